@@ -0,0 +1,158 @@
+package api
+
+import (
+	"goprizm/httputils"
+	"net/http"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+
+	"github.com/gorilla/mux"
+)
+
+func (svc *Service) getAdminRoles(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Get All Admin Roles...")
+	custom, err := svc.Store.GetAdminRoles(s)
+	if err != nil {
+		logutil.Errorf(s, "Get Admin Roles Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	roles := []*model.AdminRole{}
+	for _, name := range []string{utils.AdminUserRole, utils.AnalystUserRole} {
+		roles = append(roles, builtInRole(name))
+	}
+	if s.User.IsSuperAdmin {
+		roles = append(roles, builtInRole(utils.SuperAdminUserRole))
+	}
+	httputils.ServeJSON(w, append(roles, custom...))
+}
+
+func (svc *Service) getAdminRoleByName(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	name := mux.Vars(req)["name"]
+	logutil.Debugf(s, "Service layer - Get Admin Role By Name... Name=%v", name)
+	role, err := svc.adminRole(s, name)
+	if err != nil {
+		logutil.Errorf(s, "Get Admin Role Error - %v", err)
+		utils.SetStoreError(s, err)
+	} else {
+		httputils.ServeJSON(w, role)
+	}
+}
+
+func (svc *Service) getPermissionGroups(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	httputils.ServeJSON(w, utils.PermissionGroups)
+}
+
+func (svc *Service) UpsertAdminRole(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Add / Update Admin Role Invoked")
+	var role model.AdminRole
+	utils.DecodeAndValidate(s, w, req, &role)
+	if nil != s.Err {
+		return
+	}
+	if req.Method == utils.HttpPut {
+		// Role name is the resource id for admin roles.
+		role.Name = mux.Vars(req)["name"]
+	}
+	if utils.IsBuiltInRole(role.Name) {
+		utils.SetPreconditionFailedError(s, "key_admin_role_builtin")
+		return
+	}
+	if !utils.ValidPermissions(role.Permissions) {
+		utils.SetPreconditionFailedError(s, "key_permissions_invalid")
+		return
+	}
+	if !s.User.IsSuperAdmin && !utils.PermissionsWithin(role.Permissions, s.User.Permission) {
+		// Users can't grant access they don't have themselves.
+		utils.SetPreconditionFailedError(s, "key_permissions_exceeded")
+		return
+	}
+
+	existing, err := svc.Store.GetAdminRole(s, role.Name)
+	if req.Method == utils.HttpPost && err == nil {
+		utils.SetConflictError(s)
+		return
+	}
+	if req.Method == utils.HttpPut && err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	if existing != nil {
+		utils.SetAuditOld(s, existing)
+	}
+
+	if err := svc.Store.UpsertAdminRole(s, &role); err != nil {
+		logutil.Errorf(s, "Upsert Admin Role Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	utils.SetAuditNew(s, role.Name, &role)
+	if existing == nil {
+		httputils.ServeJSONWithStatus(w, role, http.StatusCreated)
+	} else {
+		httputils.ServeJSON(w, role)
+	}
+}
+
+func (svc *Service) DeleteAdminRole(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	name := mux.Vars(req)["name"]
+	logutil.Debugf(s, "Service layer - Delete Admin Role by Name... Name=%v", name)
+	if utils.IsBuiltInRole(name) {
+		utils.SetPreconditionFailedError(s, "key_admin_role_builtin")
+		return
+	}
+	existing, err := svc.Store.GetAdminRole(s, name)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	users, err := svc.Store.GetAllUsers(s)
+	if err != nil {
+		utils.SetSomethingWrong(s)
+		return
+	}
+	for _, user := range users {
+		if user.UserTenantAttributes.Role == name {
+			utils.SetPreconditionFailedError(s, "key_admin_role_in_use")
+			return
+		}
+	}
+	utils.SetAuditOld(s, existing)
+	if err := svc.Store.DeleteAdminRole(s, name); err != nil {
+		logutil.Errorf(s, "Delete Admin Role Error - %v", err)
+		utils.SetStoreError(s, err)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// adminRole - built in role or custom role of the session tenant with name.
+func (svc *Service) adminRole(s *model.SessionContext, name string) (*model.AdminRole, error) {
+	if utils.IsBuiltInRole(name) {
+		return builtInRole(name), nil
+	}
+	return svc.Store.GetAdminRole(s, name)
+}
+
+func builtInRole(name string) *model.AdminRole {
+	permissions, _ := utils.RolePermissions(name)
+	return &model.AdminRole{Name: name, Permissions: permissions, BuiltIn: true}
+}
+
+// UserPermissions - current role and permissions of user, sessions look them up on every request
+// so that role changes and assignments apply without logging in again.
+func (svc *Service) UserPermissions(s *model.SessionContext, userName string) (string, map[string]string, error) {
+	user, err := svc.Store.GetUserByName(s, userName)
+	if err != nil {
+		return "", nil, err
+	}
+	if user.TenantID != s.User.TenantId {
+		return "", nil, &model.NotFoundError{Entity: "user", ID: userName}
+	}
+	role, err := svc.adminRole(s, user.UserTenantAttributes.Role)
+	if err != nil {
+		return "", nil, err
+	}
+	return role.Name, role.Permissions, nil
+}
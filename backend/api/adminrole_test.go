@@ -0,0 +1,125 @@
+package api
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"testing"
+
+	"nyota/backend/model"
+	"nyota/backend/utils"
+)
+
+// newSession - client of the same server and store with its own cookies.
+func (c *testClient) newSession() *testClient {
+	jar, _ := cookiejar.New(nil)
+	return &testClient{t: c.t, server: c.server, client: &http.Client{Jar: jar}, store: c.store, blobs: c.blobs,
+		watcher: c.watcher, attempts: c.attempts}
+}
+
+func TestAdminRoles(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "secret")
+
+	auditor := map[string]interface{}{"name": "AUDITOR", "permissions": map[string]string{
+		utils.ClusterMenuPermissionKey: utils.ReadPermission, utils.EventMenuPermissionKey: utils.ReadPermission}}
+	if code := c.do(utils.HttpPost, "/adminroles", auditor, nil); code != http.StatusCreated {
+		t.Fatalf("Expected admin role create 201, got %d", code)
+	}
+	if code := c.do(utils.HttpPost, "/adminroles", auditor, nil); code != http.StatusConflict {
+		t.Errorf("Expected duplicate admin role 409, got %d", code)
+	}
+	var roles []model.AdminRole
+	c.do(utils.HttpGet, "/adminroles", nil, &roles)
+	if len(roles) != 3 || !roles[0].BuiltIn || roles[2].Name != "AUDITOR" || roles[2].AddedBy != "admin@nyota.com" {
+		t.Fatalf("Expected built in roles and AUDITOR, got %+v", roles)
+	}
+
+	user := map[string]interface{}{"name": "auditor@nyota.com", "password": "Secret123",
+		"attributes": map[string]interface{}{"role": "AUDITOR"}}
+	if code := c.do(utils.HttpPost, "/users", user, nil); code != http.StatusOK {
+		t.Fatalf("Expected user create 200, got %d", code)
+	}
+	a := c.newSession()
+	if code := a.login("auditor@nyota.com", "Secret123"); code != http.StatusOK {
+		t.Fatalf("Expected auditor login, got %d", code)
+	}
+	checks := []struct {
+		method, path string
+		expected     int
+	}{
+		{utils.HttpGet, "/clusters", http.StatusOK},
+		{utils.HttpGet, "/events", http.StatusOK},
+		{utils.HttpPost, "/clusters", http.StatusForbidden},
+		{utils.HttpGet, "/roles", http.StatusForbidden},
+		{utils.HttpGet, "/tenants", http.StatusForbidden},
+		{utils.HttpGet, "/users", http.StatusForbidden},
+	}
+	for _, check := range checks {
+		if code := a.do(check.method, check.path, map[string]interface{}{}, nil); code != check.expected {
+			t.Errorf("Expected %d for auditor %s %s, got %d", check.expected, check.method, check.path, code)
+		}
+	}
+
+	// Role changes apply to existing sessions.
+	auditor["permissions"] = map[string]string{utils.ClusterMenuPermissionKey: utils.ModifyPermission}
+	if code := c.do(utils.HttpPut, "/adminroles/AUDITOR", auditor, nil); code != http.StatusOK {
+		t.Fatalf("Expected admin role update 200, got %d", code)
+	}
+	if code := a.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "east", "uuid": "u-1"}, nil); code != http.StatusCreated {
+		t.Errorf("Expected updated role to allow cluster create, got %d", code)
+	}
+	if code := a.do(utils.HttpGet, "/events", nil, nil); code != http.StatusForbidden {
+		t.Errorf("Expected updated role to block events, got %d", code)
+	}
+
+	if code := c.do(utils.HttpDelete, "/adminroles/AUDITOR", nil, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected delete of assigned role 400, got %d", code)
+	}
+	if code := c.do(utils.HttpPut, "/adminroles/ADMIN", auditor, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected change of built in role 400, got %d", code)
+	}
+	invalid := map[string]interface{}{"name": "X", "permissions": map[string]string{"NOPE": utils.ReadPermission}}
+	if code := c.do(utils.HttpPost, "/adminroles", invalid, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected unknown group 400, got %d", code)
+	}
+
+	// Removed users lose their session.
+	c.do(utils.HttpDelete, "/users/auditor@nyota.com", nil, nil)
+	if code := a.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected session of deleted user 401, got %d", code)
+	}
+	if code := c.do(utils.HttpDelete, "/adminroles/AUDITOR", nil, nil); code != http.StatusOK {
+		t.Errorf("Expected delete of unassigned role 200, got %d", code)
+	}
+}
+
+func TestAdminRoleEscalation(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "secret")
+	manager := map[string]interface{}{"name": "USER-MANAGER", "permissions": map[string]string{
+		utils.UserMenuPermissionKey: utils.ModifyPermission, utils.ClusterMenuPermissionKey: utils.ReadPermission}}
+	c.do(utils.HttpPost, "/adminroles", manager, nil)
+	c.do(utils.HttpPost, "/users", map[string]interface{}{"name": "manager@nyota.com", "password": "Secret123",
+		"attributes": map[string]interface{}{"role": "USER-MANAGER"}}, nil)
+
+	m := c.newSession()
+	m.login("manager@nyota.com", "Secret123")
+	wider := map[string]interface{}{"name": "WIDER", "permissions": map[string]string{
+		utils.ClusterMenuPermissionKey: utils.ModifyPermission}}
+	if code := m.do(utils.HttpPost, "/adminroles", wider, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected role wider than own permissions 400, got %d", code)
+	}
+	self := map[string]interface{}{"name": "manager@nyota.com", "attributes": map[string]interface{}{"role": utils.AdminUserRole}}
+	if code := m.do(utils.HttpPut, "/users/manager@nyota.com", self, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected assigning a wider role 400, got %d", code)
+	}
+	narrower := map[string]interface{}{"name": "VIEWER", "permissions": map[string]string{
+		utils.ClusterMenuPermissionKey: utils.ReadPermission, utils.EventMenuPermissionKey: utils.BlockPermission}}
+	if code := m.do(utils.HttpPost, "/adminroles", narrower, nil); code != http.StatusCreated {
+		t.Errorf("Expected role within own permissions 201, got %d", code)
+	}
+}
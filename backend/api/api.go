@@ -2,13 +2,23 @@ package api
 
 import (
 	"net/http"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
+	"goprizm/httputils"
+	"goprizm/sysutils"
 	"nyota/backend/api/requestinterceptor"
+	"nyota/backend/auth"
+	"nyota/backend/blob"
+	"nyota/backend/cppmsync"
+	"nyota/backend/i18n"
 	"nyota/backend/logutil"
 	"nyota/backend/model"
 	"nyota/backend/store"
+	"nyota/backend/utils"
+	"nyota/backend/watch"
 
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus"
@@ -18,15 +28,28 @@ import (
 var (
 	metricReqCount *prometheus.CounterVec // metric - number of requests/tenant
 	metricReqTimes *prometheus.SummaryVec // metric - time per req
+
+	metricLoginFailures *prometheus.CounterVec // metric - failed logins/tenant
+	metricLoginLockouts *prometheus.CounterVec // metric - login lockouts/tenant
+	metricInitOnce sync.Once
+
+	requestIDFormat = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
 )
 
 type Service struct {
-	Router *mux.Router
-	Store  *store.Store
+	Router   *mux.Router
+	Store    store.Store
+	Blobs    blob.Store
+	Attempts auth.AttemptCounter
+	Notifier watch.Notifier
 }
 
 //InitAPI - initialize in api package
 func initAPI() {
+	metricInitOnce.Do(registerMetrics)
+}
+
+func registerMetrics() {
 	metricReqCount = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "api_req_total",
@@ -44,8 +67,26 @@ func initAPI() {
 		[]string{"tenantID", "url"},
 	)
 
+	metricLoginFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "api_login_failures_total",
+			Help: "Total number of failed logins, partitioned by tenantID (empty for unknown users) and reason",
+		},
+		[]string{"tenantID", "reason"},
+	)
+
+	metricLoginLockouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "api_login_lockouts_total",
+			Help: "Total number of login lockouts, partitioned by tenantID and kind (user or address)",
+		},
+		[]string{"tenantID", "kind"},
+	)
+
 	prometheus.MustRegister(metricReqCount)
 	prometheus.MustRegister(metricReqTimes)
+	prometheus.MustRegister(metricLoginFailures)
+	prometheus.MustRegister(metricLoginLockouts)
 }
 
 // Chain applies Prizm Handler to a http.HandlerFunc
@@ -57,18 +98,22 @@ func chain(realFunc requestinterceptor.PrizmHandler,
 		realFunc = m(realFunc)
 	}
 
-	// Creating a Session context which will be passed to chaining...
-	u := &model.SessionContext{User: &model.UserContext{TenantId: "", UserName: ""}, Err: nil}
-
 	return func(w http.ResponseWriter, r *http.Request) {
 		startTime := time.Now()
+		// Creating a Session context for this request which will be passed to chaining...
+		u := &model.SessionContext{User: &model.UserContext{TenantId: "", UserName: ""}, Err: nil,
+			Lang: r.Header.Get(utils.HTTPAcceptLanguageKey), RequestID: requestID(r)}
+		u.TFunc = i18n.Translate(u)
+		w.Header().Set(utils.HTTPRequestIDKey, u.RequestID)
+
 		// Invoke the chaining...
 		realFunc(u, w, r)
 
 		if u.Err != nil {
-			logutil.Errorf(u, "Method:%s, URL:%s, Type:%s, Message: %s", r.Method, r.URL, u.Err.Type, u.Err.Message)
-			http.Error(w, u.Err.Message, u.Err.Code)
-			//u.Err = nil
+			logutil.Errorf(u, "Method:%s, URL:%s, Type:%s, Message: %s, RequestID: %s", r.Method, r.URL, u.Err.Type,
+				u.Err.Message, u.RequestID)
+			u.Err.RequestID = u.RequestID
+			httputils.ServeJSONWithStatus(w, u.Err, u.Err.Code)
 		}
 
 		//add url tenant details to prom service
@@ -80,21 +125,53 @@ func chain(realFunc requestinterceptor.PrizmHandler,
 	}
 }
 
+// requestID - id of request sent by client or proxy, a new one when missing or malformed.
+func requestID(r *http.Request) string {
+	if id := r.Header.Get(utils.HTTPRequestIDKey); requestIDFormat.MatchString(id) {
+		return id
+	}
+	id, _ := auth.RandomToken(12)
+	return id
+}
+
 /*NewRoute Adds all routes exposed by ABS*/
 func NewRoute() *mux.Router {
 
 	store, err := store.New()
 	if err != nil {
+		logutil.Errorf(nil, "Store initialization failed: %v", err)
+		return nil
+	}
+	if err := bootstrapAdmin(store); err != nil {
+		logutil.Errorf(nil, "Admin bootstrap failed: %v", err)
+	}
+
+	// Config changes reach CPPM clusters through the sync outbox.
+	interval := time.Duration(sysutils.GetenvInt("SYNC_INTERVAL_SECONDS", 5)) * time.Second
+	watcher := watch.New()
+	go cppmsync.NewDispatcher(store, watcher).Run(interval, nil)
+
+	blobs, err := blob.FromEnv(store.SQL())
+	if err != nil {
+		logutil.Errorf(nil, "Blob store initialization failed: %v", err)
 		return nil
 	}
 
+	return NewRouteWithStore(store, blobs, &auth.RedisAttempts{Client: utils.Redis()}, watcher)
+}
+
+/*NewRouteWithStore Adds all routes exposed by ABS backed by given store, generated images are kept
+in blobs, failed logins are counted in attempts and attendee changes are published with notifier*/
+func NewRouteWithStore(store store.Store, blobs blob.Store, attempts auth.AttemptCounter, notifier watch.Notifier) *mux.Router {
+
 	srv := &Service{
-		Router: mux.NewRouter(),
-		Store:  store,
+		Router:   mux.NewRouter(),
+		Store:    store,
+		Blobs:    blobs,
+		Attempts: attempts,
+		Notifier: notifier,
 	}
 	initAPI()
-	// Add user records to db
-	// srv.addRecords()
 
 	r := srv.Router
 
@@ -104,19 +181,27 @@ func NewRoute() *mux.Router {
 	// All API's to use this...
 	apiRoute := r.PathPrefix("/api/v1/").Subrouter()
 
-	nologinRoutes, guardedRoutes := getAllRoutes(srv)
+	nologinRoutes, guardedRoutes, clusterRoutes := getAllRoutes(srv)
 	for _, route := range nologinRoutes {
 		apiRoute.Handle(route.Path, chain(route.RealHandler,
-			requestinterceptor.TrackReqResp(),
+			requestinterceptor.TrackReqResp(nil),
 			requestinterceptor.AddNoCacheHeader())).Methods(route.Method)
 	}
 
+	for _, route := range clusterRoutes {
+		apiRoute.Handle(route.Path, chain(route.RealHandler,
+			requestinterceptor.TrackReqResp(store),
+			requestinterceptor.AddNoCacheHeader(),
+			requestinterceptor.ValidateClusterSignature(store))).Methods(route.Method)
+	}
+
 	for _, route := range guardedRoutes {
 		apiRoute.Handle(route.Path, chain(route.RealHandler,
 			requestinterceptor.RBACCheck(route.Group, route.Permission),
-			requestinterceptor.TrackReqResp(),
+			requestinterceptor.TrackReqResp(store),
 			requestinterceptor.AddNoCacheHeader(),
-			requestinterceptor.ValidateSession())).Methods(route.Method)
+			requestinterceptor.CheckCSRF(),
+			requestinterceptor.ValidateSession(srv))).Methods(route.Method)
 	}
 
 	// This will serve static html files
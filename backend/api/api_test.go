@@ -0,0 +1,417 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"nyota/backend/auth"
+	"nyota/backend/blob"
+	"nyota/backend/cppmsync"
+	"nyota/backend/model"
+	"nyota/backend/store"
+	"nyota/backend/utils"
+	"nyota/backend/watch"
+)
+
+// testClient talks to a router backed by the in memory store and keeps session cookies.
+type testClient struct {
+	t        *testing.T
+	server   *httptest.Server
+	client   *http.Client
+	store    *store.MemStore
+	blobs    *blob.MemStore
+	watcher  *watch.Recorder
+	attempts *auth.MemAttempts
+}
+
+func newTestClient(t *testing.T) *testClient {
+	memStore := store.NewMemStore()
+	blobs := blob.NewMemStore()
+	watcher := &watch.Recorder{}
+	attempts := &auth.MemAttempts{}
+	server := httptest.NewServer(NewRouteWithStore(memStore, blobs, attempts, watcher))
+	jar, _ := cookiejar.New(nil)
+	return &testClient{t: t, server: server, client: &http.Client{Jar: jar}, store: memStore, blobs: blobs,
+		watcher: watcher, attempts: attempts}
+}
+
+func (c *testClient) Close() {
+	c.server.Close()
+}
+
+// addUser - adds user directly to store with permissions of the role.
+func (c *testClient) addUser(userName, password, tenantID, role string) {
+	permissions, _ := utils.RolePermissions(role)
+	c.store.UpsertUser(nil, &model.UserTenantDetails{UserName: userName, Password: password, TenantID: tenantID,
+		UserTenantAttributes: model.UserTenantAttributes{Role: role, Permissions: permissions}})
+}
+
+func (c *testClient) login(userName, password string) int {
+	return c.do(utils.HttpPost, "/login", model.UserLogin{UserName: userName, Password: password}, nil)
+}
+
+// newRequest - JSON request to api path with the CSRF token of the session, as the UI sends it.
+func (c *testClient) newRequest(method, path string, body io.Reader) *http.Request {
+	req, _ := http.NewRequest(method, c.server.URL+"/api/v1"+path, body)
+	req.Header.Set(utils.HTTPContentTypeKey, utils.HTTPContentJSONValue)
+	for _, cookie := range c.client.Jar.Cookies(req.URL) {
+		if cookie.Name == "csrf-token" {
+			req.Header.Set(utils.HTTPCSRFTokenKey, cookie.Value)
+		}
+	}
+	return req
+}
+
+// do - sends JSON body (when not nil) to api path and decodes JSON response into out (when not nil).
+func (c *testClient) do(method, path string, body interface{}, out interface{}) int {
+	var reader *bytes.Reader
+	if body != nil {
+		data, _ := json.Marshal(body)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	resp, err := c.client.Do(c.newRequest(method, path, reader))
+	if err != nil {
+		c.t.Fatalf("%s %s failed: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+	data, _ := ioutil.ReadAll(resp.Body)
+	if out != nil && resp.StatusCode < 300 {
+		if err := json.Unmarshal(data, out); err != nil {
+			c.t.Fatalf("%s %s invalid response %s: %v", method, path, string(data), err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestGuardedRouteNeedsLogin(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+
+	if code := c.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 without login, got %d", code)
+	}
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	if code := c.login("admin@nyota.com", "wrong"); code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 for wrong password, got %d", code)
+	}
+	if code := c.login("admin@nyota.com", "secret"); code != http.StatusOK {
+		t.Fatalf("Expected login to succeed, got %d", code)
+	}
+	if code := c.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusOK {
+		t.Errorf("Expected 200 after login, got %d", code)
+	}
+}
+
+func TestClusterAndNodeCRUD(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "secret")
+
+	if code := c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "east", "uuid": "u-1", "cppm_version": "6.7"}, nil); code != http.StatusCreated {
+		t.Fatalf("Expected cluster create 201, got %d", code)
+	}
+	var clusters []map[string]interface{}
+	c.do(utils.HttpGet, "/clusters", nil, &clusters)
+	if len(clusters) != 1 || clusters[0]["name"] != "east" {
+		t.Fatalf("Expected one cluster named east, got %v", clusters)
+	}
+	clusterID := int(clusters[0]["id"].(float64))
+
+	node := map[string]interface{}{"cppm_version": "6.7", "server_ip": "10.1.1.1", "management_ip": "10.1.1.2", "server_uuid": "s-1"}
+	if code := c.do(utils.HttpPost, "/clusters/"+strconv.Itoa(clusterID)+"/nodes", node, nil); code != http.StatusCreated {
+		t.Fatalf("Expected node create 201, got %d", code)
+	}
+	var nodes []map[string]interface{}
+	c.do(utils.HttpGet, "/clusters/"+strconv.Itoa(clusterID)+"/nodes", nil, &nodes)
+	if len(nodes) != 1 || nodes[0]["server_ip"] != "10.1.1.1" {
+		t.Fatalf("Expected node in cluster, got %v", nodes)
+	}
+
+	node["cluster_id"] = 9999
+	if code := c.do(utils.HttpPost, "/cppmnodes", node, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for unknown cluster, got %d", code)
+	}
+
+	if code := c.do(utils.HttpDelete, "/clusters/"+strconv.Itoa(clusterID), nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected cluster delete 200, got %d", code)
+	}
+	nodes = nil
+	c.do(utils.HttpGet, "/cppmnodes", nil, &nodes)
+	if len(nodes) != 0 {
+		t.Errorf("Expected nodes to be deleted with cluster, got %v", nodes)
+	}
+}
+
+func TestRoleNotifiesClusters(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "secret")
+
+	c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "east", "uuid": "u-1"}, nil)
+	var clusters []map[string]interface{}
+	c.do(utils.HttpGet, "/clusters", nil, &clusters)
+
+	role := map[string]interface{}{"name": "guest", "clusters": clusters}
+	if code := c.do(utils.HttpPost, "/roles", role, nil); code != http.StatusOK {
+		t.Fatalf("Expected role create 200, got %d", code)
+	}
+
+	// Notification is published by the sync dispatcher.
+	if len(c.watcher.Messages()) != 0 {
+		t.Fatalf("Expected no notification before dispatch, got %v", c.watcher.Messages())
+	}
+	cppmsync.NewDispatcher(c.store, c.watcher).DispatchDue(time.Now())
+	messages := c.watcher.Messages()
+	if len(messages) != 1 || messages[0].Channel != "event" {
+		t.Fatalf("Expected one event notification, got %v", messages)
+	}
+}
+
+func TestUserManagementPermissions(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("analyst@nyota.com", "secret", "1", utils.AnalystUserRole)
+
+	c.login("admin@nyota.com", "secret")
+	user := map[string]interface{}{"name": "new@nyota.com", "password": "Secret123",
+		"attributes": map[string]interface{}{"role": utils.AnalystUserRole}}
+	if code := c.do(utils.HttpPost, "/users", user, nil); code != http.StatusOK {
+		t.Fatalf("Expected user create 200, got %d", code)
+	}
+	var users []map[string]interface{}
+	c.do(utils.HttpGet, "/users", nil, &users)
+	if len(users) != 3 {
+		t.Fatalf("Expected 3 users, got %v", users)
+	}
+	for _, u := range users {
+		if _, ok := u["password"]; ok {
+			t.Errorf("Password must not be returned, got %v", u)
+		}
+	}
+
+	c.login("analyst@nyota.com", "secret")
+	if code := c.do(utils.HttpGet, "/users", nil, nil); code != http.StatusForbidden {
+		t.Errorf("Expected analyst to be forbidden, got %d", code)
+	}
+	if code := c.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusOK {
+		t.Errorf("Expected analyst to read clusters, got %d", code)
+	}
+	if code := c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "x"}, nil); code != http.StatusForbidden {
+		t.Errorf("Expected analyst to be forbidden to add cluster, got %d", code)
+	}
+}
+
+func TestTenantIsolation(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("admin1@nyota.com", "secret", "2", utils.AdminUserRole)
+
+	c.login("admin@nyota.com", "secret")
+	c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "east", "uuid": "u-1"}, nil)
+	var clusters []map[string]interface{}
+	c.do(utils.HttpGet, "/clusters", nil, &clusters)
+	clusterPath := "/clusters/" + strconv.Itoa(int(clusters[0]["id"].(float64)))
+	c.do(utils.HttpPost, clusterPath+"/nodes", map[string]interface{}{"cppm_version": "6.7", "server_ip": "10.1.1.1",
+		"management_ip": "10.1.1.2", "server_uuid": "s-1"}, nil)
+	var nodes []map[string]interface{}
+	c.do(utils.HttpGet, "/cppmnodes", nil, &nodes)
+	nodePath := "/cppmnodes/" + strconv.Itoa(int(nodes[0]["id"].(float64)))
+	var role map[string]interface{}
+	c.do(utils.HttpPost, "/roles", map[string]interface{}{"name": "guest"}, &role)
+	rolePath := "/roles/" + strconv.Itoa(int(role["id"].(float64)))
+
+	c.login("admin1@nyota.com", "secret")
+	for _, path := range []string{clusterPath, nodePath, rolePath} {
+		if code := c.do(utils.HttpGet, path, nil, nil); code != http.StatusNotFound {
+			t.Errorf("Expected 404 reading %s of other tenant, got %d", path, code)
+		}
+		if code := c.do(utils.HttpDelete, path, nil, nil); code != http.StatusNotFound {
+			t.Errorf("Expected 404 deleting %s of other tenant, got %d", path, code)
+		}
+	}
+	update := map[string]interface{}{"id": clusters[0]["id"], "name": "stolen"}
+	if code := c.do(utils.HttpPut, clusterPath, update, nil); code != http.StatusNotFound {
+		t.Errorf("Expected 404 updating cluster of other tenant, got %d", code)
+	}
+	admin := map[string]interface{}{"name": "root@nyota.com", "password": "Secret123",
+		"attributes": map[string]interface{}{"role": utils.SuperAdminUserRole}}
+	if code := c.do(utils.HttpPost, "/users", admin, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected admin to be refused super admin role, got %d", code)
+	}
+
+	c.login("admin@nyota.com", "secret")
+	var cluster map[string]interface{}
+	if code := c.do(utils.HttpGet, clusterPath, nil, &cluster); code != http.StatusOK {
+		t.Fatalf("Expected owner to read cluster, got %d", code)
+	}
+	for _, path := range []string{nodePath, rolePath, clusterPath} {
+		if code := c.do(utils.HttpDelete, path, nil, nil); code != http.StatusOK {
+			t.Errorf("Expected owner to delete %s, got %d", path, code)
+		}
+	}
+}
+
+func TestSuperAdminSeesAllTenants(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("root@nyota.com", "secret", "0", utils.SuperAdminUserRole)
+
+	c.login("admin@nyota.com", "secret")
+	c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "east", "uuid": "u-1"}, nil)
+	var clusters []map[string]interface{}
+	c.do(utils.HttpGet, "/clusters", nil, &clusters)
+	clusterPath := "/clusters/" + strconv.Itoa(int(clusters[0]["id"].(float64)))
+
+	c.login("root@nyota.com", "secret")
+	if code := c.do(utils.HttpGet, clusterPath, nil, nil); code != http.StatusOK {
+		t.Errorf("Expected super admin to read cluster of any tenant, got %d", code)
+	}
+}
+
+func TestLoginRehashesLegacyPassword(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "pass123", "1", utils.AdminUserRole)
+
+	if code := c.login("admin@nyota.com", "pass123"); code != http.StatusOK {
+		t.Fatalf("Expected legacy login to succeed, got %d", code)
+	}
+	user, _ := c.store.GetUserByName(nil, "admin@nyota.com")
+	if user.Password == "pass123" || user.Salt == "" {
+		t.Fatalf("Expected legacy password to be rehashed, got %q %q", user.Password, user.Salt)
+	}
+	if ok, rehash := auth.CheckPassword("pass123", user.Password, user.Salt); !ok || rehash {
+		t.Errorf("Expected stored hash to verify, got %v %v", ok, rehash)
+	}
+	if code := c.login("admin@nyota.com", "pass123"); code != http.StatusOK {
+		t.Errorf("Expected login with rehashed password to succeed, got %d", code)
+	}
+	if code := c.login("admin@nyota.com", "pass124"); code != http.StatusUnauthorized {
+		t.Errorf("Expected wrong password to fail, got %d", code)
+	}
+}
+
+func TestUserPasswordHashedAndPolicy(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "secret")
+
+	weak := map[string]interface{}{"name": "new@nyota.com", "password": "password",
+		"attributes": map[string]interface{}{"role": utils.AnalystUserRole}}
+	if code := c.do(utils.HttpPost, "/users", weak, nil); code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected weak password to be rejected, got %d", code)
+	}
+	weak["password"] = "Passw0rd"
+	if code := c.do(utils.HttpPost, "/users", weak, nil); code != http.StatusOK {
+		t.Fatalf("Expected user create 200, got %d", code)
+	}
+	user, _ := c.store.GetUserByName(nil, "new@nyota.com")
+	if user.Password == "Passw0rd" || user.Salt == "" {
+		t.Errorf("Expected password to be stored hashed, got %q", user.Password)
+	}
+
+	// Update without password keeps the stored hash.
+	update := map[string]interface{}{"name": "new@nyota.com", "descrption": "ops",
+		"attributes": map[string]interface{}{"role": utils.AnalystUserRole}}
+	if code := c.do(utils.HttpPut, "/users/new@nyota.com", update, nil); code != http.StatusOK {
+		t.Fatalf("Expected user update 200, got %d", code)
+	}
+	if code := c.login("new@nyota.com", "Passw0rd"); code != http.StatusOK {
+		t.Errorf("Expected login with unchanged password, got %d", code)
+	}
+}
+
+func TestDeleteUserOfOtherTenant(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("other@nyota.com", "secret", "2", utils.AnalystUserRole)
+	c.login("admin@nyota.com", "secret")
+
+	if code := c.do(utils.HttpDelete, "/users/other@nyota.com", nil, nil); code != http.StatusNotFound {
+		t.Errorf("Expected delete of user of other tenant 404, got %d", code)
+	}
+	if user, err := c.store.GetUserByName(nil, "other@nyota.com"); err != nil || user.TenantID != "2" {
+		t.Errorf("Expected user of other tenant kept, got %+v %v", user, err)
+	}
+	var page model.AuditLogPage
+	c.do(utils.HttpGet, "/audit?entity=users", nil, &page)
+	if page.Total != 0 {
+		t.Errorf("Expected no audit of user of other tenant, got %+v", page.Items)
+	}
+}
+
+func TestGetUserByName(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("other@nyota.com", "secret", "2", utils.AnalystUserRole)
+	c.addUser("root@nyota.com", "secret", "0", utils.SuperAdminUserRole)
+
+	c.login("admin@nyota.com", "secret")
+	for _, userName := range []string{"nobody@nyota.com", "other@nyota.com"} {
+		if code := c.do(utils.HttpGet, "/users/"+userName, nil, nil); code != http.StatusNotFound {
+			t.Errorf("Expected get of %s 404, got %d", userName, code)
+		}
+	}
+	c.login("root@nyota.com", "secret")
+	var user model.UserTenantDetails
+	if code := c.do(utils.HttpGet, "/users/other@nyota.com", nil, &user); code != http.StatusOK || user.TenantID != "2" || user.Password != "" {
+		t.Errorf("Expected super admin to read user of any tenant, got %d %+v", code, user)
+	}
+}
+
+func TestBootstrapAdmin(t *testing.T) {
+	memStore := store.NewMemStore()
+	os.Setenv("NYOTA_ADMIN_USER", "root@nyota.com")
+	os.Setenv("NYOTA_ADMIN_TENANT", "7")
+	defer os.Unsetenv("NYOTA_ADMIN_USER")
+	defer os.Unsetenv("NYOTA_ADMIN_TENANT")
+	defer os.Unsetenv("NYOTA_ADMIN_PASSWORD")
+
+	os.Setenv("NYOTA_ADMIN_PASSWORD", "weak")
+	if err := bootstrapAdmin(memStore); err == nil {
+		t.Errorf("Expected weak bootstrap password to be rejected")
+	}
+
+	os.Setenv("NYOTA_ADMIN_PASSWORD", "Bootstrap1")
+	if err := bootstrapAdmin(memStore); err != nil {
+		t.Fatalf("Bootstrap failed: %v", err)
+	}
+	user, err := memStore.GetUserByName(nil, "root@nyota.com")
+	if err != nil || user.TenantID != "7" || user.UserTenantAttributes.Role != utils.SuperAdminUserRole {
+		t.Fatalf("Expected super admin in tenant 7, got %v %v", user, err)
+	}
+	if ok, _ := auth.CheckPassword("Bootstrap1", user.Password, user.Salt); !ok {
+		t.Errorf("Expected bootstrap password to be hashed and verify")
+	}
+	s := &model.SessionContext{User: &model.UserContext{TenantId: "7"}}
+	if _, err := memStore.GetTenantById(s, "7"); err != nil {
+		t.Errorf("Expected bootstrap tenant to be created, got %v", err)
+	}
+
+	// Existing admin is never overwritten.
+	os.Setenv("NYOTA_ADMIN_PASSWORD", "Changed123")
+	bootstrapAdmin(memStore)
+	user, _ = memStore.GetUserByName(nil, "root@nyota.com")
+	if ok, _ := auth.CheckPassword("Bootstrap1", user.Password, user.Salt); !ok {
+		t.Errorf("Expected existing admin password to be kept")
+	}
+}
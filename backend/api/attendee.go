@@ -0,0 +1,244 @@
+package api
+
+import (
+	"encoding/json"
+	"goprizm/httputils"
+	"net/http"
+	"nyota/backend/auth"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// Channel attendee changes are published on, with their type.
+const (
+	AttendeeChannel  = "attendee"
+	attendeePromoted = "attendee_promoted"
+)
+
+func (svc *Service) getEventAttendees(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	eventID := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Get Event Attendees... Event Id = %v", eventID)
+	occurrence, ok := queryOccurrence(s, req.URL.Query())
+	if !ok {
+		return
+	}
+	event, err := svc.Store.GetEventByID(s, eventID)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	attendees, err := svc.Store.GetEventAttendees(s, eventID)
+	if err != nil {
+		logutil.Errorf(s, "Get Event Attendees Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	data := make([]*config.Attendee, 0, len(attendees))
+	for _, attendee := range attendees {
+		if occurrence != nil && (attendee.Occurrence == nil || !attendee.Occurrence.Equal(*occurrence)) {
+			continue
+		}
+		attendee.Ticket = auth.TicketToken(event.TicketKey, event.ID, attendee.ID)
+		data = append(data, attendee)
+	}
+	httputils.ServeJSON(w, data)
+}
+
+func (svc *Service) getEventAttendee(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	event, attendee, ok := svc.eventAttendee(s, req)
+	if !ok {
+		return
+	}
+	attendee.Ticket = auth.TicketToken(event.TicketKey, event.ID, attendee.ID)
+	httputils.ServeJSON(w, attendee)
+}
+
+// getEventAttendeeTicket - QR code of the ticket token of attendee, in format and size of the query
+// like the QR codes of events. Tickets are not kept, they go away with the registration.
+func (svc *Service) getEventAttendeeTicket(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	format, size, ok := qrParams(s, req.URL.Query())
+	if !ok {
+		return
+	}
+	event, attendee, ok := svc.eventAttendee(s, req)
+	if !ok {
+		return
+	}
+	data, err := encodeQR(auth.TicketToken(event.TicketKey, event.ID, attendee.ID), format, size)
+	if err != nil {
+		logutil.Errorf(s, "Ticket QR code Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	w.Header().Set(utils.HTTPContentTypeKey, qrContentTypes[format])
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Write(data)
+}
+
+// eventAttendee - event and attendee of the request path, error is set to session when either is
+// not visible.
+func (svc *Service) eventAttendee(s *model.SessionContext, req *http.Request) (*config.Event, *config.Attendee, bool) {
+	eventID, id := mux.Vars(req)["id"], mux.Vars(req)["attendeeId"]
+	logutil.Debugf(s, "Service layer - Get Event Attendee... Event Id = %v Id = %v", eventID, id)
+	event, err := svc.Store.GetEventByID(s, eventID)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return nil, nil, false
+	}
+	attendee, err := svc.Store.GetEventAttendee(s, eventID, id)
+	if err != nil {
+		logutil.Errorf(s, "Get Event Attendee Error - %v", err)
+		utils.SetStoreError(s, err)
+		return nil, nil, false
+	}
+	return event, attendee, true
+}
+
+// AddEventAttendee - registers attendee for the event, the response carries the ticket token. Emails
+// can be registered once per event, once per occurrence for recurring events. Attendees beyond the
+// capacity of the event are waitlisted, their tickets are accepted once they are promoted.
+func (svc *Service) AddEventAttendee(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	eventID := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Add Event Attendee... Event Id = %v", eventID)
+	var attendee config.Attendee
+	utils.DecodeAndValidate(s, w, req, &attendee)
+	if nil != s.Err {
+		return
+	}
+	event, err := svc.Store.GetEventByID(s, eventID)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	if !svc.checkOccurrence(s, event, attendee.Occurrence) {
+		return
+	}
+	attendee.EventID = event.ID
+	if err := svc.Store.AddEventAttendee(s, &attendee); err != nil {
+		logutil.Errorf(s, "Add Event Attendee Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	utils.SetAuditNew(s, strconv.Itoa(attendee.ID), &attendee)
+	attendee.Ticket = auth.TicketToken(event.TicketKey, event.ID, attendee.ID)
+	httputils.ServeJSONWithStatus(w, attendee, http.StatusCreated)
+}
+
+// DeleteEventAttendee - cancels the registration, waitlisted attendees promoted to the place are
+// notified.
+func (svc *Service) DeleteEventAttendee(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	_, attendee, ok := svc.eventAttendee(s, req)
+	if !ok {
+		return
+	}
+	utils.SetAuditOld(s, attendee)
+	promoted, err := svc.Store.DeleteEventAttendee(s, strconv.Itoa(attendee.EventID), strconv.Itoa(attendee.ID))
+	if err != nil {
+		logutil.Errorf(s, "Delete Event Attendee Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	svc.notifyPromoted(s, promoted)
+	w.WriteHeader(http.StatusOK)
+}
+
+// CheckInEventAttendee - checks in the attendee of a scanned ticket token. Tokens of other events or
+// with invalid signature are refused with 400, tickets already checked in with 409 and the time and
+// operator of the first check-in. Tickets for recurring events are valid at their occurrence only,
+// tickets of waitlisted attendees are refused.
+func (svc *Service) CheckInEventAttendee(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	eventID := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Check In Event Attendee... Event Id = %v", eventID)
+	var checkIn config.CheckIn
+	if err := json.NewDecoder(req.Body).Decode(&checkIn); err != nil {
+		utils.SetParsingError(s, err)
+		return
+	}
+	event, err := svc.Store.GetEventByID(s, eventID)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	ticketEventID, attendeeID, ok := auth.ParseTicketToken(checkIn.Ticket)
+	if !ok || ticketEventID != event.ID || !auth.CheckTicketToken(checkIn.Ticket, event.TicketKey) {
+		logutil.Printf(s, "Invalid ticket for event %d scanned by %s", event.ID, s.User.UserName)
+		utils.SetPreconditionFailedError(s, "key_invalid_ticket")
+		return
+	}
+	registered, err := svc.Store.GetEventAttendee(s, eventID, strconv.Itoa(attendeeID))
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	if registered.Status == config.AttendeeWaitlisted {
+		logutil.Printf(s, "Ticket of waitlisted attendee %d scanned by %s", attendeeID, s.User.UserName)
+		utils.SetPreconditionFailedError(s, "key_ticket_waitlisted")
+		return
+	}
+	if checkIn.Occurrence != nil && (registered.Occurrence == nil || !registered.Occurrence.Equal(*checkIn.Occurrence)) {
+		logutil.Printf(s, "Ticket of attendee %d for another occurrence scanned by %s", attendeeID, s.User.UserName)
+		utils.SetPreconditionFailedError(s, "key_ticket_other_occurrence")
+		return
+	}
+	if event.RRule != "" && !svc.checkOccurrence(s, event, registered.Occurrence) {
+		return
+	}
+	attendee, err := svc.Store.CheckInEventAttendee(s, eventID, strconv.Itoa(attendeeID), time.Now())
+	if duplicate, ok := err.(*model.DuplicateCheckInError); ok {
+		logutil.Printf(s, "Duplicate scan of ticket of attendee %d, scan %d", attendeeID, attendee.Scans)
+		utils.SetDuplicateCheckInError(s, duplicate)
+		return
+	}
+	if err != nil {
+		logutil.Errorf(s, "Check In Event Attendee Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	utils.SetAuditNew(s, strconv.Itoa(attendee.ID), attendee)
+	httputils.ServeJSON(w, attendee)
+}
+
+// checkOccurrence - occurrence of a registration or check-in is given for recurring events only and
+// is an occurrence of event that is not cancelled. Error is set to session otherwise.
+func (svc *Service) checkOccurrence(s *model.SessionContext, event *config.Event, at *time.Time) bool {
+	if event.RRule == "" {
+		if at != nil {
+			utils.SetPreconditionFailedError(s, "key_event_not_recurring")
+			return false
+		}
+		return true
+	}
+	if at == nil {
+		utils.SetPreconditionFailedError(s, "key_occurrence_required")
+		return false
+	}
+	occurrence, ok := svc.eventOccurrence(s, event, *at)
+	if !ok {
+		return false
+	}
+	if occurrence.Cancelled {
+		utils.SetPreconditionFailedError(s, "key_occurrence_cancelled")
+		return false
+	}
+	*at = at.UTC()
+	return true
+}
+
+// notifyPromoted - publishes the promotion of attendees from the waitlist on AttendeeChannel.
+// Registrations are changed already, failures are logged only.
+func (svc *Service) notifyPromoted(s *model.SessionContext, promoted []*config.Attendee) {
+	for _, attendee := range promoted {
+		logutil.Printf(s, "Attendee %d of event %d promoted from the waitlist", attendee.ID, attendee.EventID)
+		message, _ := json.Marshal(config.AttendeePromotion{Type: attendeePromoted, TenantID: attendee.TenantID,
+			EventID: attendee.EventID, Attendee: attendee})
+		if err := svc.Notifier.Notify(AttendeeChannel, message); err != nil {
+			logutil.Errorf(s, "Notify promotion of attendee %d Error - %v", attendee.ID, err)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+)
+
+func TestEventCheckIn(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+
+	var event config.Event
+	if code := c.do(utils.HttpPost, "/events", map[string]interface{}{"event_name": "launch"}, &event); code != http.StatusOK {
+		t.Fatalf("Expected event create 200, got %d", code)
+	}
+	eventPath := "/events/" + strconv.Itoa(event.ID)
+	var attendee config.Attendee
+	if code := c.do(utils.HttpPost, eventPath+"/attendees", map[string]interface{}{"name": "Ann", "email": " Ann@Example.com"}, &attendee); code != http.StatusCreated {
+		t.Fatalf("Expected registration 201, got %d", code)
+	}
+	if attendee.Email != "ann@example.com" || attendee.Ticket == "" || attendee.RegisteredBy != "admin@nyota.com" {
+		t.Fatalf("Expected registered attendee with ticket, got %+v", attendee)
+	}
+	if code := c.do(utils.HttpPost, eventPath+"/attendees", map[string]interface{}{"name": "Ann", "email": "ann@example.com"}, nil); code != http.StatusConflict {
+		t.Errorf("Expected second registration of email 409, got %d", code)
+	}
+	if code := c.do(utils.HttpPost, eventPath+"/attendees", map[string]interface{}{"name": "Bob", "email": "bob"}, nil); code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected invalid email 422, got %d", code)
+	}
+	attendeePath := eventPath + "/attendees/" + strconv.Itoa(attendee.ID)
+	resp, _ := c.raw(utils.HttpGet, attendeePath+"/ticket", "", nil)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get(utils.HTTPContentTypeKey) != "image/png" {
+		t.Errorf("Expected ticket QR code, got %d %s", resp.StatusCode, resp.Header.Get(utils.HTTPContentTypeKey))
+	}
+
+	forged := attendee.Ticket[:len(attendee.Ticket)-2] + "xx"
+	if code := c.do(utils.HttpPost, eventPath+"/checkin", map[string]string{"ticket": forged}, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected forged ticket 400, got %d", code)
+	}
+	var checkedIn config.Attendee
+	if code := c.do(utils.HttpPost, eventPath+"/checkin", map[string]string{"ticket": attendee.Ticket}, &checkedIn); code != http.StatusOK {
+		t.Fatalf("Expected check-in 200, got %d", code)
+	}
+	if checkedIn.CheckedInAt == nil || checkedIn.CheckedInBy != "admin@nyota.com" || checkedIn.Scans != 1 {
+		t.Fatalf("Expected check-in recorded, got %+v", checkedIn)
+	}
+	resp, envelope := c.raw(utils.HttpPost, eventPath+"/checkin", `{"ticket": "`+attendee.Ticket+`"}`, nil)
+	if resp.StatusCode != http.StatusConflict || envelope.Fields["checked_in_by"] != "admin@nyota.com" || envelope.Fields["checked_in_at"] == "" {
+		t.Fatalf("Expected duplicate scan 409 with first check-in, got %d %+v", resp.StatusCode, envelope)
+	}
+
+	var other config.Event
+	c.do(utils.HttpPost, "/events", map[string]interface{}{"event_name": "party"}, &other)
+	if code := c.do(utils.HttpPost, "/events/"+strconv.Itoa(other.ID)+"/checkin", map[string]string{"ticket": attendee.Ticket}, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected ticket of other event 400, got %d", code)
+	}
+
+	c.do(utils.HttpPost, eventPath+"/attendees", map[string]interface{}{"name": "Cid", "email": "cid@example.com"}, nil)
+	var loaded config.Event
+	c.do(utils.HttpGet, eventPath, nil, &loaded)
+	if loaded.Attendance == nil || loaded.Attendance.Registered != 2 || loaded.Attendance.CheckedIn != 1 {
+		t.Fatalf("Expected 2 registered and 1 checked in, got %+v", loaded.Attendance)
+	}
+	var attendees []config.Attendee
+	c.do(utils.HttpGet, eventPath+"/attendees", nil, &attendees)
+	if len(attendees) != 2 || attendees[0].Scans != 2 || attendees[0].Ticket != attendee.Ticket {
+		t.Fatalf("Expected attendees with scans and tickets, got %+v", attendees)
+	}
+
+	if code := c.do(utils.HttpDelete, attendeePath, nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected registration cancel 200, got %d", code)
+	}
+	if code := c.do(utils.HttpPost, eventPath+"/checkin", map[string]string{"ticket": attendee.Ticket}, nil); code != http.StatusNotFound {
+		t.Errorf("Expected ticket of cancelled registration 404, got %d", code)
+	}
+}
+
+func TestEventWaitlist(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+
+	if code := c.do(utils.HttpPost, "/events", map[string]interface{}{"event_name": "launch", "capacity": -1}, nil); code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected negative capacity 422, got %d", code)
+	}
+	var event config.Event
+	c.do(utils.HttpPost, "/events", map[string]interface{}{"event_name": "launch", "capacity": 3}, &event)
+	eventPath := "/events/" + strconv.Itoa(event.ID)
+
+	// Registrations at once never take more places than there are.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c.do(utils.HttpPost, eventPath+"/attendees", map[string]interface{}{"name": "Guest", "email": fmt.Sprintf("guest%d@example.com", i)}, nil)
+		}(i)
+	}
+	wg.Wait()
+	var loaded config.Event
+	if c.do(utils.HttpGet, eventPath, nil, &loaded); loaded.Attendance.Registered != 3 || loaded.Attendance.Waitlisted != 5 {
+		t.Fatalf("Expected 3 registered and 5 waitlisted, got %+v", loaded.Attendance)
+	}
+	var attendees []*config.Attendee
+	c.do(utils.HttpGet, eventPath+"/attendees", nil, &attendees)
+	for i, attendee := range attendees {
+		if waitlisted := attendee.Status == config.AttendeeWaitlisted; waitlisted != (i >= 3) {
+			t.Errorf("Expected first 3 attendees registered and the others waitlisted, got %d %s", i, attendee.Status)
+		}
+	}
+	if code := c.do(utils.HttpPost, eventPath+"/checkin", map[string]string{"ticket": attendees[3].Ticket}, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected ticket of waitlisted attendee 400, got %d", code)
+	}
+
+	if code := c.do(utils.HttpDelete, eventPath+"/attendees/"+strconv.Itoa(attendees[0].ID), nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected registration cancel 200, got %d", code)
+	}
+	var promoted config.Attendee
+	if c.do(utils.HttpGet, eventPath+"/attendees/"+strconv.Itoa(attendees[3].ID), nil, &promoted); promoted.Status != config.AttendeeRegistered || promoted.PromotedAt == nil {
+		t.Fatalf("Expected first waitlisted attendee promoted, got %+v", promoted)
+	}
+	if code := c.do(utils.HttpPost, eventPath+"/checkin", map[string]string{"ticket": promoted.Ticket}, nil); code != http.StatusOK {
+		t.Errorf("Expected ticket of promoted attendee 200, got %d", code)
+	}
+	// Cancelling a waitlisted attendee frees no place.
+	c.do(utils.HttpDelete, eventPath+"/attendees/"+strconv.Itoa(attendees[7].ID), nil, nil)
+
+	loaded.Capacity = 5
+	if code := c.do(utils.HttpPut, eventPath, loaded, nil); code != http.StatusOK {
+		t.Fatalf("Expected capacity raise 200, got %d", code)
+	}
+	if c.do(utils.HttpGet, eventPath, nil, &loaded); loaded.Attendance.Registered != 5 || loaded.Attendance.Waitlisted != 1 {
+		t.Errorf("Expected waitlist promoted to the new capacity, got %+v", loaded.Attendance)
+	}
+
+	var ids []int
+	for _, message := range c.watcher.Messages() {
+		var promotion config.AttendeePromotion
+		json.Unmarshal(message.Data.([]byte), &promotion)
+		if message.Channel != AttendeeChannel || promotion.Type != "attendee_promoted" || promotion.EventID != event.ID {
+			t.Errorf("Unexpected notification %s %+v", message.Channel, promotion)
+		}
+		ids = append(ids, promotion.Attendee.ID)
+	}
+	if len(ids) != 3 || ids[0] != attendees[3].ID || ids[1] != attendees[4].ID || ids[2] != attendees[5].ID {
+		t.Errorf("Expected promotions notified in order of registration, got %v", ids)
+	}
+}
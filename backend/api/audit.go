@@ -0,0 +1,104 @@
+package api
+
+import (
+	"encoding/csv"
+	"goprizm/httputils"
+	"net/http"
+	"net/url"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/store"
+	"nyota/backend/utils"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var auditCSVHeader = []string{"id", "added_at", "tenant_id", "user_name", "action", "entity", "entity_id",
+	"request_id", "remote_addr", "old_data", "new_data"}
+
+// getAuditLogs - page of audit log, filtered by query params user, entity, entity_id, action, from
+// and to (RFC 3339), paged by page and page_size.
+func (svc *Service) getAuditLogs(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Get Audit Logs invoked...")
+	filter, ok := auditFilter(s, req.URL.Query())
+	if !ok {
+		return
+	}
+	data, err := svc.Store.GetAuditLogs(s, filter)
+	if err != nil {
+		logutil.Errorf(s, "Get Audit Logs Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	httputils.ServeJSON(w, data)
+}
+
+// exportAuditLogs - audit log matching the filters of getAuditLogs as CSV, at most
+// store.MaxAuditPageSize entries, newest first.
+func (svc *Service) exportAuditLogs(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Export Audit Logs invoked...")
+	filter, ok := auditFilter(s, req.URL.Query())
+	if !ok {
+		return
+	}
+	filter.Page, filter.PageSize = 1, store.MaxAuditPageSize
+	data, err := svc.Store.GetAuditLogs(s, filter)
+	if err != nil {
+		logutil.Errorf(s, "Export Audit Logs Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+
+	w.Header().Set(utils.HTTPContentTypeKey, "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="audit.csv"`)
+	writer := csv.NewWriter(w)
+	writer.Write(auditCSVHeader)
+	for _, entry := range data.Items {
+		writer.Write([]string{strconv.Itoa(entry.ID), entry.AddedAt.UTC().Format(time.RFC3339), entry.TenantID,
+			csvCell(entry.UserName), entry.Action, entry.Entity, csvCell(entry.EntityID), entry.RequestID,
+			entry.RemoteAddr, string(entry.OldData), string(entry.NewData)})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		logutil.Errorf(s, "Export Audit Logs write failed - %v", err)
+	}
+}
+
+// auditFilter - filter of audit log query params, false with the error set on session when invalid.
+func auditFilter(s *model.SessionContext, query url.Values) (model.AuditFilter, bool) {
+	filter := model.AuditFilter{
+		UserName: query.Get("user"),
+		Entity:   query.Get("entity"),
+		EntityID: query.Get("entity_id"),
+		Action:   query.Get("action"),
+	}
+	var err error
+	for param, value := range map[string]*time.Time{"from": &filter.From, "to": &filter.To} {
+		if query.Get(param) == "" {
+			continue
+		}
+		if *value, err = time.Parse(time.RFC3339, query.Get(param)); err != nil {
+			utils.SetPreconditionFailedError(s, "key_audit_filter_invalid")
+			return filter, false
+		}
+	}
+	for param, value := range map[string]*int{"page": &filter.Page, "page_size": &filter.PageSize} {
+		if query.Get(param) == "" {
+			continue
+		}
+		if *value, err = strconv.Atoi(query.Get(param)); err != nil || *value < 1 {
+			utils.SetPreconditionFailedError(s, "key_audit_filter_invalid")
+			return filter, false
+		}
+	}
+	return filter, true
+}
+
+// csvCell - value safe to open in spreadsheets, which run cells starting with = + - @ as formulas.
+func csvCell(value string) string {
+	if value != "" && strings.ContainsAny(value[:1], "=+-@") {
+		return "'" + value
+	}
+	return value
+}
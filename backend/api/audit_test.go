@@ -0,0 +1,125 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"nyota/backend/model"
+	"nyota/backend/utils"
+)
+
+func TestAuditLog(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("editor@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("admin@other.com", "secret", "2", utils.AdminUserRole)
+
+	c.login("admin@nyota.com", "secret")
+	c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "east", "uuid": "u-1"}, nil)
+	var clusters []map[string]interface{}
+	c.do(utils.HttpGet, "/clusters", nil, &clusters)
+	id := strconv.Itoa(int(clusters[0]["id"].(float64)))
+	if code := c.do(utils.HttpPost, "/clusters", map[string]interface{}{"uuid": "u-2"}, nil); code != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected invalid cluster to fail, got %d", code)
+	}
+
+	c.login("editor@nyota.com", "secret")
+	if code := c.do(utils.HttpPut, "/clusters/"+id, map[string]interface{}{"name": "west", "uuid": "u-1"}, nil); code != http.StatusCreated {
+		t.Fatalf("Expected cluster update 201, got %d", code)
+	}
+	clusters = nil
+	c.do(utils.HttpGet, "/clusters", nil, &clusters)
+	if clusters[0]["added_by"] != "admin@nyota.com" || clusters[0]["updated_by"] != "editor@nyota.com" {
+		t.Errorf("Expected added and updated by of cluster, got %v", clusters[0])
+	}
+	c.do(utils.HttpDelete, "/clusters/"+id, nil, nil)
+
+	c.login("admin@other.com", "secret")
+	c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "north", "uuid": "u-3"}, nil)
+
+	c.login("admin@nyota.com", "secret")
+	var page model.AuditLogPage
+	if code := c.do(utils.HttpGet, "/audit?entity=clusters", nil, &page); code != http.StatusOK {
+		t.Fatalf("Expected audit log, got %d", code)
+	}
+	if page.Total != 3 || len(page.Items) != 3 {
+		t.Fatalf("Expected add, update and delete of tenant cluster only, got %+v", page)
+	}
+	deleted, updated, added := page.Items[0], page.Items[1], page.Items[2]
+	if added.Action != model.AuditActionAdd || added.EntityID != id || added.UserName != "admin@nyota.com" ||
+		added.OldData != "" || added.RequestID == "" || added.RemoteAddr == "" {
+		t.Errorf("Unexpected add entry %+v", added)
+	}
+	if updated.Action != model.AuditActionUpdate || updated.UserName != "editor@nyota.com" ||
+		auditField(t, updated.OldData, "name") != "east" || auditField(t, updated.NewData, "name") != "west" {
+		t.Errorf("Unexpected update entry %+v", updated)
+	}
+	if deleted.Action != model.AuditActionDelete || auditField(t, deleted.OldData, "name") != "west" || deleted.NewData != "" {
+		t.Errorf("Unexpected delete entry %+v", deleted)
+	}
+
+	page = model.AuditLogPage{}
+	c.do(utils.HttpGet, "/audit?entity=clusters&page=2&page_size=2", nil, &page)
+	if page.Total != 3 || len(page.Items) != 1 || page.Items[0].ID != added.ID {
+		t.Errorf("Expected last entry on second page, got %+v", page)
+	}
+	page = model.AuditLogPage{}
+	c.do(utils.HttpGet, "/audit?user=editor@nyota.com&action=Update", nil, &page)
+	if page.Total != 1 {
+		t.Errorf("Expected update of editor, got %+v", page)
+	}
+	if code := c.do(utils.HttpGet, "/audit?from=yesterday", nil, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for invalid filter, got %d", code)
+	}
+
+	resp, _ := c.raw(utils.HttpGet, "/audit/export?entity=clusters", "", nil)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get(utils.HTTPContentTypeKey) != "text/csv; charset=utf-8" {
+		t.Fatalf("Expected CSV export, got %d %s", resp.StatusCode, resp.Header.Get(utils.HTTPContentTypeKey))
+	}
+
+	c.addUser("analyst@nyota.com", "secret", "1", utils.AnalystUserRole)
+	c.login("analyst@nyota.com", "secret")
+	if code := c.do(utils.HttpGet, "/audit", nil, nil); code != http.StatusForbidden {
+		t.Errorf("Expected analyst to be denied the audit log, got %d", code)
+	}
+}
+
+func TestAuditExportCSV(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "secret")
+	c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "east", "uuid": "u-1"}, nil)
+
+	resp, err := c.client.Get(c.server.URL + "/api/v1/audit/export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	rows, err := csv.NewReader(resp.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("Expected valid CSV: %v", err)
+	}
+	if len(rows) != 2 || len(rows[1]) != len(auditCSVHeader) || rows[1][4] != model.AuditActionAdd || rows[1][5] != "clusters" {
+		t.Fatalf("Expected header and cluster add, got %v", rows)
+	}
+	if auditField(t, model.AuditData(rows[1][10]), "name") != "east" {
+		t.Errorf("Expected new data of cluster, got %s", rows[1][10])
+	}
+
+	if cell := csvCell("=HYPERLINK()"); cell != "'=HYPERLINK()" {
+		t.Errorf("Expected formula to be escaped, got %s", cell)
+	}
+}
+
+func auditField(t *testing.T, data model.AuditData, field string) interface{} {
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(data), &values); err != nil {
+		t.Fatalf("Expected JSON audit data, got %q", data)
+	}
+	return values[field]
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"errors"
+	"goprizm/sysutils"
+	"nyota/backend/auth"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/store"
+	"nyota/backend/utils"
+)
+
+// bootstrapAdmin - creates the first super admin from NYOTA_ADMIN_USER and NYOTA_ADMIN_PASSWORD
+// together with its tenant NYOTA_ADMIN_TENANT. Nothing is changed when the user already exists,
+// so the variables can stay set and the password can be changed later through the users API.
+func bootstrapAdmin(st store.Store) error {
+	userName := sysutils.Getenv("NYOTA_ADMIN_USER", "")
+	password := sysutils.Getenv("NYOTA_ADMIN_PASSWORD", "")
+	if userName == "" || password == "" {
+		logutil.Debugf(nil, "NYOTA_ADMIN_USER or NYOTA_ADMIN_PASSWORD not set, skipping admin bootstrap")
+		return nil
+	}
+	tenantID := sysutils.Getenv("NYOTA_ADMIN_TENANT", "1")
+
+	permissions, _ := utils.RolePermissions(utils.SuperAdminUserRole)
+	user := &model.UserTenantDetails{UserName: userName, Password: password, TenantID: tenantID,
+		UserTenantAttributes: model.UserTenantAttributes{Role: utils.SuperAdminUserRole, Permissions: permissions}}
+	if err := user.Validate(); err != nil {
+		return errors.New("invalid bootstrap admin: " + err.Error())
+	}
+
+	hash, salt, err := auth.HashPassword(password)
+	if err != nil {
+		return err
+	}
+	user.Password, user.Salt = hash, salt
+
+	s := &model.SessionContext{User: &model.UserContext{TenantId: tenantID, UserName: userName, IsSuperAdmin: true}}
+	created, err := st.BootstrapAdmin(s, &config.Tenant{ID: tenantID, Name: tenantID}, user)
+	if err != nil {
+		return err
+	}
+	if created {
+		logutil.Printf(nil, "Bootstrap admin %s created in tenant %s", userName, tenantID)
+	}
+	return nil
+}
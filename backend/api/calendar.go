@@ -0,0 +1,327 @@
+package api
+
+import (
+	"fmt"
+	"goprizm/httputils"
+	"io"
+	"mime"
+	"net/http"
+	"nyota/backend/auth"
+	"nyota/backend/ical"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// Limits of calendar files: events of a feed, size and events of an imported file.
+const (
+	maxFeedEvents   = 1000
+	maxImportBytes  = 1 << 20
+	maxImportEvents = 500
+
+	calendarProdID   = "-//Nyota//Events//EN"
+	calendarFeedPath = "/api/v1/calendar/"
+)
+
+// getEventICS - event as iCalendar file.
+func (svc *Service) getEventICS(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Get Event iCalendar... Id=%v", id)
+	event, err := svc.Store.GetEventByID(s, id)
+	if err != nil {
+		logutil.Errorf(s, "Get Event Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	if event.EventDate.IsZero() {
+		utils.SetPreconditionFailedError(s, "key_event_date_missing")
+		return
+	}
+	events, ok := svc.icalEvents(s, event)
+	if !ok {
+		return
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="event-%d.ics"`, event.ID))
+	writeCalendar(s, w, &ical.Calendar{ProdID: calendarProdID, Events: events})
+}
+
+// getCalendarFeed - events of the owner of the feed token as iCalendar file. Calendar clients can't
+// log in, the token in the path authenticates them. Feeds stop working with their user and when the
+// user loses access to events.
+func (svc *Service) getCalendarFeed(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	id, secret, ok := auth.ParseCalendarToken(mux.Vars(req)["token"])
+	if !ok {
+		utils.SetNotFoundError(s)
+		return
+	}
+	feed, err := svc.Store.GetCalendarFeedByID(id)
+	if err != nil || !auth.CheckTokenSecret(secret, feed.Hash) {
+		logutil.Printf(s, "Calendar feed request with unknown token %s", id)
+		utils.SetNotFoundError(s)
+		return
+	}
+	s.User.TenantId, s.User.UserName = feed.TenantID, feed.UserName
+	_, held, err := svc.UserPermissions(s, feed.UserName)
+	if err != nil || !utils.PermissionsWithin(map[string]string{utils.EventMenuPermissionKey: utils.ReadPermission}, held) {
+		logutil.Printf(s, "Calendar feed %s of %s without access to events: %v", id, feed.UserName, err)
+		utils.SetNotFoundError(s)
+		return
+	}
+	events, _, err := svc.Store.GetAllEvents(s, model.QuerySpec{Page: 1, PageSize: maxFeedEvents, Sort: "event_date", Desc: true})
+	if err != nil {
+		logutil.Errorf(s, "Get Calendar Feed Events Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+
+	cal := &ical.Calendar{ProdID: calendarProdID, Name: "Nyota - " + feed.UserName}
+	for _, event := range events {
+		if event.EventDate.IsZero() {
+			continue
+		}
+		events, ok := svc.icalEvents(s, event)
+		if !ok {
+			return
+		}
+		cal.Events = append(cal.Events, events...)
+	}
+	now := time.Now()
+	if feed.LastUsedAt == nil || now.Sub(*feed.LastUsedAt) > tokenTouchInterval {
+		if err := svc.Store.TouchCalendarFeed(feed.ID, now); err != nil {
+			logutil.Errorf(s, "Recording use of calendar feed %s failed: %v", feed.ID, err)
+		}
+	}
+	writeCalendar(s, w, cal)
+}
+
+// getCalendarFeedInfo - calendar feed of the logged in user, without token.
+func (svc *Service) getCalendarFeedInfo(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Get Calendar Feed...")
+	feed, err := svc.Store.GetCalendarFeed(s)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	httputils.ServeJSON(w, feed)
+}
+
+// AddCalendarFeed - new feed token of the logged in user, the URL of the previous one stops working.
+// Token and URL are returned once.
+func (svc *Service) AddCalendarFeed(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Add Calendar Feed Invoked")
+	var feed config.CalendarFeed
+	var err error
+	if feed.Token, feed.ID, feed.Hash, err = auth.NewCalendarToken(); err != nil {
+		logutil.Errorf(s, "Calendar token generation Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	if err := svc.Store.ReplaceCalendarFeed(s, &feed); err != nil {
+		logutil.Errorf(s, "Add Calendar Feed Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	feed.URL = publicURL(req) + calendarFeedPath + feed.Token + ".ics"
+	feed.Hash = ""
+	utils.SetAuditNew(s, feed.ID, &feed)
+	httputils.ServeJSONWithStatus(w, feed, http.StatusCreated)
+}
+
+// DeleteCalendarFeed - revokes the feed of the logged in user.
+func (svc *Service) DeleteCalendarFeed(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Delete Calendar Feed...")
+	existing, err := svc.Store.GetCalendarFeed(s)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	utils.SetAuditOld(s, existing)
+	if err := svc.Store.DeleteCalendarFeed(s); err != nil {
+		logutil.Errorf(s, "Delete Calendar Feed Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// ImportEvents - creates events of the user from the VEVENTs of an iCalendar file, sent as body or as
+// "file" of a form. Files are checked completely before the first event is created.
+func (svc *Service) ImportEvents(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Import Events Invoked")
+	req.Body = http.MaxBytesReader(w, req.Body, maxImportBytes)
+	var file io.Reader = req.Body
+	if mediaType, _, _ := mime.ParseMediaType(req.Header.Get(utils.HTTPContentTypeKey)); mediaType == "multipart/form-data" {
+		part, _, err := req.FormFile("file")
+		if err != nil {
+			utils.SetParsingError(s, err)
+			return
+		}
+		defer part.Close()
+		file = part
+	}
+	cal, err := ical.Parse(file)
+	if err != nil {
+		logutil.Printf(s, "Import of calendar file refused: %v", err)
+		utils.SetPreconditionFailedError(s, "key_ics_invalid")
+		return
+	}
+	if len(cal.Events) == 0 {
+		utils.SetPreconditionFailedError(s, "key_ics_no_events")
+		return
+	}
+	if len(cal.Events) > maxImportEvents {
+		utils.SetPreconditionFailedError(s, "key_ics_too_many_events")
+		return
+	}
+
+	// Occurrences of recurring events of the file moved by other VEVENTs of their UID.
+	recurring := make(map[string]*config.Event)
+	for _, icalEvent := range cal.Events {
+		if icalEvent.RRule != "" && icalEvent.RecurrenceID.IsZero() {
+			recurring[icalEvent.UID] = nil
+		}
+	}
+	var events []*config.Event
+	var moved []*ical.Event
+	for _, icalEvent := range cal.Events {
+		if _, ok := recurring[icalEvent.UID]; ok && !icalEvent.RecurrenceID.IsZero() {
+			moved = append(moved, icalEvent)
+			continue
+		}
+		event := importedEvent(s, icalEvent)
+		if err := event.Validate(); err != nil {
+			logutil.Printf(s, "Import of calendar file refused, event %s: %v", icalEvent.UID, err)
+			utils.SetPreconditionFailedError(s, "key_ics_invalid")
+			return
+		}
+		if event.RRule != "" {
+			recurring[icalEvent.UID] = event
+		}
+		events = append(events, event)
+	}
+
+	imported := config.EventImport{Events: make([]*config.Event, 0, len(events))}
+	for _, event := range events {
+		if err := svc.Store.UpsertEvent(s, event); err != nil {
+			logutil.Errorf(s, "Import Event %v Error - %v, %d events imported", event.Detail["ical_uid"], err, len(imported.Events))
+			utils.SetStoreError(s, err)
+			return
+		}
+		updateEvent(s, svc, event)
+		imported.Events = append(imported.Events, event)
+	}
+	for _, icalEvent := range moved {
+		event := recurring[icalEvent.UID]
+		if event == nil || !eventOccurs(event, icalEvent.RecurrenceID) {
+			logutil.Printf(s, "Import of moved occurrence %s of %s skipped, the event has no such occurrence", icalEvent.RecurrenceID, icalEvent.UID)
+			continue
+		}
+		occurrence := &config.EventOccurrence{EventID: event.ID, TenantID: event.TenantID,
+			RecurrenceID: icalEvent.RecurrenceID.UTC(), Start: icalEvent.Start.UTC()}
+		if err := svc.Store.UpsertEventOccurrence(s, occurrence); err != nil {
+			logutil.Errorf(s, "Import Event Occurrence %s Error - %v", icalEvent.UID, err)
+			utils.SetStoreError(s, err)
+			return
+		}
+	}
+	utils.SetAuditNew(s, imported.IDs(), &imported)
+	httputils.ServeJSONWithStatus(w, imported, http.StatusCreated)
+}
+
+func writeCalendar(s *model.SessionContext, w http.ResponseWriter, cal *ical.Calendar) {
+	w.Header().Set(utils.HTTPContentTypeKey, ical.ContentType)
+	if err := cal.Write(w, time.Now()); err != nil {
+		logutil.Errorf(s, "Writing calendar failed: %v", err)
+	}
+}
+
+// icalEvents - VEVENTs of event, the recurring event and one for each of its moved occurrences.
+// Cancelled occurrences are excluded from the rule. Error is set to session when overrides can't be
+// read.
+func (svc *Service) icalEvents(s *model.SessionContext, event *config.Event) ([]*ical.Event, bool) {
+	master := icalEvent(event)
+	if event.RRule == "" {
+		return []*ical.Event{master}, true
+	}
+	overrides, err := svc.Store.GetEventOccurrences(s, strconv.Itoa(event.ID))
+	if err != nil {
+		logutil.Errorf(s, "Get Event Occurrences Error - %v", err)
+		utils.SetStoreError(s, err)
+		return nil, false
+	}
+	// Rules repeat the time of day in the zone of the event, the start is written in it.
+	location := event.Location()
+	master.Start, master.RRule = event.EventDate.In(location), event.RRule
+	for _, exDate := range event.ExDates {
+		master.ExDates = append(master.ExDates, exDate.In(location))
+	}
+	events := []*ical.Event{master}
+	for _, override := range overrides {
+		if !eventOccurs(event, override.RecurrenceID) {
+			continue
+		}
+		if override.Cancelled {
+			master.ExDates = append(master.ExDates, override.RecurrenceID.In(location))
+			continue
+		}
+		occurrence := *master
+		occurrence.RRule, occurrence.ExDates = "", nil
+		occurrence.RecurrenceID, occurrence.Start = override.RecurrenceID.In(location), override.Start.In(location)
+		events = append(events, &occurrence)
+	}
+	return events, true
+}
+
+// icalEvent - VEVENT of event. Times are written in UTC, clients show them in the zone of the user.
+// Every update of the event raises its sequence, the seconds from creation to the last update.
+func icalEvent(event *config.Event) *ical.Event {
+	sequence := int(event.UpdatedAt.Sub(event.AddedAt) / time.Second)
+	if sequence < 0 {
+		sequence = 0
+	}
+	location, _ := event.Detail["location"].(string)
+	return &ical.Event{
+		UID:          "event-" + strconv.Itoa(event.ID) + "@nyota",
+		Summary:      event.Name,
+		Description:  event.Description,
+		Location:     location,
+		Start:        event.EventDate,
+		Sequence:     sequence,
+		Created:      event.AddedAt,
+		LastModified: event.UpdatedAt,
+	}
+}
+
+// importedEvent - new event of the session user for VEVENT. Location and UID are kept in the detail,
+// recurring events keep the zone of their start.
+func importedEvent(s *model.SessionContext, icalEvent *ical.Event) *config.Event {
+	event := &config.Event{
+		Name:        icalEvent.Summary,
+		Description: icalEvent.Description,
+		EventDate:   icalEvent.Start,
+		RRule:       icalEvent.RRule,
+		Detail:      map[string]interface{}{},
+		UserName:    s.User.UserName,
+		TenantID:    s.User.TenantId,
+	}
+	if icalEvent.Location != "" {
+		event.Detail["location"] = icalEvent.Location
+	}
+	if icalEvent.UID != "" {
+		event.Detail["ical_uid"] = icalEvent.UID
+	}
+	if zone := icalEvent.Start.Location().String(); zone != "Local" {
+		if _, err := time.LoadLocation(zone); err == nil {
+			event.TimeZone = zone
+		}
+	}
+	for _, exDate := range icalEvent.ExDates {
+		event.ExDates = append(event.ExDates, exDate.UTC())
+	}
+	return event
+}
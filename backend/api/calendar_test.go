@@ -0,0 +1,134 @@
+package api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"nyota/backend/ical"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+)
+
+// getCalendar - GET of url without session, the parsed calendar or nil with the status.
+func getCalendar(t *testing.T, url string) (*ical.Calendar, int) {
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s failed: %v", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp.StatusCode
+	}
+	if resp.Header.Get(utils.HTTPContentTypeKey) != ical.ContentType {
+		t.Errorf("Expected %s, got %s", ical.ContentType, resp.Header.Get(utils.HTTPContentTypeKey))
+	}
+	cal, err := ical.Parse(resp.Body)
+	if err != nil {
+		t.Fatalf("Expected valid calendar from %s, got %v", url, err)
+	}
+	return cal, resp.StatusCode
+}
+
+func TestEventCalendar(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+
+	start := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	var event config.Event
+	c.do(utils.HttpPost, "/events", map[string]interface{}{"event_name": "Launch, v2", "event_date": start,
+		"username": "admin@nyota.com", "event_detail": map[string]interface{}{"location": "Hall 2"}}, &event)
+	req := c.newRequest(utils.HttpGet, "/events/"+strconv.Itoa(event.ID)+".ics", nil)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		t.Fatalf("GET ics failed: %v", err)
+	}
+	cal, err := ical.Parse(resp.Body)
+	resp.Body.Close()
+	if err != nil || len(cal.Events) != 1 {
+		t.Fatalf("Expected calendar with the event, got %v %v", cal, err)
+	}
+	if exported := cal.Events[0]; exported.Summary != "Launch, v2" || exported.Location != "Hall 2" || !exported.Start.Equal(start) {
+		t.Errorf("Unexpected VEVENT %+v", exported)
+	}
+	sequence := cal.Events[0].Sequence
+
+	var feed config.CalendarFeed
+	if code := c.do(utils.HttpPost, "/calendar/feed", nil, &feed); code != http.StatusCreated || !strings.HasSuffix(feed.URL, feed.Token+".ics") {
+		t.Fatalf("Expected feed URL, got %d %+v", code, feed)
+	}
+	time.Sleep(1100 * time.Millisecond) // sequence counts seconds
+	event.Name = "Launch v2"
+	c.do(utils.HttpPut, "/events/"+strconv.Itoa(event.ID), event, nil)
+	cal, code := getCalendar(t, feed.URL)
+	if code != http.StatusOK || len(cal.Events) != 1 || cal.Events[0].Summary != "Launch v2" || cal.Events[0].Sequence <= sequence {
+		t.Fatalf("Expected updated event with higher sequence in feed, got %d %+v", code, cal)
+	}
+	var info config.CalendarFeed
+	if c.do(utils.HttpGet, "/calendar/feed", nil, &info); info.ID != feed.ID || info.Token != "" || info.LastUsedAt == nil {
+		t.Errorf("Expected feed without token and with last use, got %+v", info)
+	}
+
+	var rotated config.CalendarFeed
+	c.do(utils.HttpPost, "/calendar/feed", nil, &rotated)
+	if _, code := getCalendar(t, feed.URL); code != http.StatusNotFound {
+		t.Errorf("Expected replaced feed 404, got %d", code)
+	}
+	if _, code := getCalendar(t, rotated.URL[:len(rotated.URL)-8]+"xxxx.ics"); code != http.StatusNotFound {
+		t.Errorf("Expected wrong secret 404, got %d", code)
+	}
+	c.do(utils.HttpDelete, "/calendar/feed", nil, nil)
+	if _, code := getCalendar(t, rotated.URL); code != http.StatusNotFound {
+		t.Errorf("Expected revoked feed 404, got %d", code)
+	}
+}
+
+func TestImportEvents(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+
+	file := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nBEGIN:VEVENT\r\nUID:abc@example.com\r\nSUMMARY:Board meeting\r\n" +
+		"LOCATION:Room 1\r\nDTSTART;TZID=Africa/Nairobi:20260610T090000\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	req := c.newRequest(utils.HttpPost, "/events/import", strings.NewReader(file))
+	req.Header.Set(utils.HTTPContentTypeKey, "text/calendar")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d %s", resp.StatusCode, body)
+	}
+
+	var events config.EventList
+	c.do(utils.HttpGet, "/events", nil, &events)
+	if len(events.Events) != 1 {
+		t.Fatalf("Expected imported event of user, got %+v", events.Events)
+	}
+	imported := events.Events[0]
+	if imported.Name != "Board meeting" || imported.Detail["location"] != "Room 1" || imported.Detail["ical_uid"] != "abc@example.com" ||
+		!imported.EventDate.Equal(time.Date(2026, 6, 10, 6, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected imported event %+v", imported)
+	}
+
+	for _, invalid := range []string{"not a calendar", "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n",
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART:20260101T090000Z\r\nRRULE:FREQ=HOURLY\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"} {
+		req := c.newRequest(utils.HttpPost, "/events/import", strings.NewReader(invalid))
+		resp, err := c.client.Do(req)
+		if err != nil {
+			t.Fatalf("Import failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 for %q, got %d", invalid, resp.StatusCode)
+		}
+	}
+}
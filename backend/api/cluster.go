@@ -17,7 +17,11 @@ import (
 
 func (svc *Service) getClusters(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
 	logutil.Debugf(s, "Service layer - Get All Clusters invoked...")
-	data, err := getAllClusters(svc.Store, s)
+	spec, ok := utils.ParseQuerySpec(s, req.URL.Query(), uicomponent.GetClusterGridViewColumns(s))
+	if !ok {
+		return
+	}
+	data, total, err := svc.Store.GetClusters(s, spec)
 	if err != nil {
 		logutil.Errorf(s, "Error - %v", err)
 		utils.SetSomethingWrong(s)
@@ -25,12 +29,15 @@ func (svc *Service) getClusters(s *model.SessionContext, w http.ResponseWriter,
 		for _, cluster := range data {
 			updateCluster(s, svc, cluster)
 		}
+		utils.SetTotalCount(w, total)
 		httputils.ServeJSON(w, data)
 	}
 }
 
-func getAllClusters(store *store.Store, s *model.SessionContext) ([]*config.Cluster, error) {
-	return store.GetClusters(s)
+// getAllClusters - clusters of tenant for selection in forms, at most utils.MaxPageSize.
+func getAllClusters(store store.Store, s *model.SessionContext) ([]*config.Cluster, error) {
+	clusters, _, err := store.GetClusters(s, model.QuerySpec{Page: 1, PageSize: utils.MaxPageSize})
+	return clusters, err
 }
 
 func (svc *Service) getClusterByID(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
@@ -39,9 +46,10 @@ func (svc *Service) getClusterByID(s *model.SessionContext, w http.ResponseWrite
 	data, err := svc.Store.GetClusterById(s, id)
 	if err != nil {
 		logutil.Errorf(s, "Get Cluster Error - %v", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
 		updateCluster(s, svc, data)
+		setETag(w, data.Version)
 		httputils.ServeJSON(w, uicomponent.GetClusterConfigFormatter(s, data))
 	}
 }
@@ -71,12 +79,25 @@ func (svc *Service) UpsertCluster(s *model.SessionContext, w http.ResponseWriter
 		return
 	}
 	logutil.Debugf(s, "Cluster object - %v ", cluster)
+	if cluster.ID != 0 {
+		if !ifMatchUpdate(s, req, &cluster.Version) {
+			return
+		}
+		existing, err := svc.Store.GetClusterById(s, strconv.Itoa(cluster.ID))
+		if err != nil {
+			utils.SetStoreError(s, err)
+			return
+		}
+		utils.SetAuditOld(s, existing)
+	}
 	_, err := svc.Store.UpsertCluster(s, &cluster)
 	if err != nil {
 		logutil.Errorf(s, "Upsert Cluster Error - ", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
 		//go svc.Store.Watcher.Notify("event", model.Event{cluster.ID, "ccc_cluster"})
+		utils.SetAuditNew(s, strconv.Itoa(cluster.ID), &cluster)
+		setETag(w, cluster.Version)
 		w.WriteHeader(http.StatusCreated)
 	}
 }
@@ -84,15 +105,30 @@ func (svc *Service) UpsertCluster(s *model.SessionContext, w http.ResponseWriter
 func (svc *Service) DeleteCluster(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
 	id := mux.Vars(req)["id"]
 	logutil.Debugf(s, "Service layer - Delete Cluster by id... Id=", id)
-	err := svc.Store.DeleteClusterById(s, id)
+	existing, err := svc.Store.GetClusterById(s, id)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	if !checkIfMatch(s, req, existing.Version) {
+		return
+	}
+	utils.SetAuditOld(s, existing)
+	err = svc.Store.DeleteClusterById(s, id)
 	if err != nil {
 		logutil.Errorf(s, "Delete Cluster Error - ", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
 		w.WriteHeader(http.StatusOK)
 	}
 }
 
+// isTenantCluster - checks the cluster exists and is visible to the logged in user.
+func (svc *Service) isTenantCluster(s *model.SessionContext, id int) bool {
+	_, err := svc.Store.GetClusterById(s, strconv.Itoa(id))
+	return err == nil
+}
+
 func getEpoc(time time.Time) int64 {
 	tm := time.Unix()
 	if tm > 0 {
@@ -100,3 +136,18 @@ func getEpoc(time time.Time) int64 {
 	}
 	return 0
 }
+
+func (svc *Service) getClusterSyncStatus(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Get Cluster Sync Status... Id=%v", id)
+	data, err := svc.Store.GetClusterSyncStatus(s, id)
+	if err != nil {
+		logutil.Errorf(s, "Get Cluster Sync Status Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	if data == nil {
+		data = []*config.OutboxEvent{}
+	}
+	httputils.ServeJSON(w, data)
+}
@@ -9,13 +9,18 @@ import (
 	"encoding/json"
 	"goprizm/httputils"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 )
 
 func (svc *Service) getCPPMNodes(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
 	logutil.Debugf(s, "Service layer - Get All CPPM Nodes invoked...")
-	data, err := svc.Store.GetCPPMNodes(s)
+	spec, ok := utils.ParseQuerySpec(s, req.URL.Query(), uicomponent.GetCppmNodeGridViewColumns(s))
+	if !ok {
+		return
+	}
+	data, total, err := svc.Store.GetCPPMNodes(s, spec)
 	if err != nil {
 		logutil.Errorf(s, "Error - %v", err)
 		utils.SetSomethingWrong(s)
@@ -23,6 +28,7 @@ func (svc *Service) getCPPMNodes(s *model.SessionContext, w http.ResponseWriter,
 		for _, cppmNode := range data {
 			updateCppmNode(cppmNode)
 		}
+		utils.SetTotalCount(w, total)
 		httputils.ServeJSON(w, data)
 	}
 }
@@ -48,7 +54,7 @@ func (svc *Service) getCPPMNodeById(s *model.SessionContext, w http.ResponseWrit
 	data, err := svc.Store.GetCPPMNodeById(s, id)
 	if err != nil {
 		logutil.Errorf(s, "Get CPPM Node Error - %v", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
 		clusters, cerr := getAllClusters(svc.Store, s)
 		if cerr != nil {
@@ -56,6 +62,7 @@ func (svc *Service) getCPPMNodeById(s *model.SessionContext, w http.ResponseWrit
 			utils.SetSomethingWrong(s)
 		} else {
 			updateCppmNode(data)
+			setETag(w, data.Version)
 			httputils.ServeJSON(w, uicomponent.GetCPPMNodeConfigFormatter(s, data, clusters))
 		}
 	}
@@ -85,12 +92,47 @@ func (svc *Service) UpsertCPPMNode(s *model.SessionContext, w http.ResponseWrite
 	if nil != s.Err {
 		return
 	}
+	if cppmNode.ID != 0 && !ifMatchUpdate(s, req, &cppmNode.Version) {
+		return
+	}
+	svc.saveCPPMNode(s, w, &cppmNode)
+}
+
+func (svc *Service) AddClusterCPPMNode(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	clusterID := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Add CPPM Node to Cluster Invoked... Cluster Id = %v", clusterID)
+	var cppmNode config.CppmNode
+	utils.DecodeAndValidate(s, w, req, &cppmNode)
+	if nil != s.Err {
+		return
+	}
+	cppmNode.ClusterID, _ = strconv.Atoi(clusterID)
+	svc.saveCPPMNode(s, w, &cppmNode)
+}
+
+func (svc *Service) saveCPPMNode(s *model.SessionContext, w http.ResponseWriter, cppmNode *config.CppmNode) {
+	if cppmNode.ID != 0 {
+		existing, err := svc.Store.GetCPPMNodeById(s, strconv.Itoa(cppmNode.ID))
+		if err != nil {
+			utils.SetStoreError(s, err)
+			return
+		}
+		utils.SetAuditOld(s, existing)
+	}
+	// Node can be attached only to a cluster of the same tenant.
+	if !svc.isTenantCluster(s, cppmNode.ClusterID) {
+		logutil.Errorf(s, "Upsert CPPM Node Error - invalid cluster %d", cppmNode.ClusterID)
+		utils.SetPreconditionFailedError(s, "key_cluster_invalid")
+		return
+	}
 	logutil.Debugf(s, "CPPM Node object - %v ", cppmNode)
-	_, err := svc.Store.UpsertCPPMNode(s, &cppmNode)
+	_, err := svc.Store.UpsertCPPMNode(s, cppmNode)
 	if err != nil {
 		logutil.Errorf(s, "Upsert CPPM Node Error - %v", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
+		utils.SetAuditNew(s, strconv.Itoa(cppmNode.ID), cppmNode)
+		setETag(w, cppmNode.Version)
 		w.WriteHeader(http.StatusCreated)
 	}
 }
@@ -98,69 +140,121 @@ func (svc *Service) UpsertCPPMNode(s *model.SessionContext, w http.ResponseWrite
 func (svc *Service) DeleteCPPMNode(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
 	id := mux.Vars(req)["id"]
 	logutil.Debugf(s, "Service layer - Delete CPPM Node by ID... Id = %v", id)
-	err := svc.Store.DeleteCPPMNode(s, id)
+	existing, err := svc.Store.GetCPPMNodeById(s, id)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	if !checkIfMatch(s, req, existing.Version) {
+		return
+	}
+	utils.SetAuditOld(s, existing)
+	err = svc.Store.DeleteCPPMNode(s, id)
 	if err != nil {
 		logutil.Errorf(s, "Delete CPPM Node Error - %v", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
 		w.WriteHeader(http.StatusOK)
 	}
 }
 
+// ExecuteEvent - callback of CPPM cluster with the result of a synced entity. Tenant and cluster
+// are those of the credential the request is signed with, the body may not claim others.
 func (svc *Service) ExecuteEvent(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
 	event := model.Event{}
 	decoder := json.NewDecoder(req.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&event); err != nil {
+		logutil.Errorf(s, "Invalid event - %v", err)
+		utils.SetPreconditionFailedError(s, "key_event_invalid")
+		return
+	}
 
-	err := decoder.Decode(&event)
+	cluster, err := svc.Store.GetClusterById(s, strconv.Itoa(s.User.ClusterID))
+	if err != nil {
+		logutil.Errorf(s, "Cluster of credential not found - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	if event.UUID != cluster.UUID || (event.TenantID != "" && event.TenantID != cluster.TenantID) {
+		logutil.Errorf(s, "Event for cluster %s tenant %s signed by cluster %s", event.UUID, event.TenantID, cluster.UUID)
+		utils.SetForbiddenError(s)
+		return
+	}
+	event.TenantID = cluster.TenantID
 
-	s = &model.SessionContext{}
-	user := &model.UserContext{}
-	user.TenantId = event.TenantID
-	user.UserName = "abc"
-	s.User = user
+	logutil.Debugf(s, "Service layer - Execute Event %s for cluster %s", event.Data.EntityName, cluster.UUID)
+	switch event.Data.EntityName {
+	case "Role":
+		svc.executeMappedEvent(s, cluster, event, svc.Store.UpdateRoleWithCPPMID)
+	case "Event":
+		svc.executeMappedEvent(s, cluster, event, svc.Store.UpdateEventWithCPPMID)
+	case "cppmnode":
+		svc.executeCPPMNodeEvent(s, cluster, event)
+	default:
+		logutil.Errorf(s, "Unknown event entity %q", event.Data.EntityName)
+		utils.SetPreconditionFailedError(s, "key_event_entity_invalid")
+	}
+	if s.Err == nil {
+		httputils.ServeJSON(w, model.Event{})
+	}
+}
 
-	logutil.Debugf(s, "Service layer - Add / Update CPPM Node Invoked")
+// executeMappedEvent - records the CPPM id of an entity synced to the cluster it is mapped to with
+// updateCPPMID.
+func (svc *Service) executeMappedEvent(s *model.SessionContext, cluster *config.Cluster, event model.Event,
+	updateCPPMID func(s *model.SessionContext, id int, uuid string, cppmID int) error) {
+	logutil.Debugf(s, "CCCID:%d CPPMID:%d", event.Data.CccID, event.Data.CppmID)
+	if event.Data.CccID <= 0 || (event.Data.CppmID <= 0 && event.Data.Method != utils.HttpDelete) {
+		utils.SetPreconditionFailedError(s, "key_event_invalid")
+		return
+	}
+	// Entity no longer exists once its delete is acknowledged. It may also have been deleted or
+	// unmapped while the event was on its way, the delivery is acknowledged all the same.
+	if event.Data.Method != utils.HttpDelete {
+		err := updateCPPMID(s, event.Data.CccID, cluster.UUID, event.Data.CppmID)
+		if model.IsNotFound(err) {
+			logutil.Debugf(s, "%s %d no longer mapped to cluster %s", event.Data.EntityName, event.Data.CccID, cluster.UUID)
+		} else if err != nil {
+			utils.SetStoreError(s, err)
+			return
+		}
+	}
+	err := svc.Store.AckOutboxEvent(s, cluster.ID, event.Data.EventID, event.Data.EntityName, event.Data.CccID)
+	if model.IsNotFound(err) {
+		logutil.Debugf(s, "No outstanding sync event %d for %s %d", event.Data.EventID, event.Data.EntityName, event.Data.CccID)
+	} else if err != nil {
+		logutil.Errorf(s, "Sync event ack failed - %v", err)
+		utils.SetSomethingWrong(s)
+	}
+}
 
-	if err == nil {
-		logutil.Debugf(s, "Entity Name:%s", event.Data.EntityName)
-		switch event.Data.EntityName {
-		case "Role":
-			logutil.Debugf(s, "CCCID:%d", event.Data.CccID)
-			logutil.Debugf(s, "CPPMID:%d", event.Data.CppmID)
-			logutil.Debugf(s, "event:%v", event)
-			if event.Data.CccID != 0 {
-				svc.Store.UpdateRoleWithCPPMID(s, event.Data.CccID, event.UUID, event.Data.CppmID)
-			}
-			break
-		case "cppmnode":
-			uuid := event.UUID
-			tenantID := event.TenantID
-			var cppmNodes []config.CppmNode
-			payload := event.Data.Payload
-			payloadByte, _ := json.Marshal(payload)
-			json.Unmarshal(payloadByte, &cppmNodes)
-			logutil.Debugf(s, "CPPM Node object Array - %v ", cppmNodes)
-			logutil.Debugf(s, "length of cppmnodes:%d", len(cppmNodes))
-
-			//insert/update cluster table
-			cluster := svc.Store.GetClusterByUUID(s, uuid)
-			if nil == cluster {
-				cluster = svc.Store.CreateAndFetchCluster(s, event)
-			}
-
-			for _, cppmNode := range cppmNodes {
-				logutil.Debugf(s, "CPPM Node object - %v ", cppmNode)
-				cppmNode.TenantID = tenantID
-				cppmNode.ClusterID = cluster.ID
-				logutil.Debugf(s, "new CPPM Node object - %v ", cppmNode)
-				err := svc.Store.UpsertCPPMNodeEvent(s, &cppmNode)
-				if err != nil {
-					logutil.Errorf(s, "Upsert CPPM Node(%s) , error: %v", cppmNode.ServerIP, err)
-				}
-			}
-			break
+// executeCPPMNodeEvent - inserts or updates the nodes reported by the cluster in one transaction.
+func (svc *Service) executeCPPMNodeEvent(s *model.SessionContext, cluster *config.Cluster, event model.Event) {
+	var cppmNodes []config.CppmNode
+	payloadByte, _ := json.Marshal(event.Data.Payload)
+	if err := json.Unmarshal(payloadByte, &cppmNodes); err != nil || len(cppmNodes) == 0 {
+		logutil.Errorf(s, "Invalid CPPM node payload - %v", err)
+		utils.SetPreconditionFailedError(s, "key_event_invalid")
+		return
+	}
+	nodes := make([]*config.CppmNode, len(cppmNodes))
+	for i := range cppmNodes {
+		cppmNode := &cppmNodes[i]
+		nodes[i] = cppmNode
+		if err := cppmNode.Validate(); err != nil || cppmNode.ServerUUID == "" {
+			logutil.Errorf(s, "Invalid CPPM node(%s) - %v", cppmNode.ServerIP, err)
+			utils.SetPreconditionFailedError(s, "key_event_invalid")
+			return
 		}
+		cppmNode.ID = 0
+		cppmNode.TenantID = cluster.TenantID
+		cppmNode.ClusterID = cluster.ID
+	}
+
+	// Nodes of a report are stored together, a failure leaves none of them changed.
+	if err := svc.Store.UpsertCPPMNodeEvents(s, nodes); err != nil {
+		logutil.Errorf(s, "Upsert CPPM Nodes of cluster %s , error: %v", cluster.UUID, err)
+		utils.SetStoreError(s, err)
 	}
-	event = model.Event{}
-	httputils.ServeJSON(w, event)
 }
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"goprizm/httputils"
+	"net/http"
+	"nyota/backend/auth"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+func (svc *Service) getClusterCredentials(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	clusterID := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Get Cluster Credentials... Cluster Id = %v", clusterID)
+	if _, err := svc.Store.GetClusterById(s, clusterID); err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	data, err := svc.Store.GetClusterCredentials(s, clusterID)
+	if err != nil {
+		logutil.Errorf(s, "Get Cluster Credentials Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	if data == nil {
+		data = make([]*config.ClusterCredential, 0)
+	}
+	httputils.ServeJSON(w, data)
+}
+
+// AddClusterCredential - creates key and secret the cluster signs its callbacks with. Secret
+// is only part of this response, it has to be configured on the cluster right away.
+func (svc *Service) AddClusterCredential(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	clusterID, _ := strconv.Atoi(mux.Vars(req)["id"])
+	logutil.Debugf(s, "Service layer - Add Cluster Credential... Cluster Id = %v", clusterID)
+	keyID, err := auth.RandomToken(12)
+	if err != nil {
+		utils.SetSomethingWrong(s)
+		return
+	}
+	secret, err := auth.RandomToken(32)
+	if err != nil {
+		utils.SetSomethingWrong(s)
+		return
+	}
+	credential := &config.ClusterCredential{KeyID: keyID, ClusterID: clusterID, TenantID: s.User.TenantId, Secret: secret}
+	if err := svc.Store.AddClusterCredential(s, credential); err != nil {
+		logutil.Errorf(s, "Add Cluster Credential Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	// Secret is never part of the audit log.
+	s.AuditID = credential.KeyID
+	httputils.ServeJSONWithStatus(w, credential, http.StatusCreated)
+}
+
+func (svc *Service) DeleteClusterCredential(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	clusterID, keyID := mux.Vars(req)["id"], mux.Vars(req)["keyId"]
+	logutil.Debugf(s, "Service layer - Delete Cluster Credential... Cluster Id = %v Key = %v", clusterID, keyID)
+	if err := svc.Store.DeleteClusterCredential(s, clusterID, keyID); err != nil {
+		logutil.Errorf(s, "Delete Cluster Credential Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
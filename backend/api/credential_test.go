@@ -0,0 +1,148 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"nyota/backend/auth"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+)
+
+// signedEvent - posts event to the callback endpoint signed with key and secret at the given time.
+func (c *testClient) signedEvent(keyID, secret string, event interface{}, now time.Time, tamper func(*http.Request)) int {
+	body, _ := json.Marshal(event)
+	req, _ := http.NewRequest(utils.HttpPost, c.server.URL+"/api/v1/event", nil)
+	req.Header.Set(utils.HTTPContentTypeKey, utils.HTTPContentJSONValue)
+	if err := auth.SignRequest(req, keyID, secret, body, now); err != nil {
+		c.t.Fatalf("SignRequest failed: %v", err)
+	}
+	if tamper != nil {
+		tamper(req)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		c.t.Fatalf("POST /event failed: %v", err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+// addCluster - creates cluster with a credential as admin of tenant, returns cluster id and credential.
+func (c *testClient) addCluster(name, uuid string) (int, config.ClusterCredential) {
+	if code := c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": name, "uuid": uuid, "cppm_version": "6.7"}, nil); code != http.StatusCreated {
+		c.t.Fatalf("Expected cluster create 201, got %d", code)
+	}
+	cluster := c.store.GetClusterByUUID(nil, uuid)
+	credential := config.ClusterCredential{}
+	path := "/clusters/" + strconv.Itoa(cluster.ID) + "/credentials"
+	if code := c.do(utils.HttpPost, path, nil, &credential); code != http.StatusCreated {
+		c.t.Fatalf("Expected credential create 201, got %d", code)
+	}
+	return cluster.ID, credential
+}
+
+func nodeEvent(uuid, tenantID string) model.Event {
+	return model.Event{UUID: uuid, TenantID: tenantID, Data: model.EventData{EntityName: "cppmnode", Payload: []map[string]interface{}{
+		{"cppm_version": "6.7", "server_ip": "10.1.1.1", "management_ip": "10.1.1.2", "server_uuid": "s-1"}}}}
+}
+
+func TestClusterCredentialSecretShownOnce(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+
+	clusterID, credential := c.addCluster("east", "u-1")
+	if credential.KeyID == "" || len(credential.Secret) < 32 || credential.ClusterID != clusterID {
+		t.Fatalf("Expected key and secret of new credential, got %+v", credential)
+	}
+	path := "/clusters/" + strconv.Itoa(clusterID) + "/credentials"
+	var credentials []config.ClusterCredential
+	c.do(utils.HttpGet, path, nil, &credentials)
+	if len(credentials) != 1 || credentials[0].KeyID != credential.KeyID || credentials[0].Secret != "" {
+		t.Fatalf("Expected listed credential without secret, got %+v", credentials)
+	}
+	if code := c.do(utils.HttpGet, "/clusters/9999/credentials", nil, nil); code != http.StatusNotFound {
+		t.Errorf("Expected 404 for credentials of unknown cluster, got %d", code)
+	}
+	if code := c.do(utils.HttpDelete, path+"/"+credential.KeyID, nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected credential delete 200, got %d", code)
+	}
+	if code := c.signedEvent(credential.KeyID, credential.Secret, nodeEvent("u-1", ""), time.Now(), nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected revoked credential to be rejected, got %d", code)
+	}
+}
+
+func TestSignedEvent(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+	clusterID, credential := c.addCluster("east", "u-1")
+	event := nodeEvent("u-1", "")
+
+	if code := c.signedEvent(credential.KeyID, credential.Secret, event, time.Now(), nil); code != http.StatusOK {
+		t.Fatalf("Expected signed event 200, got %d", code)
+	}
+	var nodes []map[string]interface{}
+	c.do(utils.HttpGet, "/clusters/"+strconv.Itoa(clusterID)+"/nodes", nil, &nodes)
+	if len(nodes) != 1 || nodes[0]["tenant_id"] != "1" {
+		t.Fatalf("Expected node added to cluster by event, got %v", nodes)
+	}
+
+	tests := []struct {
+		name   string
+		secret string
+		event  interface{}
+		now    time.Time
+		tamper func(*http.Request)
+		code   int
+	}{
+		{"missing signature", credential.Secret, event, time.Now(), func(r *http.Request) { r.Header.Del(auth.HeaderSignature) }, http.StatusUnauthorized},
+		{"wrong secret", "not-the-secret", event, time.Now(), nil, http.StatusUnauthorized},
+		{"stale timestamp", credential.Secret, event, time.Now().Add(-10 * time.Minute), nil, http.StatusUnauthorized},
+		{"future timestamp", credential.Secret, event, time.Now().Add(10 * time.Minute), nil, http.StatusUnauthorized},
+		{"other cluster", credential.Secret, nodeEvent("u-2", ""), time.Now(), nil, http.StatusForbidden},
+		{"other tenant", credential.Secret, nodeEvent("u-1", "2"), time.Now(), nil, http.StatusForbidden},
+		{"unknown field", credential.Secret, map[string]interface{}{"uuid": "u-1", "extra": true}, time.Now(), nil, http.StatusBadRequest},
+		{"unknown entity", credential.Secret, model.Event{UUID: "u-1", Data: model.EventData{EntityName: "Tenant"}}, time.Now(), nil, http.StatusBadRequest},
+		{"invalid role", credential.Secret, model.Event{UUID: "u-1", Data: model.EventData{EntityName: "Role"}}, time.Now(), nil, http.StatusBadRequest},
+		{"empty nodes", credential.Secret, model.Event{UUID: "u-1", Data: model.EventData{EntityName: "cppmnode", Payload: []interface{}{}}}, time.Now(), nil, http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		if code := c.signedEvent(credential.KeyID, test.secret, test.event, test.now, test.tamper); code != test.code {
+			t.Errorf("%s: expected %d, got %d", test.name, test.code, code)
+		}
+	}
+}
+
+func TestSignedEventReplay(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+	_, credential := c.addCluster("east", "u-1")
+
+	var nonce string
+	keep := func(r *http.Request) {
+		if nonce == "" {
+			nonce = r.Header.Get(auth.HeaderNonce)
+			return
+		}
+		timestamp := r.Header.Get(auth.HeaderTimestamp)
+		body, _ := json.Marshal(nodeEvent("u-1", ""))
+		r.Header.Set(auth.HeaderNonce, nonce)
+		r.Header.Set(auth.HeaderSignature, auth.Signature(credential.Secret, r.Method, r.URL.Path, timestamp, nonce, body))
+	}
+	if code := c.signedEvent(credential.KeyID, credential.Secret, nodeEvent("u-1", ""), time.Now(), keep); code != http.StatusOK {
+		t.Fatalf("Expected first event 200, got %d", code)
+	}
+	if code := c.signedEvent(credential.KeyID, credential.Secret, nodeEvent("u-1", ""), time.Now(), keep); code != http.StatusUnauthorized {
+		t.Errorf("Expected replayed nonce to be rejected, got %d", code)
+	}
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"nyota/backend/i18n"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+
+	"github.com/lib/pq"
+)
+
+// raw - sends body as is and decodes the error envelope of the response.
+func (c *testClient) raw(method, path, body string, header map[string]string) (*http.Response, model.AppError) {
+	req := c.newRequest(method, path, bytes.NewReader([]byte(body)))
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		c.t.Fatalf("%s %s failed: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+	var envelope model.AppError
+	if resp.StatusCode >= 400 {
+		if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+			c.t.Fatalf("%s %s expected JSON error envelope: %v", method, path, err)
+		}
+	}
+	return resp, envelope
+}
+
+func TestErrorEnvelope(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+
+	resp, envelope := c.raw(utils.HttpGet, "/clusters", "", map[string]string{utils.HTTPRequestIDKey: "req-42"})
+	if resp.StatusCode != http.StatusUnauthorized || resp.Header.Get(utils.HTTPContentTypeKey) != utils.HTTPContentJSONValue {
+		t.Fatalf("Expected JSON 401, got %d %s", resp.StatusCode, resp.Header.Get(utils.HTTPContentTypeKey))
+	}
+	if envelope.Code != http.StatusUnauthorized || envelope.Message != "Unauthorized" || envelope.RequestID != "req-42" ||
+		resp.Header.Get(utils.HTTPRequestIDKey) != "req-42" {
+		t.Errorf("Unexpected envelope %+v", envelope)
+	}
+
+	_, envelope = c.raw(utils.HttpGet, "/clusters", "", map[string]string{utils.HTTPRequestIDKey: "bad id/!"})
+	if envelope.RequestID == "" || envelope.RequestID == "bad id/!" {
+		t.Errorf("Expected generated request id for malformed one, got %q", envelope.RequestID)
+	}
+
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+	resp, envelope = c.raw(utils.HttpPost, "/clusters", `{"name": "east",`, nil)
+	if resp.StatusCode != http.StatusBadRequest || envelope.Message != "Request body is not valid JSON" {
+		t.Errorf("Expected 400 for malformed JSON, got %d %+v", resp.StatusCode, envelope)
+	}
+	resp, envelope = c.raw(utils.HttpPost, "/clusters", `{"name": 5}`, nil)
+	if resp.StatusCode != http.StatusBadRequest || envelope.Fields["name"] != "Value has the wrong type" {
+		t.Errorf("Expected 400 with field for wrong type, got %d %+v", resp.StatusCode, envelope)
+	}
+	resp, envelope = c.raw(utils.HttpPost, "/clusters", `{"name": ""}`, nil)
+	if resp.StatusCode != http.StatusUnprocessableEntity || envelope.Type != utils.ValidatationError ||
+		envelope.Message != "Some fields are invalid" || envelope.Fields["name"] == "" {
+		t.Errorf("Expected 422 with field errors, got %d %+v", resp.StatusCode, envelope)
+	}
+	resp, envelope = c.raw(utils.HttpGet, "/clusters/999", "", nil)
+	if resp.StatusCode != http.StatusNotFound || envelope.Message != "Not found" {
+		t.Errorf("Expected translated 404, got %d %+v", resp.StatusCode, envelope)
+	}
+}
+
+func TestStoreErrorStatus(t *testing.T) {
+	tests := []struct {
+		err  error
+		code int
+	}{
+		{&model.NotFoundError{Entity: "role", ID: "1"}, http.StatusNotFound},
+		{sql.ErrNoRows, http.StatusNotFound},
+		{&pq.Error{Code: "23505", Constraint: "ccc_cluster_uuid_idx"}, http.StatusConflict},
+		{&pq.Error{Code: "23503"}, http.StatusInternalServerError},
+		{errors.New("connection refused"), http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		s := &model.SessionContext{User: &model.UserContext{}}
+		s.TFunc = i18n.Translate(s)
+		utils.SetStoreError(s, test.err)
+		if s.Err.Code != test.code || s.Err.Message == "" {
+			t.Errorf("SetStoreError(%v) = %+v, expected %d", test.err, s.Err, test.code)
+		}
+	}
+}
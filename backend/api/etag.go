@@ -0,0 +1,73 @@
+package api
+
+import (
+	"net/http"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+	"strconv"
+	"strings"
+)
+
+// etag - strong entity tag of a config resource at version.
+func etag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// setETag - tags response with version of the resource it carries.
+func setETag(w http.ResponseWriter, version int) {
+	w.Header().Set(utils.HTTPETagKey, etag(version))
+}
+
+// notModified - answers 304 when If-None-Match of req has the tag of version. Only for resources
+// whose representation is fully given by their version, not those embedding related entities.
+func notModified(w http.ResponseWriter, req *http.Request, version int) bool {
+	return notModifiedTag(w, req, etag(version))
+}
+
+// notModifiedTag - answers 304 with entity tag when If-None-Match of req has it.
+func notModifiedTag(w http.ResponseWriter, req *http.Request, entityTag string) bool {
+	for _, tag := range strings.Split(req.Header.Get(utils.HTTPIfNoneMatchKey), ",") {
+		if tag = strings.TrimSpace(tag); tag == "*" || strings.TrimPrefix(tag, "W/") == entityTag {
+			w.Header().Set(utils.HTTPETagKey, entityTag)
+			w.WriteHeader(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion - version If-Match of req requires, 0 without If-Match or with "*". Tags which are
+// no version of ours can never match, the precondition fails for them.
+func ifMatchVersion(s *model.SessionContext, req *http.Request) (int, bool) {
+	tag := strings.TrimSpace(req.Header.Get(utils.HTTPIfMatchKey))
+	if tag == "" || tag == "*" {
+		return 0, true
+	}
+	version, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(tag, `"`), `"`))
+	if err != nil || version <= 0 || etag(version) != tag {
+		utils.SetVersionMismatchError(s)
+		return 0, false
+	}
+	return version, true
+}
+
+// checkIfMatch - If-Match of req allows a change of the resource at version, precondition failed
+// error otherwise.
+func checkIfMatch(s *model.SessionContext, req *http.Request, version int) bool {
+	expected, ok := ifMatchVersion(s, req)
+	if ok && expected != 0 && expected != version {
+		utils.SetVersionMismatchError(s)
+		return false
+	}
+	return ok
+}
+
+// ifMatchUpdate - bases the update of a resource on the version If-Match of req requires, the
+// version of the body is kept without If-Match.
+func ifMatchUpdate(s *model.SessionContext, req *http.Request, version *int) bool {
+	expected, ok := ifMatchVersion(s, req)
+	if ok && expected != 0 {
+		*version = expected
+	}
+	return ok
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+)
+
+func TestRoleVersionPreconditions(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+
+	role := config.Role{}
+	if code := c.do(utils.HttpPost, "/roles", map[string]interface{}{"name": "guest", "version": 7}, &role); code != http.StatusOK || role.Version != 1 {
+		t.Fatalf("Expected role created at version 1, got %d %+v", code, role)
+	}
+	rolePath := "/roles/" + strconv.Itoa(role.ID)
+	resp, _ := c.raw(utils.HttpGet, rolePath, "", nil)
+	if tag := resp.Header.Get(utils.HTTPETagKey); tag != `"1"` {
+		t.Fatalf("Expected ETag of version 1, got %q", tag)
+	}
+
+	// Second admin saving the version both loaded loses against the first.
+	ifMatch := map[string]string{utils.HTTPIfMatchKey: `"1"`}
+	resp, _ = c.raw(utils.HttpPut, rolePath, `{"name": "visitor"}`, ifMatch)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get(utils.HTTPETagKey) != `"2"` {
+		t.Fatalf("Expected update of version 1 to version 2, got %d %q", resp.StatusCode, resp.Header.Get(utils.HTTPETagKey))
+	}
+	resp, envelope := c.raw(utils.HttpPut, rolePath, `{"name": "staff"}`, ifMatch)
+	if resp.StatusCode != http.StatusPreconditionFailed || envelope.Message == "" {
+		t.Errorf("Expected 412 for update of outdated version, got %d %+v", resp.StatusCode, envelope)
+	}
+	if code := c.do(utils.HttpPut, rolePath, map[string]interface{}{"name": "staff", "version": 1}, nil); code != http.StatusPreconditionFailed {
+		t.Errorf("Expected 412 for outdated version in body, got %d", code)
+	}
+	if resp, _ = c.raw(utils.HttpPut, rolePath, `{"name": "staff"}`, map[string]string{utils.HTTPIfMatchKey: `W/"2"`}); resp.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("Expected 412 for weak tag, got %d", resp.StatusCode)
+	}
+	if resp, _ = c.raw(utils.HttpGet, rolePath, "", nil); resp.Header.Get(utils.HTTPETagKey) != `"2"` {
+		t.Fatalf("Expected refused updates not applied, got %q", resp.Header.Get(utils.HTTPETagKey))
+	}
+
+	// Without precondition the update applies to the current version.
+	if code := c.do(utils.HttpPut, rolePath, map[string]interface{}{"name": "staff"}, &role); code != http.StatusOK || role.Version != 3 {
+		t.Fatalf("Expected unconditional update to version 3, got %d %+v", code, role)
+	}
+	if resp, _ = c.raw(utils.HttpDelete, rolePath, "", map[string]string{utils.HTTPIfMatchKey: `"2"`}); resp.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("Expected 412 for delete of outdated version, got %d", resp.StatusCode)
+	}
+	if resp, _ = c.raw(utils.HttpDelete, rolePath, "", map[string]string{utils.HTTPIfMatchKey: `"3"`}); resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected delete of current version, got %d", resp.StatusCode)
+	}
+}
+
+func TestTenantConditionalGet(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.store.BootstrapAdmin(nil, &config.Tenant{ID: "1", Name: "one"}, &model.UserTenantDetails{UserName: "admin@nyota.com"})
+	c.login("admin@nyota.com", "Secret123")
+
+	resp, _ := c.raw(utils.HttpGet, "/tenants/1", "", nil)
+	tag := resp.Header.Get(utils.HTTPETagKey)
+	if resp.StatusCode != http.StatusOK || tag != `"1"` {
+		t.Fatalf("Expected tenant with ETag, got %d %q", resp.StatusCode, tag)
+	}
+	if resp, _ = c.raw(utils.HttpGet, "/tenants/1", "", map[string]string{utils.HTTPIfNoneMatchKey: tag}); resp.StatusCode != http.StatusNotModified {
+		t.Fatalf("Expected 304 for unchanged tenant, got %d", resp.StatusCode)
+	}
+
+	if resp, _ = c.raw(utils.HttpPut, "/tenants/1", `{"id": "1", "name": "first"}`, map[string]string{utils.HTTPIfMatchKey: tag}); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected tenant update, got %d", resp.StatusCode)
+	}
+	resp, _ = c.raw(utils.HttpGet, "/tenants/1", "", map[string]string{utils.HTTPIfNoneMatchKey: tag})
+	if resp.StatusCode != http.StatusOK || resp.Header.Get(utils.HTTPETagKey) != `"2"` {
+		t.Errorf("Expected changed tenant sent again, got %d %q", resp.StatusCode, resp.Header.Get(utils.HTTPETagKey))
+	}
+}
@@ -1,15 +1,12 @@
 package api
 
 import (
-	"bytes"
 	"goprizm/httputils"
-	"image"
-	"image/jpeg"
-	"log"
 	"net/http"
 	"nyota/backend/logutil"
 	"nyota/backend/model"
 	"nyota/backend/model/config"
+	"nyota/backend/uicomponent"
 	"nyota/backend/utils"
 	"strconv"
 
@@ -18,16 +15,32 @@ import (
 
 func (svc *Service) getEvents(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
 	logutil.Debugf(s, "Service layer - Get All Events invoked...")
-	data, err := svc.Store.GetAllEvents(s)
+	columns := uicomponent.GetEventGridViewColumns(s)
+	spec, ok := utils.ParseQuerySpec(s, req.URL.Query(), columns)
+	if !ok {
+		return
+	}
+	from, to, ok := occurrenceRange(s, req.URL.Query(), false)
+	if !ok {
+		return
+	}
+	data, total, err := svc.Store.GetAllEvents(s, spec)
 	if err != nil {
 		logutil.Errorf(s, "Error - %v", err)
 		utils.SetSomethingWrong(s)
 	} else {
 		for _, event := range data {
 			updateEvent(s, svc, event)
+			// Occurrences are listed only for a range, recurring events are endless otherwise.
+			if !from.IsZero() {
+				if event.Occurrences, ok = svc.expandEvent(s, event, from, to); !ok {
+					return
+				}
+			}
 		}
-		eventList := config.EventList{}
+		eventList := config.EventList{Total: total, Page: spec.Page, PageSize: spec.PageSize}
 		eventList.Events = data
+		eventList.Structure = columns
 		httputils.ServeJSON(w, eventList)
 	}
 }
@@ -35,41 +48,30 @@ func (svc *Service) getEvents(s *model.SessionContext, w http.ResponseWriter, re
 func (svc *Service) getEventByID(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
 	id := mux.Vars(req)["id"]
 	logutil.Debugf(s, "Service layer - Get Event By Id... Id=%v", id)
+	from, to, ok := occurrenceRange(s, req.URL.Query(), false)
+	if !ok {
+		return
+	}
 	data, err := svc.Store.GetEventByID(s, id)
 	if err != nil {
 		logutil.Errorf(s, "Get Event Error - %v", err)
-		utils.SetSomethingWrong(s)
-	} else {
-		updateEvent(s, svc, data)
-		httputils.ServeJSON(w, data)
+		utils.SetStoreError(s, err)
+		return
 	}
-}
-
-func (svc *Service) getEventQrByID(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
-	id := mux.Vars(req)["id"]
-	logutil.Debugf(s, "Service layer - Get Event QR By Id... Id=%v", id)
-	img, err := svc.Store.GetEventQrByID(s, id)
-	if err != nil {
-		logutil.Errorf(s, "Get Event QR Error - %v", err)
+	// Counts change with every registration and check-in, not with the version of the event.
+	if data.Attendance, err = svc.Store.GetEventAttendance(s, id); err != nil {
+		logutil.Errorf(s, "Get Event Attendance Error - %v", err)
 		utils.SetSomethingWrong(s)
-	} else {
-		writeImage(w, img)
-	}
-}
-
-// writeImage encodes an image 'img' in jpeg format and writes it into ResponseWriter.
-func writeImage(w http.ResponseWriter, img *image.Image) {
-
-	buffer := new(bytes.Buffer)
-	if err := jpeg.Encode(buffer, *img, nil); err != nil {
-		log.Println("unable to encode image.")
+		return
 	}
-
-	w.Header().Set("Content-Type", "image/jpeg")
-	w.Header().Set("Content-Length", strconv.Itoa(len(buffer.Bytes())))
-	if _, err := w.Write(buffer.Bytes()); err != nil {
-		log.Println("unable to write image.")
+	if !from.IsZero() {
+		if data.Occurrences, ok = svc.expandEvent(s, data, from, to); !ok {
+			return
+		}
 	}
+	updateEvent(s, svc, data)
+	setETag(w, data.Version)
+	httputils.ServeJSON(w, data)
 }
 
 func (svc *Service) UpsertEvent(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
@@ -80,11 +82,30 @@ func (svc *Service) UpsertEvent(s *model.SessionContext, w http.ResponseWriter,
 		return
 	}
 	logutil.Debugf(s, "Event object - %v ", event)
+	// Places freed by a higher capacity go to the waitlist.
+	raised := false
+	if event.ID != 0 {
+		if !ifMatchUpdate(s, req, &event.Version) {
+			return
+		}
+		existing, err := svc.Store.GetEventByID(s, strconv.Itoa(event.ID))
+		if err != nil {
+			utils.SetStoreError(s, err)
+			return
+		}
+		utils.SetAuditOld(s, existing)
+		raised = existing.Capacity > 0 && (event.Capacity == 0 || event.Capacity > existing.Capacity)
+	}
 	err := svc.Store.UpsertEvent(s, &event)
 	if err != nil {
 		logutil.Errorf(s, "Upsert Event Error - %v", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
+		if raised {
+			svc.promoteAttendees(s, event.ID)
+		}
+		utils.SetAuditNew(s, strconv.Itoa(event.ID), &event)
+		setETag(w, event.Version)
 		httputils.ServeJSON(w, event)
 	}
 }
@@ -92,15 +113,36 @@ func (svc *Service) UpsertEvent(s *model.SessionContext, w http.ResponseWriter,
 func (svc *Service) DeleteEvent(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
 	id := mux.Vars(req)["id"]
 	logutil.Debugf(s, "Service layer - Delete Event by ID... Id = %v", id)
-	err := svc.Store.DeleteEvent(s, id)
+	existing, err := svc.Store.GetEventByID(s, id)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	if !checkIfMatch(s, req, existing.Version) {
+		return
+	}
+	utils.SetAuditOld(s, existing)
+	err = svc.Store.DeleteEvent(s, id)
 	if err != nil {
 		logutil.Errorf(s, "Delete Event Error - %v", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
+		svc.deleteEventQRCodes(s, existing.ID)
 		w.WriteHeader(http.StatusOK)
 	}
 }
 
+// promoteAttendees - promotes waitlisted attendees of event to free places. The event is saved
+// already, failures are logged and promotion waits for the next cancellation.
+func (svc *Service) promoteAttendees(s *model.SessionContext, eventID int) {
+	promoted, err := svc.Store.PromoteEventAttendees(s, strconv.Itoa(eventID))
+	if err != nil {
+		logutil.Errorf(s, "Promote Event Attendees Error - %v", err)
+		return
+	}
+	svc.notifyPromoted(s, promoted)
+}
+
 func updateEvent(s *model.SessionContext, svc *Service, data *config.Event) {
 	data.AddedAtEpoc = getEpoc(data.AddedAt)
 	data.UpdatedAtEpoc = getEpoc(data.UpdatedAt)
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"goprizm/httputils"
+	"net/http"
+	"nyota/backend/auth"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+
+	"github.com/gorilla/mux"
+)
+
+func (svc *Service) getIdentityProviders(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Get Identity Providers...")
+	idps, err := svc.Store.GetIdentityProviders(s)
+	if err != nil {
+		logutil.Errorf(s, "Get Identity Providers Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	list := []*model.IdentityProvider{}
+	for _, idp := range idps {
+		idp.ClientSecret = ""
+		list = append(list, idp)
+	}
+	httputils.ServeJSON(w, list)
+}
+
+func (svc *Service) getIdentityProviderByID(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Get Identity Provider By Id... Id=%v", id)
+	idp, err := svc.tenantIdentityProvider(s, id)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	idp.ClientSecret = ""
+	httputils.ServeJSON(w, idp)
+}
+
+// UpsertIdentityProvider - adds or updates identity provider of tenant. The client secret is kept
+// when an update has none.
+func (svc *Service) UpsertIdentityProvider(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Add / Update Identity Provider Invoked")
+	var idp model.IdentityProvider
+	utils.DecodeAndValidate(s, w, req, &idp)
+	if nil != s.Err {
+		return
+	}
+	var roles []string
+	if idp.DefaultRole != "" {
+		roles = append(roles, idp.DefaultRole)
+	}
+	for _, role := range idp.GroupRoles {
+		roles = append(roles, role)
+	}
+	for _, name := range roles {
+		role, err := svc.adminRole(s, name)
+		if err != nil || role.Name == utils.SuperAdminUserRole {
+			// Single sign-on never grants access across tenants.
+			utils.SetPreconditionFailedError(s, "key_role_invalid")
+			return
+		}
+		if !s.User.IsSuperAdmin && !utils.PermissionsWithin(role.Permissions, s.User.Permission) {
+			utils.SetPreconditionFailedError(s, "key_permissions_exceeded")
+			return
+		}
+	}
+
+	if req.Method == utils.HttpPost {
+		id, err := auth.RandomToken(9)
+		if err != nil {
+			logutil.Errorf(s, "Identity Provider id generation Error - %v", err)
+			utils.SetSomethingWrong(s)
+			return
+		}
+		idp.ID = id
+	} else {
+		existing, err := svc.tenantIdentityProvider(s, idp.ID)
+		if err != nil {
+			utils.SetStoreError(s, err)
+			return
+		}
+		utils.SetAuditOld(s, existing)
+		if idp.ClientSecret == "" {
+			idp.ClientSecret = existing.ClientSecret
+		}
+	}
+	if err := svc.Store.UpsertIdentityProvider(s, &idp); err != nil {
+		logutil.Errorf(s, "Upsert Identity Provider Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	utils.SetAuditNew(s, idp.ID, &idp)
+	idp.ClientSecret = ""
+	status := http.StatusOK
+	if req.Method == utils.HttpPost {
+		status = http.StatusCreated
+	}
+	httputils.ServeJSONWithStatus(w, idp, status)
+}
+
+// DeleteIdentityProvider - removes identity provider, its users can no longer log in.
+func (svc *Service) DeleteIdentityProvider(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Delete Identity Provider... Id=%v", id)
+	existing, err := svc.tenantIdentityProvider(s, id)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	utils.SetAuditOld(s, existing)
+	if err := svc.Store.DeleteIdentityProvider(s, id); err != nil {
+		logutil.Errorf(s, "Delete Identity Provider Error - %v", err)
+		utils.SetStoreError(s, err)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// tenantIdentityProvider - identity provider id when it belongs to the session tenant.
+func (svc *Service) tenantIdentityProvider(s *model.SessionContext, id string) (*model.IdentityProvider, error) {
+	idp, err := svc.Store.GetIdentityProviderByID(id)
+	if err == nil && idp.TenantID != s.User.TenantId && !s.User.IsSuperAdmin {
+		err = &model.NotFoundError{Entity: "identity provider", ID: id}
+	}
+	return idp, err
+}
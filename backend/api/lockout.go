@@ -0,0 +1,236 @@
+package api
+
+import (
+	"encoding/json"
+	"goprizm/httputils"
+	"goprizm/sysutils"
+	"net"
+	"net/http"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// Login throttling. Failures are counted per user name and per source address within
+// LoginFailureWindow. After loginFreeFailures failures of a user every further attempt is delayed,
+// doubling from a second; LoginMaxFailures lock the user and LoginMaxAddressFailures the address
+// for LoginLockout.
+const (
+	LoginFailureWindow      = 15 * time.Minute
+	LoginLockout            = 15 * time.Minute
+	LoginMaxFailures        = 5
+	LoginMaxAddressFailures = 20
+
+	loginFreeFailures = 3
+)
+
+func loginUserKey(userName string) string {
+	return "login:user:" + userName
+}
+
+func loginAddressKey(address string) string {
+	return "login:ip:" + address
+}
+
+// trustedProxyHeaderEnv - header the load balancer in front of the servers puts the client address
+// in, e.g. X-Forwarded-For. Without it every client has the address of the load balancer.
+const trustedProxyHeaderEnv = "TRUSTED_PROXY_HEADER"
+
+// remoteIP - address of client without port. Behind a trusted proxy it is the last address of its
+// header, the one the proxy added; addresses before it are sent by the client and can be forged.
+func remoteIP(r *http.Request) string {
+	if header := sysutils.Getenv(trustedProxyHeaderEnv, ""); header != "" {
+		values := strings.Split(strings.Join(r.Header[http.CanonicalHeaderKey(header)], ","), ",")
+		if ip := net.ParseIP(strings.TrimSpace(values[len(values)-1])); ip != nil {
+			return ip.String()
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// loginBlocked - refuses login while user or address are delayed or locked out. Counters that can't
+// be read don't block, logins must not depend on redis.
+func (svc *Service) loginBlocked(s *model.SessionContext, w http.ResponseWriter, userName, address string) bool {
+	wait, key := time.Duration(0), "key_login_delayed"
+	counters := map[string]int64{loginUserKey(userName): LoginMaxFailures, loginAddressKey(address): LoginMaxAddressFailures}
+	for counter, maxFailures := range counters {
+		blocked, err := svc.Attempts.Blocked(counter)
+		if err != nil {
+			logutil.Errorf(s, "Login attempts of %s not readable: %v", counter, err)
+			continue
+		}
+		if blocked == 0 {
+			continue
+		}
+		if blocked > wait {
+			wait = blocked
+		}
+		if failures, _ := svc.Attempts.Failures(counter); failures >= maxFailures {
+			key = "key_login_locked"
+		}
+	}
+	if wait == 0 {
+		return false
+	}
+	logutil.Printf(s, "Login refused for %s from %s for another %s", userName, address, wait)
+	metricLoginFailures.WithLabelValues("", "blocked").Inc()
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter(wait)))
+	utils.SetTooManyRequestsError(s, key)
+	return true
+}
+
+// loginFailed - counts failed login of userName from address for reason, a wrong password or second
+// factor, user is nil when it doesn't exist. Delays and lockouts start from here.
+func (svc *Service) loginFailed(s *model.SessionContext, r *http.Request, userName, address string, user *model.UserTenantDetails, reason string) {
+	tenantID := ""
+	if user != nil {
+		tenantID = user.TenantID
+	}
+	metricLoginFailures.WithLabelValues(tenantID, reason).Inc()
+
+	failures, err := svc.Attempts.Fail(loginUserKey(userName), LoginFailureWindow)
+	if err != nil {
+		logutil.Errorf(s, "Counting failed login of %s failed: %v", userName, err)
+	}
+	logutil.Printf(s, "Login failed for %s from %s, user exists: %t, failures: %d", userName, address, user != nil, failures)
+	switch {
+	case failures >= LoginMaxFailures:
+		svc.lockLogin(s, r, loginUserKey(userName), tenantID, userName, failures)
+	case failures >= loginFreeFailures:
+		delay := time.Duration(1<<uint(failures-loginFreeFailures)) * time.Second
+		if err := svc.Attempts.Block(loginUserKey(userName), delay); err != nil {
+			logutil.Errorf(s, "Delaying login of %s failed: %v", userName, err)
+		}
+	}
+
+	failures, err = svc.Attempts.Fail(loginAddressKey(address), LoginFailureWindow)
+	if err != nil {
+		logutil.Errorf(s, "Counting failed login from %s failed: %v", address, err)
+	}
+	if failures >= LoginMaxAddressFailures {
+		svc.lockLogin(s, r, loginAddressKey(address), "", address, failures)
+	}
+}
+
+// lockLogin - locks counter out for LoginLockout, recorded in audit log of tenant of the user.
+func (svc *Service) lockLogin(s *model.SessionContext, r *http.Request, counter, tenantID, id string, failures int64) {
+	if err := svc.Attempts.Block(counter, LoginLockout); err != nil {
+		logutil.Errorf(s, "Locking %s failed: %v", counter, err)
+		return
+	}
+	logutil.Printf(s, "Login locked for %s after %d failures", id, failures)
+	kind := "user"
+	if counter == loginAddressKey(id) {
+		kind = "address"
+	}
+	metricLoginLockouts.WithLabelValues(tenantID, kind).Inc()
+
+	data, _ := json.Marshal(map[string]interface{}{kind: id, "failures": failures, "locked_until": time.Now().Add(LoginLockout)})
+	entry := &model.AuditLog{
+		TenantID:   tenantID,
+		UserName:   id,
+		Entity:     "lockout",
+		EntityID:   id,
+		Action:     model.AuditActionLock,
+		NewData:    model.AuditData(data),
+		RequestID:  s.RequestID,
+		RemoteAddr: r.RemoteAddr,
+		AddedAt:    time.Now(),
+	}
+	if kind == "address" {
+		entry.UserName = ""
+	}
+	if err := svc.Store.AddAuditLog(entry); err != nil {
+		logutil.Errorf(s, "Audit Log - write failed for lockout of %s: %v", id, err)
+	}
+}
+
+// loginSucceeded - forgets the failed logins of user.
+func (svc *Service) loginSucceeded(s *model.SessionContext, userName string) {
+	if err := svc.Attempts.Reset(loginUserKey(userName)); err != nil {
+		logutil.Errorf(s, "Resetting failed logins of %s failed: %v", userName, err)
+	}
+}
+
+func retryAfter(wait time.Duration) int {
+	return int((wait + time.Second - 1) / time.Second)
+}
+
+// getUserLockout - failed logins of user and whether logins are refused.
+func (svc *Service) getUserLockout(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	userName := mux.Vars(req)["userName"]
+	logutil.Debugf(s, "Service layer - Get Login Lockout of User... UserName=%v", userName)
+	if !svc.tenantUser(s, userName) {
+		return
+	}
+	lockout := model.LoginLockout{UserName: userName}
+	failures, err := svc.Attempts.Failures(loginUserKey(userName))
+	var wait time.Duration
+	if err == nil {
+		wait, err = svc.Attempts.Blocked(loginUserKey(userName))
+	}
+	if err != nil {
+		logutil.Errorf(s, "Get Login Lockout Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	lockout.Failures = failures
+	lockout.Locked = failures >= LoginMaxFailures && wait > 0
+	lockout.RetryAfter = retryAfter(wait)
+	httputils.ServeJSON(w, lockout)
+}
+
+// DeleteUserLockout - unlocks user, its failed logins are forgotten. The lockout of the address of
+// the query, the one the user logs in from, is lifted as well. Address counters are shared by all
+// tenants, only super admins may reset them.
+func (svc *Service) DeleteUserLockout(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	userName, address := mux.Vars(req)["userName"], req.URL.Query().Get("address")
+	logutil.Debugf(s, "Service layer - Unlock Login of User... UserName=%v Address=%v", userName, address)
+	counters := []string{loginUserKey(userName)}
+	if address != "" {
+		if !s.User.IsSuperAdmin {
+			utils.SetForbiddenError(s)
+			return
+		}
+		ip := net.ParseIP(address)
+		if ip == nil {
+			utils.SetPreconditionFailedError(s, "key_lockout_address_invalid")
+			return
+		}
+		counters = append(counters, loginAddressKey(ip.String()))
+	}
+	if !svc.tenantUser(s, userName) {
+		return
+	}
+	s.AuditID = userName
+	for _, counter := range counters {
+		if err := svc.Attempts.Reset(counter); err != nil {
+			logutil.Errorf(s, "Unlock Login Error - %v", err)
+			utils.SetSomethingWrong(s)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// tenantUser - whether userName is a user of the tenant, sets not found otherwise.
+func (svc *Service) tenantUser(s *model.SessionContext, userName string) bool {
+	user, err := svc.Store.GetUserByName(s, userName)
+	if err == nil && user.TenantID != s.User.TenantId && !s.User.IsSuperAdmin {
+		err = &model.NotFoundError{Entity: "user", ID: userName}
+	}
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return false
+	}
+	return true
+}
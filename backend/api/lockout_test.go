@@ -0,0 +1,148 @@
+package api
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"nyota/backend/model"
+	"nyota/backend/utils"
+)
+
+func TestLoginDelayAndLockout(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("analyst@nyota.com", "secret", "1", utils.AnalystUserRole)
+	now := time.Now()
+	c.attempts.Now = func() time.Time { return now }
+
+	analyst := c.newSession()
+	attempt := func(password string) (*http.Response, model.AppError) {
+		return analyst.raw(utils.HttpPost, "/login", `{"UserName": "analyst@nyota.com", "Password": "`+password+`"}`, nil)
+	}
+	for i := 0; i < loginFreeFailures; i++ {
+		if resp, _ := attempt("wrong"); resp.StatusCode != http.StatusUnauthorized {
+			t.Fatalf("Expected failed login %d 401, got %d", i+1, resp.StatusCode)
+		}
+	}
+	resp, envelope := attempt("secret")
+	if resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "1" || envelope.Message != "Too many failed logins, try again in a few seconds" {
+		t.Fatalf("Expected delayed login 429 for a second, got %d %q %+v", resp.StatusCode, resp.Header.Get("Retry-After"), envelope)
+	}
+
+	now = now.Add(time.Second)
+	attempt("wrong")
+	now = now.Add(2 * time.Second)
+	attempt("wrong")
+	resp, envelope = attempt("secret")
+	if resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "900" || !strings.Contains(envelope.Message, "locked") {
+		t.Fatalf("Expected locked login 429 for the lockout, got %d %q %+v", resp.StatusCode, resp.Header.Get("Retry-After"), envelope)
+	}
+
+	c.login("admin@nyota.com", "secret")
+	var lockout model.LoginLockout
+	c.do(utils.HttpGet, "/users/analyst@nyota.com/lockout", nil, &lockout)
+	if !lockout.Locked || lockout.Failures != LoginMaxFailures || lockout.RetryAfter != 900 {
+		t.Errorf("Expected analyst locked out, got %+v", lockout)
+	}
+	var entries model.AuditLogPage
+	c.do(utils.HttpGet, "/audit?entity=lockout", nil, &entries)
+	if entries.Total != 1 || entries.Items[0].Action != model.AuditActionLock || entries.Items[0].EntityID != "analyst@nyota.com" {
+		t.Errorf("Expected lockout in audit log, got %+v", entries)
+	}
+
+	if code := c.do(utils.HttpDelete, "/users/analyst@nyota.com/lockout", nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected unlock 200, got %d", code)
+	}
+	if resp, _ := attempt("secret"); resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected login after unlock, got %d", resp.StatusCode)
+	}
+	c.do(utils.HttpGet, "/audit?entity=lockout", nil, &entries)
+	if entries.Total != 2 || entries.Items[0].Action != model.AuditActionDelete {
+		t.Errorf("Expected unlock in audit log, got %+v", entries)
+	}
+	if code := c.do(utils.HttpDelete, "/users/nobody@nyota.com/lockout", nil, nil); code != http.StatusNotFound {
+		t.Errorf("Expected unlock of unknown user 404, got %d", code)
+	}
+
+	resp, _ = http.Get(c.server.URL + "/metrics")
+	metrics, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(metrics), `api_login_lockouts_total{kind="user",tenantID="1"}`) ||
+		!strings.Contains(string(metrics), `api_login_failures_total{reason="password",tenantID="1"}`) {
+		t.Errorf("Expected login metrics of tenant, got %s", metrics)
+	}
+}
+
+func TestLoginAddressLockout(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+
+	// Unknown users are counted like existing ones, so responses don't reveal which exist.
+	for i := 0; i < LoginMaxAddressFailures; i++ {
+		if code := c.login(fmt.Sprintf("user%d@nyota.com", i), "wrong"); code != http.StatusUnauthorized {
+			t.Fatalf("Expected failed login %d 401, got %d", i+1, code)
+		}
+	}
+	resp, envelope := c.raw(utils.HttpPost, "/login", `{"UserName": "admin@nyota.com", "Password": "secret"}`, nil)
+	if resp.StatusCode != http.StatusTooManyRequests || !strings.Contains(envelope.Message, "locked") {
+		t.Errorf("Expected address locked out, got %d %+v", resp.StatusCode, envelope)
+	}
+	c.attempts.Now = func() time.Time { return time.Now().Add(LoginLockout) }
+	if code := c.login("admin@nyota.com", "secret"); code != http.StatusOK {
+		t.Errorf("Expected login once lockout passed, got %d", code)
+	}
+}
+
+func TestLoginAddressLockoutBehindProxy(t *testing.T) {
+	t.Setenv(trustedProxyHeaderEnv, "X-Forwarded-For")
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("analyst@nyota.com", "secret", "1", utils.AnalystUserRole)
+	login := func(session *testClient, userName, password, forwardedFor string) int {
+		resp, _ := session.raw(utils.HttpPost, "/login", `{"UserName": "`+userName+`", "Password": "`+password+`"}`,
+			map[string]string{"X-Forwarded-For": forwardedFor})
+		return resp.StatusCode
+	}
+
+	// Addresses the client sends before the one of the proxy don't spread its failures.
+	analyst := c.newSession()
+	for i := 0; i < LoginMaxAddressFailures; i++ {
+		login(analyst, fmt.Sprintf("user%d@nyota.com", i), "wrong", fmt.Sprintf("192.0.2.%d, 10.0.0.1", i))
+	}
+	if code := login(analyst, "analyst@nyota.com", "secret", "10.0.0.1"); code != http.StatusTooManyRequests {
+		t.Fatalf("Expected address of client locked out, got %d", code)
+	}
+	if code := login(c, "admin@nyota.com", "secret", "10.0.0.2"); code != http.StatusOK {
+		t.Fatalf("Expected login of other client behind proxy, got %d", code)
+	}
+
+	// Address counters are shared by tenants, tenant admins unlock their users only.
+	if code := c.do(utils.HttpDelete, "/users/analyst@nyota.com/lockout?address=10.0.0.1", nil, nil); code != http.StatusForbidden {
+		t.Errorf("Expected unlock of address by tenant admin 403, got %d", code)
+	}
+	if code := login(analyst, "analyst@nyota.com", "secret", "10.0.0.1"); code != http.StatusTooManyRequests {
+		t.Errorf("Expected address still locked out, got %d", code)
+	}
+
+	root := c.newSession()
+	c.addUser("root@nyota.com", "secret", "0", utils.SuperAdminUserRole)
+	if code := login(root, "root@nyota.com", "secret", "10.0.0.3"); code != http.StatusOK {
+		t.Fatalf("Expected super admin login, got %d", code)
+	}
+	if code := root.do(utils.HttpDelete, "/users/analyst@nyota.com/lockout?address=nowhere", nil, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected unlock of invalid address 400, got %d", code)
+	}
+	if code := root.do(utils.HttpDelete, "/users/analyst@nyota.com/lockout?address=10.0.0.1", nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected unlock of user and address by super admin 200, got %d", code)
+	}
+	if code := login(analyst, "analyst@nyota.com", "secret", "10.0.0.1"); code != http.StatusOK {
+		t.Errorf("Expected login from unlocked address, got %d", code)
+	}
+}
@@ -5,34 +5,13 @@ import (
 	"goprizm/httputils"
 	"net/http"
 	"nyota/backend/api/requestinterceptor"
+	"nyota/backend/auth"
 	"nyota/backend/logutil"
 	"nyota/backend/model"
 	"nyota/backend/store"
 	"nyota/backend/utils"
 )
 
-var (
-	// Supported Permissions
-	adminPermission   = make(map[string]string)
-	analystPermission = make(map[string]string)
-
-	users = model.UserTenantDetailsArray{
-		model.UserTenantDetails{UserName: "admin@nyota.com", Password: "pass123", TenantID: "1",
-			UserTenantAttributes: model.UserTenantAttributes{Role: utils.AdminUserRole, Permissions: utils.AdminUserRolePermission}},
-		model.UserTenantDetails{UserName: "admin1@nyota.com", Password: "pass123", TenantID: "2",
-			UserTenantAttributes: model.UserTenantAttributes{Role: utils.AdminUserRole, Permissions: utils.AdminUserRolePermission}},
-	}
-)
-
-func (svc *Service) addRecords(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
-
-	logutil.Printf(s, "Add Records Request Start...")
-
-	for _, localUser := range users {
-		svc.Store.UpsertUser(nil, &localUser)
-	}
-}
-
 func (svc *Service) login(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
 
 	logutil.Printf(s, "Authentication Request Start...")
@@ -42,50 +21,91 @@ func (svc *Service) login(s *model.SessionContext, w http.ResponseWriter, r *htt
 	error := json.NewDecoder(r.Body).Decode(&user)
 	if error != nil {
 		logutil.Errorf(s, error.Error())
-		http.Error(w, error.Error(), http.StatusInternalServerError)
+		utils.SetParsingError(s, error)
+		return
+	}
+	address := remoteIP(r)
+	if svc.loginBlocked(s, w, user.UserName, address) {
 		return
 	}
 	sysUser, loginSuccess := checkDbUser(s, user, svc.Store)
 	if loginSuccess == false {
-		sysUser, loginSuccess = checkLocalUser(user)
+		svc.loginFailed(s, r, user.UserName, address, sysUser, "password")
+		utils.SetUnauthorizedError(s)
+		return
 	}
-
-	if loginSuccess == false {
-		logutil.Printf(s, "Login Failed...")
-		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+	s.User.TenantId = sysUser.TenantID
+	role, err := svc.adminRole(s, sysUser.UserTenantAttributes.Role)
+	if err != nil {
+		// Role of user was removed, user has no access until another role is assigned.
+		logutil.Errorf(s, "Role %s of user %s not found: %v", sysUser.UserTenantAttributes.Role, sysUser.UserName, err)
+		utils.SetUnauthorizedError(s)
+		return
+	}
+	// Failed logins are only forgotten once the second factor passed too.
+	if svc.startSecondFactor(s, w, r, sysUser) {
+		return
+	}
+	svc.loginSucceeded(s, user.UserName)
+	if !svc.beginSession(s, w, r, sysUser, role) {
 		return
 	}
-	requestinterceptor.StartSession(s, r, w, sysUser.UserName, sysUser.TenantID, sysUser.UserTenantAttributes.Permissions)
-	logutil.Printf(s, "Authentication Request Complete - "+
-		"[Role is - %s Permission - %v] ", sysUser.UserTenantAttributes.Role, sysUser.UserTenantAttributes.Permissions)
 	userBasicDetails := model.UserTenantBasicDetails{UserName: sysUser.UserName,
-		Role: sysUser.UserTenantAttributes.Role, Permission: sysUser.UserTenantAttributes.Permissions}
+		Role: role.Name, Permission: role.Permissions}
 	httputils.ServeJSON(w, userBasicDetails)
 }
 
-func checkLocalUser(user model.UserLogin) (*model.UserTenantDetails, bool) {
-	for _, localUser := range users {
-		if user.UserName == localUser.UserName && user.Password == localUser.Password {
-			return &localUser, true
-		}
+// beginSession - logs authenticated user in with role, for password login and single sign-on.
+func (svc *Service) beginSession(s *model.SessionContext, w http.ResponseWriter, r *http.Request,
+	user *model.UserTenantDetails, role *model.AdminRole) bool {
+	session, idle, err := svc.startSession(s, r, user.UserName, user.TenantID)
+	if err != nil {
+		logutil.Errorf(s, "Session creation failed for %s: %v", user.UserName, err)
+		utils.SetSomethingWrong(s)
+		return false
 	}
-	return nil, false
+	requestinterceptor.StartSession(s, r, w, session, idle, role.Name, role.Permissions)
+	logutil.Printf(s, "Authentication Request Complete - "+
+		"[Role is - %s Permission - %v] ", role.Name, role.Permissions)
+	return true
 }
 
-func checkDbUser(s *model.SessionContext, user model.UserLogin, store *store.Store) (*model.UserTenantDetails, bool) {
+// checkDbUser - user when password matches, the user without success on a wrong password and nil
+// when there is no such user.
+func checkDbUser(s *model.SessionContext, user model.UserLogin, store store.Store) (*model.UserTenantDetails, bool) {
 	dbUser, err := store.GetUserByName(s, user.UserName)
 	if err != nil {
 		logutil.Errorf(nil, "User fetch failed...: %v", err)
 		return nil, false
 	}
+	if dbUser.IdentityProvider != "" {
+		logutil.Errorf(s, "User %s logs in with single sign-on only", dbUser.UserName)
+		return dbUser, false
+	}
 
-	if user.UserName == dbUser.UserName && user.Password == dbUser.Password {
-		return dbUser, true
+	ok, needsRehash := auth.CheckPassword(user.Password, dbUser.Password, dbUser.Salt)
+	if !ok {
+		return dbUser, false
 	}
-	return nil, false
+	if needsRehash {
+		// Legacy plaintext or outdated hash, upgrade now that the password is known.
+		hash, salt, err := auth.HashPassword(user.Password)
+		if err == nil {
+			err = store.UpdateUserPassword(s, dbUser.UserName, hash, salt)
+		}
+		if err != nil {
+			logutil.Errorf(s, "Password rehash failed for %s: %v", dbUser.UserName, err)
+		}
+	}
+	return dbUser, true
 }
 
-func logout(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
+func (svc *Service) logout(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
 	logutil.Printf(s, "Logout called...")
+	if s.User.SessionID != "" {
+		if err := svc.Store.DeleteUserSession(s, s.User.SessionID); err != nil {
+			logutil.Errorf(s, "Session removal failed on logout: %v", err)
+		}
+	}
 	requestinterceptor.EndSession(r, w)
 }
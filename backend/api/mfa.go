@@ -0,0 +1,371 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"goprizm/httputils"
+	"net/http"
+	"nyota/backend/api/requestinterceptor"
+	"nyota/backend/auth"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// Second factor of password logins. Users who enrolled, and all users of tenants requiring it, get
+// a short lived pre-auth session from /login and are logged in by /login/mfa with a TOTP or recovery
+// code. Single sign-on leaves the second factor to the identity provider.
+const (
+	mfaIssuer        = "Nyota"
+	mfaRecoveryCodes = 10
+	mfaQRSize        = 256
+)
+
+// startSecondFactor - starts the pre-auth session when user logs in with a second factor, whether
+// the request is served.
+func (svc *Service) startSecondFactor(s *model.SessionContext, w http.ResponseWriter, r *http.Request, user *model.UserTenantDetails) bool {
+	mfa, err := svc.Store.GetUserMFA(user.UserName)
+	if err != nil && !model.IsNotFound(err) {
+		// Never skip the second factor because it couldn't be read.
+		logutil.Errorf(s, "Second factor of %s not readable: %v", user.UserName, err)
+		utils.SetSomethingWrong(s)
+		return true
+	}
+	enabled := err == nil && mfa.Enabled
+	tenant, err := svc.Store.GetTenantById(s, user.TenantID)
+	if err != nil && !model.IsNotFound(err) {
+		logutil.Errorf(s, "Tenant %s of %s not readable: %v", user.TenantID, user.UserName, err)
+		utils.SetSomethingWrong(s)
+		return true
+	}
+	if !enabled && (tenant == nil || !tenant.MFARequired) {
+		return false
+	}
+	if err := requestinterceptor.StartPreAuth(r, w, user.UserName, user.TenantID); err != nil {
+		logutil.Errorf(s, "Pre-auth session could not be saved for %s: %v", user.UserName, err)
+		utils.SetSomethingWrong(s)
+		return true
+	}
+	logutil.Printf(s, "Authentication Request waits for second factor of %s, enrolled: %t", user.UserName, enabled)
+	details := model.UserTenantBasicDetails{UserName: user.UserName, MFARequired: true, MFAEnroll: !enabled}
+	httputils.ServeJSONWithStatus(w, details, http.StatusAccepted)
+	return true
+}
+
+// loginSecondFactor - completes login of the pre-auth session with a code. Users enrolling during
+// login get their recovery codes with the response.
+func (svc *Service) loginSecondFactor(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
+	logutil.Printf(s, "Second Factor Request Start...")
+	userName, tenantID, ok := requestinterceptor.PreAuthUser(r)
+	if !ok {
+		utils.SetUnauthorizedError(s)
+		return
+	}
+	var code model.MFACode
+	if err := json.NewDecoder(r.Body).Decode(&code); err != nil {
+		utils.SetParsingError(s, err)
+		return
+	}
+	address := remoteIP(r)
+	if svc.loginBlocked(s, w, userName, address) {
+		return
+	}
+	s.User.TenantId = tenantID
+	user, err := svc.Store.GetUserByName(s, userName)
+	var role *model.AdminRole
+	if err == nil {
+		role, err = svc.adminRole(s, user.Role)
+	}
+	if err != nil {
+		logutil.Errorf(s, "User %s of pre-auth session can't log in: %v", userName, err)
+		requestinterceptor.EndPreAuth(r, w)
+		utils.SetUnauthorizedError(s)
+		return
+	}
+	mfa, err := svc.Store.GetUserMFA(userName)
+	if err != nil {
+		utils.SetPreconditionFailedError(s, "key_mfa_not_enrolled")
+		return
+	}
+	enrolling := !mfa.Enabled
+	if !svc.checkSecondFactor(s, mfa, code) {
+		svc.loginFailed(s, r, userName, address, user, "mfa")
+		utils.SetUnauthorizedError(s)
+		return
+	}
+	var recoveryCodes []string
+	if enrolling {
+		if recoveryCodes, err = svc.enableMFA(mfa); err != nil {
+			logutil.Errorf(s, "Enabling second factor of %s failed: %v", userName, err)
+			utils.SetSomethingWrong(s)
+			return
+		}
+		entry := &model.AuditLog{TenantID: tenantID, UserName: userName, Entity: "mfa", EntityID: userName,
+			Action: model.AuditActionAdd, RequestID: s.RequestID, RemoteAddr: r.RemoteAddr, AddedAt: time.Now()}
+		if err := svc.Store.AddAuditLog(entry); err != nil {
+			logutil.Errorf(s, "Audit Log - write failed for second factor of %s: %v", userName, err)
+		}
+	}
+	requestinterceptor.EndPreAuth(r, w)
+	svc.loginSucceeded(s, userName)
+	if !svc.beginSession(s, w, r, user, role) {
+		return
+	}
+	details := model.UserTenantBasicDetails{UserName: userName, Role: role.Name, Permission: role.Permissions, RecoveryCodes: recoveryCodes}
+	httputils.ServeJSON(w, details)
+}
+
+// enrollLoginMFA - new second factor for the pre-auth session of a user who must enroll first.
+func (svc *Service) enrollLoginMFA(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
+	userName, tenantID, ok := requestinterceptor.PreAuthUser(r)
+	if !ok {
+		utils.SetUnauthorizedError(s)
+		return
+	}
+	svc.enrollMFA(s, w, userName, tenantID)
+}
+
+// getMFA - second factor of the logged in user.
+func (svc *Service) getMFA(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Get MFA...")
+	status := model.MFAStatus{}
+	mfa, err := svc.Store.GetUserMFA(s.User.UserName)
+	if err == nil && mfa.Enabled {
+		status.Enabled = true
+		status.RecoveryCodesLeft = len(mfa.RecoveryCodes)
+	} else if err != nil && !model.IsNotFound(err) {
+		utils.SetStoreError(s, err)
+		return
+	}
+	if tenant, err := svc.Store.GetTenantById(s, s.User.TenantId); err == nil {
+		status.Required = tenant.MFARequired
+	}
+	httputils.ServeJSON(w, status)
+}
+
+// AddMFA - starts enrollment of a second factor for the logged in user, it is enabled by the first
+// code through UpdateMFA.
+func (svc *Service) AddMFA(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Add MFA Invoked")
+	if !svc.mfaUser(s) {
+		return
+	}
+	s.AuditID = s.User.UserName
+	svc.enrollMFA(s, w, s.User.UserName, s.User.TenantId)
+}
+
+// UpdateMFA - enables the enrolled second factor with a code, the response has the recovery codes.
+func (svc *Service) UpdateMFA(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Enable MFA Invoked")
+	mfa, code, ok := svc.mfaRequest(s, req)
+	if !ok {
+		return
+	}
+	if mfa.Enabled {
+		utils.SetConflictError(s)
+		return
+	}
+	if code.RecoveryCode != "" || !svc.checkSecondFactor(s, mfa, code) {
+		svc.mfaFailed(s, req)
+		return
+	}
+	codes, err := svc.enableMFA(mfa)
+	if err != nil {
+		logutil.Errorf(s, "Enable MFA Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	httputils.ServeJSON(w, model.MFAEnrollment{RecoveryCodes: codes})
+}
+
+// AddMFARecoveryCodes - replaces the recovery codes of the logged in user, a code is required.
+func (svc *Service) AddMFARecoveryCodes(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Add MFA Recovery Codes Invoked")
+	mfa, code, ok := svc.mfaRequest(s, req)
+	if !ok {
+		return
+	}
+	if !mfa.Enabled {
+		utils.SetPreconditionFailedError(s, "key_mfa_not_enrolled")
+		return
+	}
+	if !svc.checkSecondFactor(s, mfa, code) {
+		svc.mfaFailed(s, req)
+		return
+	}
+	codes, err := svc.enableMFA(mfa)
+	if err != nil {
+		logutil.Errorf(s, "Add MFA Recovery Codes Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	httputils.ServeJSONWithStatus(w, model.MFAEnrollment{RecoveryCodes: codes}, http.StatusCreated)
+}
+
+// DeleteMFA - removes the second factor of the logged in user, unless the tenant requires one. A
+// code is required.
+func (svc *Service) DeleteMFA(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Delete MFA Invoked")
+	mfa, code, ok := svc.mfaRequest(s, req)
+	if !ok {
+		return
+	}
+	if tenant, err := svc.Store.GetTenantById(s, s.User.TenantId); (err != nil && !model.IsNotFound(err)) || (err == nil && tenant.MFARequired) {
+		utils.SetPreconditionFailedError(s, "key_mfa_required")
+		return
+	}
+	if mfa.Enabled && !svc.checkSecondFactor(s, mfa, code) {
+		svc.mfaFailed(s, req)
+		return
+	}
+	if err := svc.Store.DeleteUserMFA(s, s.User.UserName); err != nil {
+		logutil.Errorf(s, "Delete MFA Error - %v", err)
+		utils.SetStoreError(s, err)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// DeleteUserMFA - resets the second factor of user, who enrolls again at the next login when the
+// tenant requires one.
+func (svc *Service) DeleteUserMFA(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	userName := mux.Vars(req)["userName"]
+	logutil.Debugf(s, "Service layer - Reset MFA of User... UserName=%v", userName)
+	if !svc.tenantUser(s, userName) {
+		return
+	}
+	s.AuditID = userName
+	if err := svc.Store.DeleteUserMFA(s, userName); err != nil {
+		logutil.Errorf(s, "Reset MFA Error - %v", err)
+		utils.SetStoreError(s, err)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// enrollMFA - new secret of userName, replacing one not yet enabled. Enabled second factors are
+// only replaced after deleting them with a code.
+func (svc *Service) enrollMFA(s *model.SessionContext, w http.ResponseWriter, userName, tenantID string) {
+	existing, err := svc.Store.GetUserMFA(userName)
+	if err == nil && existing.Enabled {
+		utils.SetConflictError(s)
+		return
+	}
+	secret, err := auth.NewTOTPSecret()
+	if err != nil {
+		logutil.Errorf(s, "MFA secret generation Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	uri := auth.TOTPURI(mfaIssuer, userName, secret)
+	png, err := qrcode.Encode(uri, qrcode.Medium, mfaQRSize)
+	if err != nil {
+		logutil.Errorf(s, "MFA QR code Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	mfa := &model.UserMFA{UserName: userName, TenantID: tenantID, Secret: secret, AddedAt: time.Now()}
+	if err := svc.Store.UpsertUserMFA(mfa); err != nil {
+		logutil.Errorf(s, "Add MFA Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	enrollment := model.MFAEnrollment{Secret: secret, URI: uri, QRCode: "data:image/png;base64," + base64.StdEncoding.EncodeToString(png)}
+	httputils.ServeJSONWithStatus(w, enrollment, http.StatusCreated)
+}
+
+// enableMFA - enables mfa with new recovery codes, which are returned once and only stored hashed.
+func (svc *Service) enableMFA(mfa *model.UserMFA) ([]string, error) {
+	codes, err := auth.NewRecoveryCodes(mfaRecoveryCodes)
+	if err != nil {
+		return nil, err
+	}
+	mfa.RecoveryCodes = make([]string, len(codes))
+	for i, code := range codes {
+		mfa.RecoveryCodes[i] = auth.HashRecoveryCode(code)
+	}
+	if !mfa.Enabled {
+		now := time.Now()
+		mfa.Enabled, mfa.EnabledAt = true, &now
+	}
+	return codes, svc.Store.UpsertUserMFA(mfa)
+}
+
+// checkSecondFactor - whether code is valid for mfa. Codes are used up: TOTP codes up to the one
+// given, recovery codes individually. The store uses them up only when no other request did, so
+// concurrent logins can't both accept the same code.
+func (svc *Service) checkSecondFactor(s *model.SessionContext, mfa *model.UserMFA, code model.MFACode) bool {
+	if code.RecoveryCode != "" {
+		i, ok := auth.CheckRecoveryCode(code.RecoveryCode, mfa.RecoveryCodes)
+		if !ok || !mfa.Enabled {
+			return false
+		}
+		if err := svc.Store.UseUserMFARecoveryCode(mfa.UserName, mfa.RecoveryCodes[i]); err != nil {
+			logutil.Errorf(s, "Using up recovery code of %s failed: %v", mfa.UserName, err)
+			return false
+		}
+		mfa.RecoveryCodes = append(mfa.RecoveryCodes[:i:i], mfa.RecoveryCodes[i+1:]...)
+		logutil.Printf(s, "Recovery code used by %s, %d left", mfa.UserName, len(mfa.RecoveryCodes))
+		return true
+	}
+	step, ok := auth.CheckTOTP(mfa.Secret, strings.TrimSpace(code.Code), time.Now(), mfa.LastStep)
+	if !ok {
+		return false
+	}
+	if err := svc.Store.UseUserMFAStep(mfa.UserName, step); err != nil {
+		logutil.Errorf(s, "Using up code of %s failed: %v", mfa.UserName, err)
+		return false
+	}
+	mfa.LastStep = step
+	return true
+}
+
+// mfaUser - whether the second factor of the request user can be managed. Tokens can't, users of
+// single sign-on have theirs at the identity provider.
+func (svc *Service) mfaUser(s *model.SessionContext) bool {
+	if s.User.TokenID != "" {
+		utils.SetForbiddenError(s)
+		return false
+	}
+	user, err := svc.Store.GetUserByName(s, s.User.UserName)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return false
+	}
+	if user.IdentityProvider != "" {
+		utils.SetPreconditionFailedError(s, "key_mfa_sso")
+		return false
+	}
+	return true
+}
+
+// mfaRequest - second factor of the request user and the code of the request.
+func (svc *Service) mfaRequest(s *model.SessionContext, req *http.Request) (*model.UserMFA, model.MFACode, bool) {
+	var code model.MFACode
+	if !svc.mfaUser(s) {
+		return nil, code, false
+	}
+	if err := json.NewDecoder(req.Body).Decode(&code); err != nil {
+		utils.SetParsingError(s, err)
+		return nil, code, false
+	}
+	s.AuditID = s.User.UserName
+	mfa, err := svc.Store.GetUserMFA(s.User.UserName)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return nil, code, false
+	}
+	return mfa, code, true
+}
+
+// mfaFailed - wrong code of a logged in user, counted like failed logins so codes can't be guessed.
+func (svc *Service) mfaFailed(s *model.SessionContext, req *http.Request) {
+	user, _ := svc.Store.GetUserByName(s, s.User.UserName)
+	svc.loginFailed(s, req, s.User.UserName, remoteIP(req), user, "mfa")
+	utils.SetPreconditionFailedError(s, "key_mfa_code_invalid")
+}
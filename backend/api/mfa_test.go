@@ -0,0 +1,163 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"nyota/backend/auth"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+)
+
+// totp - code of secret at now plus steps time steps.
+func totp(secret string, steps int) model.MFACode {
+	code, _ := auth.TOTPCode(secret, time.Now().Add(time.Duration(steps)*auth.TOTPPeriod))
+	return model.MFACode{Code: code}
+}
+
+func TestSecondFactorLogin(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("jane@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.login("jane@nyota.com", "secret")
+
+	var enrollment model.MFAEnrollment
+	if code := c.do(utils.HttpPost, "/mfa", nil, &enrollment); code != http.StatusCreated || enrollment.Secret == "" ||
+		!strings.HasPrefix(enrollment.URI, "otpauth://totp/") || !strings.HasPrefix(enrollment.QRCode, "data:image/png;base64,") {
+		t.Fatalf("Expected enrollment 201 with secret and QR code, got %d %+v", code, enrollment)
+	}
+	if code := c.do(utils.HttpPut, "/mfa", model.MFACode{Code: "abcdef"}, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected wrong code 400, got %d", code)
+	}
+	first := totp(enrollment.Secret, 0)
+	var enabled model.MFAEnrollment
+	if code := c.do(utils.HttpPut, "/mfa", first, &enabled); code != http.StatusOK || len(enabled.RecoveryCodes) != mfaRecoveryCodes {
+		t.Fatalf("Expected second factor enabled with recovery codes, got %d %+v", code, enabled)
+	}
+	if code := c.do(utils.HttpPost, "/mfa", nil, nil); code != http.StatusConflict {
+		t.Errorf("Expected enrollment over enabled second factor 409, got %d", code)
+	}
+
+	jane := c.newSession()
+	var pending model.UserTenantBasicDetails
+	if code := jane.do(utils.HttpPost, "/login", model.UserLogin{UserName: "jane@nyota.com", Password: "secret"}, &pending); code != http.StatusAccepted ||
+		!pending.MFARequired || pending.MFAEnroll || pending.Permission != nil {
+		t.Fatalf("Expected login to wait for second factor, got %d %+v", code, pending)
+	}
+	if code := jane.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected no session before second factor, got %d", code)
+	}
+	if code := jane.do(utils.HttpPost, "/login/mfa", first, nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected used code 401, got %d", code)
+	}
+	var details model.UserTenantBasicDetails
+	if code := jane.do(utils.HttpPost, "/login/mfa", totp(enrollment.Secret, 1), &details); code != http.StatusOK || details.Role != utils.AdminUserRole {
+		t.Fatalf("Expected login with second factor, got %d %+v", code, details)
+	}
+	if code := jane.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusOK {
+		t.Errorf("Expected session after second factor, got %d", code)
+	}
+	if code := jane.do(utils.HttpPost, "/login/mfa", totp(enrollment.Secret, 1), nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected pre-auth session to end with login, got %d", code)
+	}
+
+	recovery := model.MFACode{RecoveryCode: enabled.RecoveryCodes[0]}
+	for i, expected := range []int{http.StatusOK, http.StatusUnauthorized} {
+		other := c.newSession()
+		other.login("jane@nyota.com", "secret")
+		if code := other.do(utils.HttpPost, "/login/mfa", recovery, nil); code != expected {
+			t.Errorf("Expected login %d with recovery code %d, got %d", i+1, expected, code)
+		}
+	}
+	var status model.MFAStatus
+	if c.do(utils.HttpGet, "/mfa", nil, &status); !status.Enabled || status.RecoveryCodesLeft != mfaRecoveryCodes-1 {
+		t.Errorf("Expected used recovery code gone, got %+v", status)
+	}
+
+	// Admins reset the second factor of users who lost it.
+	admin := c.newSession()
+	admin.login("admin@nyota.com", "secret")
+	if code := admin.do(utils.HttpDelete, "/users/jane@nyota.com/mfa", nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected reset of second factor, got %d", code)
+	}
+	var entries model.AuditLogPage
+	admin.do(utils.HttpGet, "/audit?entity=mfa", nil, &entries)
+	if entries.Total != 3 || entries.Items[0].Action != model.AuditActionDelete || entries.Items[0].EntityID != "jane@nyota.com" {
+		t.Errorf("Expected enrollment and reset in audit log, got %+v", entries)
+	}
+	if code := c.newSession().login("jane@nyota.com", "secret"); code != http.StatusOK {
+		t.Errorf("Expected password login after reset, got %d", code)
+	}
+}
+
+func TestSecondFactorCodesUsedOnce(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	svc := &Service{Store: c.store}
+	s := &model.SessionContext{User: &model.UserContext{UserName: "jane@nyota.com", TenantId: "1"}}
+	secret, _ := auth.NewTOTPSecret()
+	codes, _ := auth.NewRecoveryCodes(2)
+	c.store.UpsertUserMFA(&model.UserMFA{UserName: "jane@nyota.com", TenantID: "1", Secret: secret, Enabled: true,
+		RecoveryCodes: []string{auth.HashRecoveryCode(codes[0]), auth.HashRecoveryCode(codes[1])}})
+
+	// Both requests read the second factor before either of them uses the code up.
+	for _, code := range []model.MFACode{totp(secret, 0), {RecoveryCode: codes[0]}} {
+		first, _ := c.store.GetUserMFA("jane@nyota.com")
+		second, _ := c.store.GetUserMFA("jane@nyota.com")
+		if !svc.checkSecondFactor(s, first, code) {
+			t.Fatalf("Expected code %+v accepted", code)
+		}
+		if svc.checkSecondFactor(s, second, code) {
+			t.Errorf("Expected code %+v accepted only once", code)
+		}
+	}
+	if mfa, _ := c.store.GetUserMFA("jane@nyota.com"); len(mfa.RecoveryCodes) != 1 || mfa.LastStep == 0 {
+		t.Errorf("Expected codes used up in store, got %+v", mfa)
+	}
+}
+
+func TestSecondFactorRequiredByTenant(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.store.BootstrapAdmin(nil, &config.Tenant{ID: "1", Name: "one", MFARequired: true}, &model.UserTenantDetails{UserName: "admin@nyota.com"})
+
+	var pending model.UserTenantBasicDetails
+	if code := c.do(utils.HttpPost, "/login", model.UserLogin{UserName: "admin@nyota.com", Password: "secret"}, &pending); code != http.StatusAccepted || !pending.MFAEnroll {
+		t.Fatalf("Expected login to require enrollment, got %d %+v", code, pending)
+	}
+	if code := c.do(utils.HttpPost, "/login/mfa", model.MFACode{Code: "123456"}, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected code before enrollment 400, got %d", code)
+	}
+	var enrollment model.MFAEnrollment
+	if code := c.do(utils.HttpPost, "/login/mfa/enroll", nil, &enrollment); code != http.StatusCreated || enrollment.Secret == "" {
+		t.Fatalf("Expected enrollment during login, got %d %+v", code, enrollment)
+	}
+	var details model.UserTenantBasicDetails
+	if code := c.do(utils.HttpPost, "/login/mfa", totp(enrollment.Secret, 0), &details); code != http.StatusOK || len(details.RecoveryCodes) != mfaRecoveryCodes {
+		t.Fatalf("Expected login with recovery codes of enrollment, got %d %+v", code, details)
+	}
+	if code := c.do(utils.HttpDelete, "/mfa", totp(enrollment.Secret, 1), nil); code != http.StatusBadRequest {
+		t.Errorf("Expected required second factor not removable, got %d", code)
+	}
+
+	// Wrong codes count as failed logins.
+	now := time.Now()
+	c.attempts.Now = func() time.Time { return now }
+	other := c.newSession()
+	other.login("admin@nyota.com", "secret")
+	for i := 0; i < LoginMaxFailures; i++ {
+		if code := other.do(utils.HttpPost, "/login/mfa", model.MFACode{Code: "abcdef"}, nil); code != http.StatusUnauthorized {
+			t.Fatalf("Expected wrong code %d 401, got %d", i+1, code)
+		}
+		now = now.Add(time.Minute)
+	}
+	resp, envelope := other.raw(utils.HttpPost, "/login/mfa", `{"code": "`+totp(enrollment.Secret, 1).Code+`"}`, nil)
+	if resp.StatusCode != http.StatusTooManyRequests || !strings.Contains(envelope.Message, "locked") {
+		t.Errorf("Expected second factor locked after failures, got %d %+v", resp.StatusCode, envelope)
+	}
+}
@@ -0,0 +1,273 @@
+package api
+
+import (
+	"goprizm/httputils"
+	"net/http"
+	"net/url"
+	"nyota/backend/ical"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// Occurrences are expanded for ranges of at most maxOccurrenceRange, at most maxEventOccurrences per
+// event.
+const (
+	maxOccurrenceRange  = 366 * 24 * time.Hour
+	maxEventOccurrences = 1000
+)
+
+// getEventOccurrences - occurrences of event from to to of the query with overrides applied and the
+// attendance of each.
+func (svc *Service) getEventOccurrences(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Get Event Occurrences... Id=%v", id)
+	from, to, ok := occurrenceRange(s, req.URL.Query(), true)
+	if !ok {
+		return
+	}
+	event, err := svc.Store.GetEventByID(s, id)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	occurrences, ok := svc.expandEvent(s, event, from, to)
+	if !ok {
+		return
+	}
+	attendees, err := svc.Store.GetEventAttendees(s, id)
+	if err != nil {
+		logutil.Errorf(s, "Get Event Attendees Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	for _, occurrence := range occurrences {
+		occurrence.Attendance = &config.EventAttendance{}
+		for _, attendee := range attendees {
+			if attendee.Occurrence == nil || attendee.Occurrence.Equal(occurrence.RecurrenceID) {
+				occurrence.Attendance.Count(attendee)
+			}
+		}
+	}
+	httputils.ServeJSON(w, occurrences)
+}
+
+// UpsertEventOccurrence - moves or cancels the occurrence of the path, the override replaces the
+// previous one.
+func (svc *Service) UpsertEventOccurrence(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Upsert Event Occurrence Invoked")
+	var occurrence config.EventOccurrence
+	utils.DecodeAndValidate(s, w, req, &occurrence)
+	if nil != s.Err {
+		return
+	}
+	event, existing, ok := svc.pathOccurrence(s, req)
+	if !ok {
+		return
+	}
+	if existing.Overridden {
+		utils.SetAuditOld(s, existing)
+	}
+	occurrence.EventID, occurrence.RecurrenceID = event.ID, existing.RecurrenceID
+	if occurrence.Start.IsZero() {
+		occurrence.Start = occurrence.RecurrenceID
+	}
+	occurrence.Start = occurrence.Start.UTC()
+	if err := svc.Store.UpsertEventOccurrence(s, &occurrence); err != nil {
+		logutil.Errorf(s, "Upsert Event Occurrence Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	occurrence.Overridden = true
+	utils.SetAuditNew(s, strconv.Itoa(event.ID), &occurrence)
+	httputils.ServeJSON(w, occurrence)
+}
+
+// DeleteEventOccurrence - removes the override of the occurrence of the path.
+func (svc *Service) DeleteEventOccurrence(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Delete Event Occurrence Invoked")
+	event, existing, ok := svc.pathOccurrence(s, req)
+	if !ok {
+		return
+	}
+	utils.SetAuditOld(s, existing)
+	if err := svc.Store.DeleteEventOccurrence(s, strconv.Itoa(event.ID), existing.RecurrenceID); err != nil {
+		logutil.Errorf(s, "Delete Event Occurrence Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// pathOccurrence - recurring event and occurrence of the request path, error is set to session when
+// the event doesn't recur or has no such occurrence.
+func (svc *Service) pathOccurrence(s *model.SessionContext, req *http.Request) (*config.Event, *config.EventOccurrence, bool) {
+	eventID, recurrenceID := mux.Vars(req)["id"], mux.Vars(req)["recurrenceId"]
+	logutil.Debugf(s, "Service layer - Get Event Occurrence... Event Id = %v Occurrence = %v", eventID, recurrenceID)
+	event, err := svc.Store.GetEventByID(s, eventID)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return nil, nil, false
+	}
+	if event.RRule == "" {
+		utils.SetPreconditionFailedError(s, "key_event_not_recurring")
+		return nil, nil, false
+	}
+	at, err := time.Parse(time.RFC3339, recurrenceID)
+	if err != nil {
+		utils.SetPreconditionFailedError(s, "key_occurrence_invalid")
+		return nil, nil, false
+	}
+	occurrence, ok := svc.eventOccurrence(s, event, at)
+	return event, occurrence, ok
+}
+
+// eventOccurrence - occurrence of event starting at recurrenceID by its rule, with override. Error is
+// set to session when there is no such occurrence.
+func (svc *Service) eventOccurrence(s *model.SessionContext, event *config.Event, recurrenceID time.Time) (*config.EventOccurrence, bool) {
+	if !eventOccurs(event, recurrenceID) {
+		utils.SetPreconditionFailedError(s, "key_occurrence_invalid")
+		return nil, false
+	}
+	occurrence := &config.EventOccurrence{EventID: event.ID, TenantID: event.TenantID,
+		RecurrenceID: recurrenceID.UTC(), Start: recurrenceID.UTC()}
+	if event.RRule == "" {
+		return occurrence, true
+	}
+	overrides, err := svc.Store.GetEventOccurrences(s, strconv.Itoa(event.ID))
+	if err != nil {
+		logutil.Errorf(s, "Get Event Occurrences Error - %v", err)
+		utils.SetStoreError(s, err)
+		return nil, false
+	}
+	for _, override := range overrides {
+		if override.RecurrenceID.Equal(recurrenceID) {
+			override.Overridden = true
+			return override, true
+		}
+	}
+	return occurrence, true
+}
+
+// expandEvent - occurrences of event starting from from to to, with overrides. Error is set to
+// session when overrides can't be read.
+func (svc *Service) expandEvent(s *model.SessionContext, event *config.Event, from, to time.Time) ([]*config.EventOccurrence, bool) {
+	var overrides []*config.EventOccurrence
+	if event.RRule != "" {
+		var err error
+		if overrides, err = svc.Store.GetEventOccurrences(s, strconv.Itoa(event.ID)); err != nil {
+			logutil.Errorf(s, "Get Event Occurrences Error - %v", err)
+			utils.SetStoreError(s, err)
+			return nil, false
+		}
+	}
+	return eventOccurrences(event, overrides, from, to), true
+}
+
+// eventOccurrences - occurrences of event starting from from to to in order of start. Moved
+// occurrences are listed at their new start, cancelled ones are listed with Cancelled.
+func eventOccurrences(event *config.Event, overrides []*config.EventOccurrence, from, to time.Time) []*config.EventOccurrence {
+	inRange := func(t time.Time) bool { return !t.Before(from) && t.Before(to) }
+	var starts []time.Time
+	if rule := eventRule(event); rule != nil {
+		starts = rule.Between(event.EventDate.In(event.Location()), from, to, maxEventOccurrences)
+	} else if !event.EventDate.IsZero() && inRange(event.EventDate) {
+		starts = []time.Time{event.EventDate}
+	}
+	byID := make(map[int64]*config.EventOccurrence)
+	for _, override := range overrides {
+		byID[override.RecurrenceID.UnixNano()] = override
+	}
+
+	occurrences := []*config.EventOccurrence{}
+	for _, start := range starts {
+		if excluded(event, start) {
+			continue
+		}
+		occurrence := &config.EventOccurrence{EventID: event.ID, TenantID: event.TenantID, RecurrenceID: start.UTC(), Start: start.UTC()}
+		if override, ok := byID[start.UnixNano()]; ok {
+			occurrence = override
+			occurrence.Overridden = true
+			delete(byID, start.UnixNano())
+		}
+		if inRange(occurrence.Start) {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+	// Occurrences moved into the range from outside of it.
+	for _, override := range byID {
+		if inRange(override.Start) && !inRange(override.RecurrenceID) && eventOccurs(event, override.RecurrenceID) {
+			override.Overridden = true
+			occurrences = append(occurrences, override)
+		}
+	}
+	sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].Start.Before(occurrences[j].Start) })
+	return occurrences
+}
+
+// eventOccurs - event has an occurrence starting at t by its date and rule, overrides aside.
+func eventOccurs(event *config.Event, t time.Time) bool {
+	rule := eventRule(event)
+	if rule == nil {
+		return t.Equal(event.EventDate)
+	}
+	return rule.Occurs(event.EventDate.In(event.Location()), t) && !excluded(event, t)
+}
+
+// eventRule - recurrence of event, nil for single events.
+func eventRule(event *config.Event) *ical.Recurrence {
+	if event.RRule == "" || event.EventDate.IsZero() {
+		return nil
+	}
+	rule, err := ical.ParseRecurrence(event.RRule)
+	if err != nil {
+		// Rules are validated when stored, the event is treated as single event.
+		return nil
+	}
+	return rule
+}
+
+func excluded(event *config.Event, t time.Time) bool {
+	for _, exDate := range event.ExDates {
+		if exDate.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// occurrenceRange - from and to of query as RFC 3339 times, zero when neither is given and not
+// required. Error is set to session for invalid and too long ranges.
+func occurrenceRange(s *model.SessionContext, query url.Values, required bool) (time.Time, time.Time, bool) {
+	if query.Get("from") == "" && query.Get("to") == "" && !required {
+		return time.Time{}, time.Time{}, true
+	}
+	from, fromErr := time.Parse(time.RFC3339, query.Get("from"))
+	to, toErr := time.Parse(time.RFC3339, query.Get("to"))
+	if fromErr != nil || toErr != nil || !from.Before(to) || to.Sub(from) > maxOccurrenceRange {
+		utils.SetPreconditionFailedError(s, "key_occurrence_range_invalid")
+		return time.Time{}, time.Time{}, false
+	}
+	return from, to, true
+}
+
+// queryOccurrence - occurrence of query as RFC 3339 time, nil when not given. Error is set to session
+// when it is invalid.
+func queryOccurrence(s *model.SessionContext, query url.Values) (*time.Time, bool) {
+	value := query.Get("occurrence")
+	if value == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		utils.SetPreconditionFailedError(s, "key_occurrence_invalid")
+		return nil, false
+	}
+	return &t, true
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"nyota/backend/ical"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+)
+
+func TestRecurringEvent(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+
+	// Weekly at 10:00 in Berlin, summer time starts on March 29.
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	if code := c.do(utils.HttpPost, "/events", map[string]interface{}{"event_name": "standup", "event_date": at(3, 23, 9),
+		"rrule": "FREQ=WEEKLY;COUNT=5;BYDAY=XX"}, nil); code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected invalid rule 422, got %d", code)
+	}
+	var event config.Event
+	if code := c.do(utils.HttpPost, "/events", map[string]interface{}{"event_name": "standup", "event_date": at(3, 23, 9),
+		"username": "admin@nyota.com", "time_zone": "Europe/Berlin", "rrule": "freq=weekly;count=6", "exdates": []time.Time{at(3, 30, 8)}}, &event); code != http.StatusOK {
+		t.Fatalf("Expected recurring event create 200, got %d", code)
+	}
+	if event.RRule != "FREQ=WEEKLY;COUNT=6" {
+		t.Errorf("Expected rule in canonical form, got %q", event.RRule)
+	}
+	eventPath := "/events/" + strconv.Itoa(event.ID)
+	occurrencePath := func(t time.Time) string { return eventPath + "/occurrences/" + t.Format(time.RFC3339) }
+	rangeQuery := "?from=2026-03-01T00:00:00Z&to=2026-05-01T00:00:00Z"
+
+	if code := c.do(utils.HttpPut, occurrencePath(at(4, 6, 8)), map[string]interface{}{"start": at(4, 7, 8)}, nil); code != http.StatusOK {
+		t.Fatalf("Expected move of occurrence 200, got %d", code)
+	}
+	if code := c.do(utils.HttpPut, occurrencePath(at(4, 13, 8)), map[string]interface{}{"cancelled": true}, nil); code != http.StatusOK {
+		t.Fatalf("Expected cancel of occurrence 200, got %d", code)
+	}
+	if code := c.do(utils.HttpPut, occurrencePath(at(4, 13, 9)), map[string]interface{}{"cancelled": true}, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected override of no occurrence 400, got %d", code)
+	}
+	var occurrences []*config.EventOccurrence
+	c.do(utils.HttpGet, eventPath+"/occurrences"+rangeQuery, nil, &occurrences)
+	expected := []time.Time{at(3, 23, 9), at(4, 7, 8), at(4, 13, 8), at(4, 20, 8), at(4, 27, 8)}
+	if len(occurrences) != len(expected) {
+		t.Fatalf("Expected %d occurrences, got %+v", len(expected), occurrences)
+	}
+	for i, occurrence := range occurrences {
+		if !occurrence.Start.Equal(expected[i]) {
+			t.Errorf("Expected occurrence %d at %s, got %s", i, expected[i], occurrence.Start)
+		}
+	}
+	if !occurrences[1].Overridden || !occurrences[1].RecurrenceID.Equal(at(4, 6, 8)) || !occurrences[2].Cancelled {
+		t.Errorf("Expected moved and cancelled occurrences, got %+v %+v", occurrences[1], occurrences[2])
+	}
+	var events config.EventList
+	if c.do(utils.HttpGet, "/events?from=2026-04-01T00:00:00Z&to=2026-04-15T00:00:00Z", nil, &events); len(events.Events) != 1 || len(events.Events[0].Occurrences) != 2 {
+		t.Errorf("Expected events with occurrences of range, got %+v", events.Events)
+	}
+	if code := c.do(utils.HttpGet, "/events?from=2026-04-01T00:00:00Z", nil, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected range without end 400, got %d", code)
+	}
+
+	register := func(occurrence interface{}) (config.Attendee, int) {
+		var attendee config.Attendee
+		code := c.do(utils.HttpPost, eventPath+"/attendees", map[string]interface{}{"name": "Ann", "email": "ann@example.com", "occurrence": occurrence}, &attendee)
+		return attendee, code
+	}
+	if _, code := register(nil); code != http.StatusBadRequest {
+		t.Errorf("Expected registration without occurrence 400, got %d", code)
+	}
+	if _, code := register(at(4, 13, 8)); code != http.StatusBadRequest {
+		t.Errorf("Expected registration for cancelled occurrence 400, got %d", code)
+	}
+	attendee, code := register(at(4, 6, 8))
+	if code != http.StatusCreated {
+		t.Fatalf("Expected registration for moved occurrence 201, got %d", code)
+	}
+	if _, code := register(at(4, 20, 8)); code != http.StatusCreated {
+		t.Errorf("Expected registration of email for another occurrence 201, got %d", code)
+	}
+	if code := c.do(utils.HttpPost, eventPath+"/checkin", map[string]interface{}{"ticket": attendee.Ticket, "occurrence": at(4, 20, 8)}, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected ticket of other occurrence 400, got %d", code)
+	}
+	if code := c.do(utils.HttpPost, eventPath+"/checkin", map[string]interface{}{"ticket": attendee.Ticket, "occurrence": at(4, 6, 8)}, nil); code != http.StatusOK {
+		t.Errorf("Expected check-in at occurrence 200, got %d", code)
+	}
+	var attendees []config.Attendee
+	if c.do(utils.HttpGet, eventPath+"/attendees?occurrence=2026-04-06T08:00:00Z", nil, &attendees); len(attendees) != 1 || attendees[0].ID != attendee.ID {
+		t.Errorf("Expected attendees of occurrence, got %+v", attendees)
+	}
+	c.do(utils.HttpGet, eventPath+"/occurrences"+rangeQuery, nil, &occurrences)
+	if attendance := occurrences[1].Attendance; attendance.Registered != 1 || attendance.CheckedIn != 1 {
+		t.Errorf("Expected attendance of occurrence, got %+v", attendance)
+	}
+
+	resp, _ := c.raw(utils.HttpGet, "/events/qr/"+strconv.Itoa(event.ID)+"?occurrence=2026-04-06T08:00:00Z", "", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected QR code of occurrence 200, got %d", resp.StatusCode)
+	}
+	resp, _ = c.raw(utils.HttpGet, "/events/qr/"+strconv.Itoa(event.ID)+"?occurrence=2026-04-06T09:00:00Z", "", nil)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected QR code of no occurrence 400, got %d", resp.StatusCode)
+	}
+
+	req := c.newRequest(utils.HttpGet, eventPath+".ics", nil)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		t.Fatalf("GET ics failed: %v", err)
+	}
+	cal, err := ical.Parse(resp.Body)
+	resp.Body.Close()
+	if err != nil || len(cal.Events) != 2 {
+		t.Fatalf("Expected recurring event and moved occurrence, got %v %v", cal, err)
+	}
+	if master := cal.Events[0]; master.RRule != event.RRule || len(master.ExDates) != 2 || master.Start.Location().String() != "Europe/Berlin" {
+		t.Errorf("Expected rule with excluded and cancelled occurrences, got %+v", master)
+	}
+	if moved := cal.Events[1]; !moved.RecurrenceID.Equal(at(4, 6, 8)) || !moved.Start.Equal(at(4, 7, 8)) {
+		t.Errorf("Expected moved occurrence, got %+v", moved)
+	}
+
+	if code := c.do(utils.HttpDelete, occurrencePath(at(4, 13, 8)), nil, nil); code != http.StatusOK {
+		t.Errorf("Expected override removed 200, got %d", code)
+	}
+	if _, code := register(at(4, 13, 8)); code != http.StatusCreated {
+		t.Errorf("Expected registration for restored occurrence 201, got %d", code)
+	}
+}
@@ -0,0 +1,186 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"nyota/backend/blob"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// QR codes of events are served in these formats, at these edge lengths in pixels.
+var (
+	qrContentTypes = map[string]string{"png": "image/png", "svg": "image/svg+xml"}
+	qrSizes        = []int{128, 256, 512, 1024}
+)
+
+const (
+	defaultQRFormat = "png"
+	defaultQRSize   = 256
+	qrCacheControl  = "private, max-age=86400" // codes of an event never change
+)
+
+// getEventQrByID - QR code of event in format and size of the query. Codes are kept in the blob
+// store and generated again whenever they are missing there. Codes of an occurrence of a recurring
+// event are generated for each request.
+func (svc *Service) getEventQrByID(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Get Event QR By Id... Id=%v", id)
+	format, size, ok := qrParams(s, req.URL.Query())
+	if !ok {
+		return
+	}
+	at, ok := queryOccurrence(s, req.URL.Query())
+	if !ok {
+		return
+	}
+	event, err := svc.Store.GetEventByID(s, id)
+	if err != nil {
+		logutil.Errorf(s, "Get Event QR Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	var data []byte
+	if at != nil {
+		if _, ok := svc.eventOccurrence(s, event, *at); !ok {
+			return
+		}
+		if data, err = encodeQR(eventOccurrenceQRContent(event.ID, *at), format, size); err != nil {
+			logutil.Errorf(s, "Event QR code Error - %v", err)
+			utils.SetSomethingWrong(s)
+			return
+		}
+	} else if data, ok = svc.storedEventQR(s, event.ID, format, size); !ok {
+		return
+	}
+
+	sum := sha256.Sum256(data)
+	tag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	for _, header := range []string{"Expires", "Pragma", "X-Accel-Expires"} {
+		w.Header().Del(header)
+	}
+	w.Header().Set("Cache-Control", qrCacheControl)
+	if notModifiedTag(w, req, tag) {
+		return
+	}
+	w.Header().Set(utils.HTTPETagKey, tag)
+	w.Header().Set(utils.HTTPContentTypeKey, qrContentTypes[format])
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Write(data)
+}
+
+// storedEventQR - QR code of event from the blob store, generated and stored when it is missing
+// there. Error is set to session when it can't be generated.
+func (svc *Service) storedEventQR(s *model.SessionContext, eventID int, format string, size int) ([]byte, bool) {
+	key := eventQRKey(eventID, format, size)
+	data, err := svc.Blobs.Get(key)
+	if err == nil {
+		return data, true
+	}
+	if err != blob.ErrNotFound {
+		logutil.Errorf(s, "Get Event QR blob %s Error - %v", key, err)
+	}
+	if data, err = encodeQR(eventQRContent(eventID), format, size); err != nil {
+		logutil.Errorf(s, "Event QR code Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return nil, false
+	}
+	if err := svc.Blobs.Put(key, data, qrContentTypes[format]); err != nil {
+		logutil.Errorf(s, "Put Event QR blob %s Error - %v", key, err)
+	}
+	return data, true
+}
+
+// deleteEventQRCodes - removes the stored QR codes of event. Failures leave unreachable objects
+// behind, they are logged and don't fail the request.
+func (svc *Service) deleteEventQRCodes(s *model.SessionContext, eventID int) {
+	for format := range qrContentTypes {
+		for _, size := range qrSizes {
+			if err := svc.Blobs.Delete(eventQRKey(eventID, format, size)); err != nil {
+				logutil.Errorf(s, "Delete Event QR blob Error - %v", err)
+			}
+		}
+	}
+}
+
+// qrParams - format and size of query, defaults when not given. Error is set to session for others.
+func qrParams(s *model.SessionContext, query url.Values) (string, int, bool) {
+	format, size := defaultQRFormat, defaultQRSize
+	if value := query.Get("format"); value != "" {
+		if _, ok := qrContentTypes[value]; !ok {
+			utils.SetPreconditionFailedError(s, "key_qr_format_invalid")
+			return "", 0, false
+		}
+		format = value
+	}
+	if value := query.Get("size"); value != "" {
+		size, _ = strconv.Atoi(value)
+		valid := false
+		for _, qrSize := range qrSizes {
+			valid = valid || size == qrSize
+		}
+		if !valid {
+			utils.SetPreconditionFailedError(s, "key_qr_size_invalid")
+			return "", 0, false
+		}
+	}
+	return format, size, true
+}
+
+func eventQRKey(eventID int, format string, size int) string {
+	return fmt.Sprintf("events/%d/qr-%d.%s", eventID, size, format)
+}
+
+func eventQRContent(eventID int) string {
+	return "http://google.com/search?q=" + strconv.Itoa(eventID)
+}
+
+func eventOccurrenceQRContent(eventID int, occurrence time.Time) string {
+	return eventQRContent(eventID) + "&occurrence=" + url.QueryEscape(occurrence.UTC().Format(time.RFC3339))
+}
+
+// encodeQR - QR code of content as PNG or SVG image of size pixels.
+func encodeQR(content, format string, size int) ([]byte, error) {
+	qr, err := qrcode.New(content, qrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+	if format == "svg" {
+		return qrSVG(qr.Bitmap(), size), nil
+	}
+	return qr.PNG(size)
+}
+
+// qrSVG - dark modules of bitmap as a single path, runs of a row merged, scaled to size by the view box.
+func qrSVG(bitmap [][]bool, size int) []byte {
+	n := len(bitmap)
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`,
+		size, size, n, n)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, n, n)
+	for y, row := range bitmap {
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+			run := 1
+			for x+run < len(row) && row[x+run] {
+				run++
+			}
+			fmt.Fprintf(&b, "M%d %dh%dv1h-%dz", x, y, run, run)
+			x += run - 1
+		}
+	}
+	b.WriteString(`"/></svg>`)
+	return b.Bytes()
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"nyota/backend/blob"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+)
+
+func TestEventQRCode(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+
+	var event config.Event
+	c.do(utils.HttpPost, "/events", map[string]interface{}{"event_name": "launch"}, &event)
+	qrPath := "/events/qr/" + strconv.Itoa(event.ID)
+	if _, err := c.blobs.Get(eventQRKey(event.ID, "png", defaultQRSize)); err != blob.ErrNotFound {
+		t.Fatalf("Expected QR code generated on demand only, got %v", err)
+	}
+
+	resp, _ := c.raw(utils.HttpGet, qrPath, "", nil)
+	tag := resp.Header.Get(utils.HTTPETagKey)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get(utils.HTTPContentTypeKey) != "image/png" ||
+		resp.Header.Get("Cache-Control") != qrCacheControl || resp.Header.Get("Pragma") != "" || tag == "" {
+		t.Fatalf("Expected cacheable PNG, got %d %v", resp.StatusCode, resp.Header)
+	}
+	if _, err := c.blobs.Get(eventQRKey(event.ID, "png", defaultQRSize)); err != nil {
+		t.Fatalf("Expected QR code kept in blob store, got %v", err)
+	}
+	if resp, _ = c.raw(utils.HttpGet, qrPath, "", map[string]string{utils.HTTPIfNoneMatchKey: tag}); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Expected 304 for known QR code, got %d", resp.StatusCode)
+	}
+
+	req := c.newRequest(utils.HttpGet, qrPath+"?format=svg&size=512", nil)
+	svgResp, err := c.client.Do(req)
+	if err != nil {
+		t.Fatalf("GET %s failed: %v", qrPath, err)
+	}
+	svg, _ := ioutil.ReadAll(svgResp.Body)
+	svgResp.Body.Close()
+	if svgResp.Header.Get(utils.HTTPContentTypeKey) != "image/svg+xml" || !strings.Contains(string(svg), `width="512"`) {
+		t.Fatalf("Expected SVG of 512 pixels, got %s %s", svgResp.Header.Get(utils.HTTPContentTypeKey), svg)
+	}
+	for _, query := range []string{"?format=jpeg", "?size=100", "?size=big"} {
+		if code := c.do(utils.HttpGet, qrPath+query, nil, nil); code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for %s, got %d", query, code)
+		}
+	}
+
+	if code := c.do(utils.HttpDelete, "/events/"+strconv.Itoa(event.ID), nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected event delete 200, got %d", code)
+	}
+	for _, key := range []string{eventQRKey(event.ID, "png", defaultQRSize), eventQRKey(event.ID, "svg", 512)} {
+		if _, err := c.blobs.Get(key); err != blob.ErrNotFound {
+			t.Errorf("Expected %s deleted with event, got %v", key, err)
+		}
+	}
+	if code := c.do(utils.HttpGet, qrPath, nil, nil); code != http.StatusNotFound {
+		t.Errorf("Expected 404 for QR code of deleted event, got %d", code)
+	}
+}
+
+func TestQRSVG(t *testing.T) {
+	svg := string(qrSVG([][]bool{{true, true, false}, {false, false, true}, {true, false, true}}, 64))
+	if !strings.Contains(svg, `viewBox="0 0 3 3"`) || !strings.Contains(svg, `d="M0 0h2v1h-2zM2 1h1v1h-1zM0 2h1v1h-1zM2 2h1v1h-1z"`) {
+		t.Errorf("Expected merged runs of dark modules, got %s", svg)
+	}
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+)
+
+func TestListQuery(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "secret")
+	for _, name := range []string{"guest", "staff", "guest-wifi", "contractor"} {
+		c.do(utils.HttpPost, "/roles", map[string]interface{}{"name": name, "description": "role " + name}, nil)
+	}
+
+	var roles config.RoleList
+	if code := c.do(utils.HttpGet, "/roles?sort=-name&page=2&page_size=2", nil, &roles); code != http.StatusOK {
+		t.Fatalf("Expected roles, got %d", code)
+	}
+	if roles.Total != 4 || roles.Page != 2 || roles.PageSize != 2 || len(roles.Roles) != 2 ||
+		roles.Roles[0].Name != "guest" || roles.Roles[1].Name != "contractor" || len(roles.Structure) == 0 {
+		t.Errorf("Expected second page by name descending, got %+v", roles)
+	}
+
+	roles = config.RoleList{}
+	c.do(utils.HttpGet, "/roles?q=GUEST&filter=name:ne:guest", nil, &roles)
+	if roles.Total != 1 || len(roles.Roles) != 1 || roles.Roles[0].Name != "guest-wifi" {
+		t.Errorf("Expected search and filter to match guest-wifi, got %+v", roles)
+	}
+
+	roles = config.RoleList{}
+	c.do(utils.HttpGet, "/roles?filter=name:in:staff,contractor&filter=name:like:%25", nil, &roles)
+	if roles.Total != 0 {
+		t.Errorf("Expected like to match a literal %%, got %+v", roles)
+	}
+
+	tests := []struct {
+		query, field string
+	}{
+		{"sort=permit_id", "sort"},
+		{"sort=-extras", "sort"},
+		{"filter=name", "filter"},
+		{"filter=name:regex:.*", "filter"},
+		{"filter=permit_id:eq:1", "filter"},
+		{"filter=updated_at:like:2020", "filter"},
+		{"filter=updated_at:gt:yesterday", "filter"},
+		{"page=0", "page"},
+		{"page_size=many", "page_size"},
+	}
+	for _, test := range tests {
+		resp, envelope := c.raw(utils.HttpGet, "/roles?"+test.query, "", nil)
+		if resp.StatusCode != http.StatusBadRequest || envelope.Fields[test.field] == "" {
+			t.Errorf("Expected 400 for %s on %s, got %d %+v", test.query, test.field, resp.StatusCode, envelope)
+		}
+	}
+
+	c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "east", "uuid": "u-1"}, nil)
+	c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "west", "uuid": "u-2"}, nil)
+	resp, _ := c.raw(utils.HttpGet, "/clusters?page_size=1&sort=name", "", nil)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get(utils.HTTPTotalCountKey) != "2" {
+		t.Errorf("Expected total count of clusters, got %d %q", resp.StatusCode, resp.Header.Get(utils.HTTPTotalCountKey))
+	}
+}
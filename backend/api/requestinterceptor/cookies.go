@@ -0,0 +1,66 @@
+package requestinterceptor
+
+import (
+	"encoding/base64"
+	"fmt"
+	"goprizm/sysutils"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+)
+
+// Session keys. SESSION_KEYS has comma separated pairs "<hash key>:<block key>" of base64 keys, the
+// hash key signs cookies (at least 32 bytes) and the block key encrypts them (AES, 16, 24 or 32
+// bytes). New cookies use the first pair, the others are still accepted so keys can be rotated by
+// prepending a new pair and removing the old one once its cookies expired.
+const (
+	minHashKeyLength = 32
+	sessionKeysEnv   = "SESSION_KEYS"
+)
+
+// SessionKeyPairs - hash and block key pairs of spec, in the order of securecookie.CodecsFromPairs.
+func SessionKeyPairs(spec string) ([][]byte, error) {
+	var pairs [][]byte
+	for i, pair := range strings.Split(spec, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("key pair %d is not <hash key>:<block key>", i+1)
+		}
+		hashKey, err := base64.StdEncoding.DecodeString(parts[0])
+		if err != nil || len(hashKey) < minHashKeyLength {
+			return nil, fmt.Errorf("hash key %d must be base64 of at least %d bytes", i+1, minHashKeyLength)
+		}
+		blockKey, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil || (len(blockKey) != 16 && len(blockKey) != 24 && len(blockKey) != 32) {
+			return nil, fmt.Errorf("block key %d must be base64 of 16, 24 or 32 bytes", i+1)
+		}
+		pairs = append(pairs, hashKey, blockKey)
+	}
+	return pairs, nil
+}
+
+// sessionKeys - key pairs of SESSION_KEYS, random keys when not set. Random keys don't survive a
+// restart and differ between instances, every restart logs all users out.
+func sessionKeys() ([][]byte, error) {
+	spec := sysutils.Getenv(sessionKeysEnv, "")
+	if spec == "" {
+		return [][]byte{securecookie.GenerateRandomKey(64), securecookie.GenerateRandomKey(32)}, nil
+	}
+	return SessionKeyPairs(spec)
+}
+
+// cookieOptions - options of session cookies of r that live maxAge seconds. Scripts can't read them,
+// other sites only send them with top level navigation (e.g. the single sign-on callback), and only
+// over https unless the backend is reached over http.
+func cookieOptions(r *http.Request, maxAge int) *sessions.Options {
+	return &sessions.Options{Path: "/", MaxAge: maxAge, HttpOnly: true, Secure: secureCookies(r), SameSite: http.SameSiteLaxMode}
+}
+
+// secureCookies - SESSION_COOKIE_SECURE, by default whether r or PUBLIC_URL use https.
+func secureCookies(r *http.Request) bool {
+	https := r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" ||
+		strings.HasPrefix(sysutils.Getenv("PUBLIC_URL", ""), "https://")
+	return sysutils.GetenvBool("SESSION_COOKIE_SECURE", https)
+}
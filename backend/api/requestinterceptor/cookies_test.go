@@ -0,0 +1,41 @@
+package requestinterceptor
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/securecookie"
+)
+
+func key(n int) string {
+	return base64.StdEncoding.EncodeToString(securecookie.GenerateRandomKey(n))
+}
+
+func TestSessionKeyPairs(t *testing.T) {
+	current, previous := key(32)+":"+key(32), key(64)+":"+key(16)
+	pairs, err := SessionKeyPairs(current + ", " + previous)
+	if err != nil || len(pairs) != 4 || len(pairs[2]) != 64 || len(pairs[3]) != 16 {
+		t.Fatalf("Expected two key pairs, got %d: %v", len(pairs), err)
+	}
+
+	// Cookies of the previous keys are still accepted after rotation.
+	old := securecookie.CodecsFromPairs(pairs[2:]...)
+	encoded, _ := securecookie.EncodeMulti("session", "value", old...)
+	var value string
+	if err := securecookie.DecodeMulti("session", encoded, &value, securecookie.CodecsFromPairs(pairs...)...); err != nil || value != "value" {
+		t.Errorf("Expected cookie of previous key pair to decode, got %q: %v", value, err)
+	}
+
+	invalid := map[string]string{
+		"no block key":     key(32),
+		"short hash key":   key(16) + ":" + key(32),
+		"block key length": key(32) + ":" + key(20),
+		"not base64":       strings.Repeat("!", 44) + ":" + key(32),
+	}
+	for name, spec := range invalid {
+		if _, err := SessionKeyPairs(spec); err == nil {
+			t.Errorf("Expected %s to be refused", name)
+		}
+	}
+}
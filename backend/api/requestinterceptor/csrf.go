@@ -0,0 +1,60 @@
+package requestinterceptor
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"nyota/backend/auth"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+
+	"github.com/gorilla/sessions"
+)
+
+var (
+	// CSRF token of session, readable by the UI from this cookie and sent back in utils.HTTPCSRFTokenKey.
+	csrfCookieName = "csrf-token"
+	csrfTokenKey   = "csrf-token"
+)
+
+// csrfToken - token of session, a new one for sessions without.
+func csrfToken(session *sessions.Session) string {
+	token, _ := session.Values[csrfTokenKey].(string)
+	if token == "" {
+		token, _ = auth.RandomToken(24)
+		session.Values[csrfTokenKey] = token
+	}
+	return token
+}
+
+// setCSRFCookie - makes token of the session readable by scripts of the UI, for as long as the session
+// cookie lives.
+func setCSRFCookie(r *http.Request, w http.ResponseWriter, token string, maxAge int) {
+	options := cookieOptions(r, maxAge)
+	options.HttpOnly = false
+	http.SetCookie(w, sessions.NewCookie(csrfCookieName, token, options))
+}
+
+/*CheckCSRF refuses POST, PUT and DELETE requests of sessions without their CSRF token, to be chained after ValidateSession.
+Only GET is exempt. Requests with API tokens carry no cookies and need none.*/
+func CheckCSRF() Interceptor {
+
+	return func(f PrizmHandler) PrizmHandler {
+
+		return func(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
+			if r.Method == utils.HttpGet || s.User.SessionID == "" {
+				f(s, w, r)
+				return
+			}
+			session, _ := store.Get(r, loginCokieName)
+			expected, _ := session.Values[csrfTokenKey].(string)
+			token := r.Header.Get(utils.HTTPCSRFTokenKey)
+			if expected == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+				logutil.Errorf(s, "CSRF token check failed. URL - %s  Method - %s", r.URL, r.Method)
+				utils.SetForbiddenError(s)
+				return
+			}
+			f(s, w, r)
+		}
+	}
+}
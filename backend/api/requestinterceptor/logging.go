@@ -8,10 +8,18 @@ import (
 	"runtime"
 	"strings"
 	"time"
+
+	"github.com/gorilla/mux"
 )
 
-// TrackReqResp logs all requests with its path and the time it took to process
-func TrackReqResp() Interceptor {
+// AuditLogger - persists audit log entries.
+type AuditLogger interface {
+	AddAuditLog(entry *model.AuditLog) error
+}
+
+// TrackReqResp logs all requests with its path and the time it took to process. Successful
+// mutating requests are written to auditLog, nil only logs them.
+func TrackReqResp(auditLog AuditLogger) Interceptor {
 
 	// Create a new Middleware
 	return func(f PrizmHandler) PrizmHandler {
@@ -37,7 +45,7 @@ func TrackReqResp() Interceptor {
 				}
 				logutil.Debugf(s, "URL - %s  Method - %s Completed with Time Taken - %s", r.URL, r.Method, time.Since(start))
 
-				addAuditLogs(s, r)
+				addAuditLogs(s, r, auditLog)
 			}()
 
 			// Call the next middleware/handler in chain
@@ -46,23 +54,65 @@ func TrackReqResp() Interceptor {
 	}
 }
 
-func addAuditLogs(s *model.SessionContext, r *http.Request) {
+func addAuditLogs(s *model.SessionContext, r *http.Request, auditLog AuditLogger) {
 	// Add Audit logs only when there is no error message
-	if s.Err == nil {
-		if "" != s.AuditData {
-			logutil.Debugf(s, "Audit Log - Entity:%s, Action:%s, Data:%s",
-				getEntity(r.URL.Path), getAction(r.Method), s.AuditData)
-		} else {
-			logutil.Debugf(s, "Audit Log - Entity:%s, Action:%s",
-				getEntity(r.URL.Path), getAction(r.Method))
-		}
-
+	if s.Err != nil {
+		return
+	}
+	entity, id := auditTarget(r)
+	action := getAction(r.Method)
+	if "" != s.AuditData {
+		logutil.Debugf(s, "Audit Log - Entity:%s, Action:%s, Data:%s", entity, action, s.AuditData)
+	} else {
+		logutil.Debugf(s, "Audit Log - Entity:%s, Action:%s", entity, action)
+	}
+	if auditLog == nil || action == "Read" || action == "" {
+		return
+	}
+	if s.AuditID != "" {
+		id = s.AuditID
+	}
+	entry := &model.AuditLog{
+		TenantID:   s.User.TenantId,
+		UserName:   s.User.UserName,
+		Entity:     entity,
+		EntityID:   id,
+		Action:     action,
+		OldData:    model.AuditData(s.AuditOld),
+		NewData:    model.AuditData(s.AuditData),
+		RequestID:  s.RequestID,
+		RemoteAddr: r.RemoteAddr,
+		AddedAt:    time.Now(),
+	}
+	if action == model.AuditActionDelete {
+		entry.NewData = ""
+	}
+	if err := auditLog.AddAuditLog(entry); err != nil {
+		logutil.Errorf(s, "Audit Log - write failed for Entity:%s, Id:%s, Action:%s: %v", entity, id, action, err)
 	}
 }
 
-func getEntity(a string) string {
-	s := strings.SplitAfter(a, "/")
-	return s[len(s)-1]
+// auditTarget - entity and id changed by request, from the last constant segment of its route
+// and the variable following it: "/clusters/{id}/credentials/{keyId}" is entity credentials
+// with the id in keyId.
+func auditTarget(r *http.Request) (string, string) {
+	path := r.URL.Path
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			path = template
+		}
+	}
+	vars := mux.Vars(r)
+	entity, id := "", ""
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		if strings.HasPrefix(segment, "{") {
+			name := strings.SplitN(strings.Trim(segment, "{}"), ":", 2)[0]
+			id = vars[name]
+			continue
+		}
+		entity, id = segment, ""
+	}
+	return entity, id
 }
 
 func getAction(m string) string {
@@ -70,11 +120,11 @@ func getAction(m string) string {
 	case "GET":
 		return "Read"
 	case "POST":
-		return "Add"
+		return model.AuditActionAdd
 	case "PUT":
-		return "Update"
+		return model.AuditActionUpdate
 	case "DELETE":
-		return "Delete"
+		return model.AuditActionDelete
 	}
 	return ""
 }
@@ -21,11 +21,11 @@ var noCacheHeaders = map[string]string{
 	"X-Accel-Expires": "0",
 }
 
+// Conditional request headers handlers don't evaluate. If-Match and If-None-Match are kept, handlers
+// of config resources compare them with the ETag of the resource version.
 var etagHeaders = []string{
 	"ETag",
 	"If-Modified-Since",
-	"If-Match",
-	"If-None-Match",
 	"If-Range",
 	"If-Unmodified-Since",
 }
@@ -39,7 +39,7 @@ func AddNoCacheHeader() Interceptor {
 		// Define the http.HandlerFunc
 		return func(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
 
-			// Delete any ETag headers that may have been set
+			// Delete conditional headers that may have been set
 			for _, v := range etagHeaders {
 				if r.Header.Get(v) != "" {
 					r.Header.Del(v)
@@ -34,7 +34,7 @@ func RBACCheck(group, permission string) Interceptor {
 			if isAllowed == false {
 				// Permission and group not matching...
 				logutil.Errorf(s, "RBAC check failed for URL - %s", r.URL)
-				s.Err = &model.AppError{Type: utils.AccessError, Message: "Forbidden: Access is denied", Code: http.StatusForbidden}
+				utils.SetForbiddenError(s)
 				return
 			}
 			// Call the next middleware/handler in chain
@@ -1,21 +1,22 @@
 package requestinterceptor
 
 import (
+	"goprizm/sysutils"
+	"log"
+	"nyota/backend/auth"
 	"nyota/backend/i18n"
 	"nyota/backend/logutil"
 	"nyota/backend/model"
+	"nyota/backend/model/config"
 	"nyota/backend/utils"
-	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/sessions"
 	rstore "gopkg.in/boj/redistore.v1"
 )
 
 var (
-	// key must be 16, 24 or 32 bytes long (AES-128, AES-192 or AES-256)
-	// TODO: Fix the key string.
-	key   = []byte("SfTuB!#&D55663*^329")
 	store sessions.Store
 
 	// Cokie Name
@@ -25,19 +26,53 @@ var (
 	userAuthenticated = "authenticated"
 	userNameKey       = "loggedin-user-name"
 	userTenantIDKey   = "loggedin-user-tenant-id"
-	userPermissionKey = "loggedin-user-permission"
+	sessionIDKey      = "loggedin-session-id"
 
-	// 15 mins * 60 sec
-	maxAge = 900
+	// Signed cookies older than the longest idle lifetime are refused, within it the session record
+	// decides. Cookies of a session get the idle lifetime of its tenant.
+	maxAge = config.MaxSessionIdleMinutes * 60
+
+	// Single sign-on in progress, from the redirect to the identity provider to its callback.
+	ssoCookieName = "sso-flow-cookie"
+	ssoMaxAge     = 600
+
+	// Password checked, login waits for the second factor.
+	preAuthCookieName = "mfa-preauth-cookie"
+	preAuthMaxAge     = 300
 )
 
+// SSOFlow - single sign-on in progress. State, nonce and PKCE verifier never leave the server
+// but for the cookie, the provider only gets the state and hashes of the others.
+type SSOFlow struct {
+	ProviderID string
+	State      string
+	Nonce      string
+	Verifier   string
+}
+
+// Authenticator - checks sessions and API tokens and provides current role and permissions of users
+// of the session tenant. Sessions only keep the user and the id of the server side session record, so
+// revocations, role changes and reassignments apply to the next request.
+type Authenticator interface {
+	CheckSession(s *model.SessionContext, id, userName string) (idle time.Duration, err error)
+	UserPermissions(s *model.SessionContext, userName string) (role string, permissions map[string]string, err error)
+	TokenPermissions(s *model.SessionContext, bearer string) (token *model.APIToken, permissions map[string]string, err error)
+}
+
 func init() {
+	keys, err := sessionKeys()
+	if err != nil {
+		log.Fatalf("Invalid %s: %v", sessionKeysEnv, err)
+	}
+	if sysutils.Getenv(sessionKeysEnv, "") == "" {
+		logutil.Printf(nil, "%s not set, sessions use random keys and end with a restart", sessionKeysEnv)
+	}
 	redisOpts := utils.RedisOptions("")
-	rstore, storeErr := rstore.NewRediStore(10, redisOpts.Network, redisOpts.Addr, "", key)
+	rstore, storeErr := rstore.NewRediStore(10, redisOpts.Network, redisOpts.Addr, "", keys...)
 	if storeErr != nil {
 		logutil.Printf(nil, "Cookie store in use for sessions")
 		// Case when session is stored in cookie in local disc.
-		cookieStore := sessions.NewCookieStore(key)
+		cookieStore := sessions.NewCookieStore(keys...)
 		cookieStore.MaxAge(maxAge)
 		store = cookieStore
 	} else {
@@ -48,20 +83,26 @@ func init() {
 	}
 }
 
-/*StartSession should be called as part of login to create new session and save user details.*/
+/*StartSession should be called as part of login, after adding the session record, to save user details.*/
 func StartSession(s *model.SessionContext, r *http.Request, w http.ResponseWriter,
-	userName string, tenantID string, permission map[string]string) {
+	userSession *model.UserSession, idle time.Duration, role string, permission map[string]string) {
 
 	session, _ := store.Get(r, loginCokieName)
-	tempPerm, _ := json.Marshal(permission)
 
 	// Set user as authenticated
 	session.Values[userAuthenticated] = true
-	session.Values[userNameKey] = userName
-	session.Values[userTenantIDKey] = tenantID
-	session.Values[userPermissionKey] = tempPerm
+	session.Values[userNameKey] = userSession.UserName
+	session.Values[userTenantIDKey] = userSession.TenantID
+	session.Values[sessionIDKey] = userSession.ID
+	// New token with every login, tokens of earlier sessions of the browser are useless.
+	delete(session.Values, csrfTokenKey)
+	token := csrfToken(session)
+	session.Options = cookieOptions(r, int(idle/time.Second))
 	session.Save(r, w)
-	setUserContextDataForAPI(s, tenantID, userName, permission, r.Header.Get(utils.HTTPAcceptLanguageKey))
+	setCSRFCookie(r, w, token, session.Options.MaxAge)
+	w.Header().Set(utils.HTTPCSRFTokenKey, token)
+	setUserContextDataForAPI(s, userSession.TenantID, userSession.UserName, role, permission, r.Header.Get(utils.HTTPAcceptLanguageKey))
+	s.User.SessionID = userSession.ID
 }
 
 /*EndSession should be called as part of logout to clear session.*/
@@ -73,8 +114,72 @@ func EndSession(r *http.Request, w http.ResponseWriter) {
 	session.Values[userAuthenticated] = false
 	session.Values[userNameKey] = ""
 	session.Values[userTenantIDKey] = ""
-	session.Values[userPermissionKey] = ""
-	session.Options.MaxAge = -1
+	session.Values[sessionIDKey] = ""
+	delete(session.Values, csrfTokenKey)
+	session.Options = cookieOptions(r, -1)
+	session.Save(r, w)
+	setCSRFCookie(r, w, "", -1)
+}
+
+/*StartSSOFlow should be called before redirecting to the identity provider, its callback gets the flow back from EndSSOFlow.*/
+func StartSSOFlow(r *http.Request, w http.ResponseWriter, flow SSOFlow) error {
+	session, _ := store.New(r, ssoCookieName)
+	session.Values["provider"] = flow.ProviderID
+	session.Values["state"] = flow.State
+	session.Values["nonce"] = flow.Nonce
+	session.Values["verifier"] = flow.Verifier
+	session.Options = cookieOptions(r, ssoMaxAge)
+	return session.Save(r, w)
+}
+
+/*EndSSOFlow returns the single sign-on started by StartSSOFlow, it can be used once.*/
+func EndSSOFlow(r *http.Request, w http.ResponseWriter) (SSOFlow, bool) {
+	session, err := store.Get(r, ssoCookieName)
+	if err != nil || session.IsNew {
+		return SSOFlow{}, false
+	}
+	flow := SSOFlow{}
+	flow.ProviderID, _ = session.Values["provider"].(string)
+	flow.State, _ = session.Values["state"].(string)
+	flow.Nonce, _ = session.Values["nonce"].(string)
+	flow.Verifier, _ = session.Values["verifier"].(string)
+	session.Values = map[interface{}]interface{}{}
+	session.Options = cookieOptions(r, -1)
+	session.Save(r, w)
+	return flow, flow.State != ""
+}
+
+/*StartPreAuth should be called when the password of a user with a second factor matched, login completes after PreAuthUser passed it.*/
+func StartPreAuth(r *http.Request, w http.ResponseWriter, userName, tenantID string) error {
+	session, _ := store.New(r, preAuthCookieName)
+	session.Values[userNameKey] = userName
+	session.Values[userTenantIDKey] = tenantID
+	session.Values["started"] = time.Now().Unix()
+	session.Options = cookieOptions(r, preAuthMaxAge)
+	return session.Save(r, w)
+}
+
+/*PreAuthUser returns user and tenant of the login started by StartPreAuth, unless it expired.*/
+func PreAuthUser(r *http.Request) (userName, tenantID string, ok bool) {
+	session, err := store.Get(r, preAuthCookieName)
+	if err != nil || session.IsNew {
+		return "", "", false
+	}
+	// The store keeps cookies for longer, the pre-auth lifetime is checked here.
+	started, _ := session.Values["started"].(int64)
+	if time.Since(time.Unix(started, 0)) > time.Duration(preAuthMaxAge)*time.Second {
+		return "", "", false
+	}
+	userName, _ = session.Values[userNameKey].(string)
+	tenantID, _ = session.Values[userTenantIDKey].(string)
+	return userName, tenantID, userName != ""
+}
+
+/*EndPreAuth should be called once the second factor passed, or the login is given up.*/
+func EndPreAuth(r *http.Request, w http.ResponseWriter) {
+	session, _ := store.Get(r, preAuthCookieName)
+	session.Values = map[interface{}]interface{}{}
+	session.Options = cookieOptions(r, -1)
 	session.Save(r, w)
 }
 
@@ -87,40 +192,69 @@ func isUserLoggedIn(session *sessions.Session) bool {
 	return true
 }
 
-/*ValidateSession will verify User has the right session...*/
-func ValidateSession() Interceptor {
+/*ValidateSession will verify User has the right session, or API token, and load the current permissions of user...*/
+func ValidateSession(authenticator Authenticator) Interceptor {
 
 	return func(f PrizmHandler) PrizmHandler {
 
 		return func(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
 
+			// Automation clients send a token instead of the session cookie.
+			if bearer := auth.BearerToken(r); bearer != "" {
+				token, permissionMap, err := authenticator.TokenPermissions(s, bearer)
+				if err != nil {
+					logutil.Errorf(s, "API token check failed. URL - %s  Method - %s: %v", r.URL, r.Method, err)
+					utils.SetUnauthorizedError(s)
+					return
+				}
+				// Tokens never act across tenants, also when created by a super admin.
+				setUserContextDataForAPI(s, token.TenantID, token.UserName, "", permissionMap, r.Header.Get(utils.HTTPAcceptLanguageKey))
+				s.User.TokenID = token.ID
+				logutil.Debugf(s, "API token check passed for URL - %s", r.URL)
+				f(s, w, r)
+				return
+			}
+
 			// Handle Session Here...
 			session, _ := store.Get(r, loginCokieName)
 
 			if !isUserLoggedIn(session) {
 				logutil.Errorf(nil, "Session check failed. URL - %s  Method - %s ", r.URL, r.Method)
 				//http.Error(w, "Forbidden: Access is denied", http.StatusForbidden)
-				s.Err = &model.AppError{Type: utils.SessionError, Message: "Unauthorized", Code: http.StatusUnauthorized}
+				utils.SetUnauthorizedError(s)
 				return
 			}
 
 			// Add user and tenant info in request here...
 			tenantID, _ := session.Values[userTenantIDKey].(string)
 			userName, _ := session.Values[userNameKey].(string)
-			permission, _ := session.Values[userPermissionKey].([]byte)
+			sessionID, _ := session.Values[sessionIDKey].(string)
 			lang := r.Header.Get(utils.HTTPAcceptLanguageKey)
 
-			var permissionMap map[string]string
-			err := json.Unmarshal(permission, &permissionMap)
+			// Session may have been revoked or expired, user or its role removed since login.
+			s.User.TenantId = tenantID
+			idle, err := authenticator.CheckSession(s, sessionID, userName)
 			if err != nil {
-				logutil.Errorf(s, "Failed to fetch permission from session... Assigning Analyst permission.")
-				permissionMap = utils.AnalystUserRolePermission
+				logutil.Errorf(s, "Session %s of %s is no longer valid: %v", sessionID, userName, err)
+				EndSession(r, w)
+				utils.SetUnauthorizedError(s)
+				return
+			}
+			role, permissionMap, err := authenticator.UserPermissions(s, userName)
+			if err != nil {
+				logutil.Errorf(s, "Failed to fetch permission of %s: %v", userName, err)
+				EndSession(r, w)
+				utils.SetUnauthorizedError(s)
+				return
 			}
-			setUserContextDataForAPI(s, tenantID, userName, permissionMap, lang)
+			setUserContextDataForAPI(s, tenantID, userName, role, permissionMap, lang)
+			s.User.SessionID = sessionID
 
-			// Update max age...
-			session.Options.MaxAge = maxAge
+			// Update max age, of the CSRF token too. Sessions of older logins get their token here.
+			token := csrfToken(session)
+			session.Options = cookieOptions(r, int(idle/time.Second))
 			session.Save(r, w)
+			setCSRFCookie(r, w, token, session.Options.MaxAge)
 
 			// Call the next handler in chain
 			logutil.Debugf(s, "Session check passed for URL - %s", r.URL)
@@ -130,12 +264,13 @@ func ValidateSession() Interceptor {
 }
 
 func setUserContextDataForAPI(s *model.SessionContext, tenantID string, userName string,
-	permission map[string]string, lang string) {
+	role string, permission map[string]string, lang string) {
 	// Add user and tenant info in request here...
 	u := s.User
 	u.TenantId = tenantID
 	u.UserName = userName
 	u.Permission = permission
+	u.IsSuperAdmin = role == utils.SuperAdminUserRole
 	s.Lang = lang
 	s.TFunc = i18n.Translate(s)
 	s.Err = nil
@@ -0,0 +1,105 @@
+package requestinterceptor
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"nyota/backend/auth"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+const (
+	// Allowed difference between request timestamp and server clock.
+	maxClockSkew = 5 * time.Minute
+	// Largest callback body accepted from a cluster.
+	maxSignedBody = 1 << 20
+)
+
+var nonceFormat = regexp.MustCompile(`^[A-Za-z0-9_-]{16,128}$`)
+
+// ClusterCredentials - credential lookup and replay protection for requests signed by CPPM clusters.
+type ClusterCredentials interface {
+	GetClusterCredentialByKey(keyID string) (*config.ClusterCredential, error)
+	UseNonce(keyID, nonce string, seenAt time.Time, window time.Duration) (bool, error)
+}
+
+/*ValidateClusterSignature authenticates requests signed with a cluster credential (see auth.SignRequest).
+Session gets tenant and cluster of the credential, never the ones claimed in request body.*/
+func ValidateClusterSignature(credentials ClusterCredentials) Interceptor {
+
+	return func(f PrizmHandler) PrizmHandler {
+
+		return func(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
+			setUserContextDataForAPI(s, "", "", "", nil, r.Header.Get(utils.HTTPAcceptLanguageKey))
+
+			keyID := r.Header.Get(auth.HeaderKeyID)
+			timestamp := r.Header.Get(auth.HeaderTimestamp)
+			nonce := r.Header.Get(auth.HeaderNonce)
+			signature := r.Header.Get(auth.HeaderSignature)
+			if keyID == "" || signature == "" || !nonceFormat.MatchString(nonce) {
+				setSignatureError(s, r, "missing or malformed signature headers")
+				return
+			}
+			unix, err := strconv.ParseInt(timestamp, 10, 64)
+			now := time.Now()
+			if err != nil || now.Sub(time.Unix(unix, 0)) > maxClockSkew || time.Unix(unix, 0).Sub(now) > maxClockSkew {
+				setSignatureError(s, r, "timestamp outside allowed window")
+				return
+			}
+
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxSignedBody))
+			if err != nil {
+				logutil.Errorf(s, "Signed request body rejected: %v", err)
+				utils.SetPreconditionFailedError(s, "key_event_invalid")
+				return
+			}
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+			credential, err := credentials.GetClusterCredentialByKey(keyID)
+			if err != nil {
+				if !model.IsNotFound(err) {
+					logutil.Errorf(s, "Cluster credential lookup failed: %v", err)
+					utils.SetSomethingWrong(s)
+					return
+				}
+				setSignatureError(s, r, "unknown key "+keyID)
+				return
+			}
+			if !auth.CheckSignature(signature, credential.Secret, r.Method, r.URL.Path, timestamp, nonce, body) {
+				setSignatureError(s, r, "signature mismatch for key "+keyID)
+				return
+			}
+
+			// Nonces are kept for twice the skew, a request is never accepted again while its
+			// timestamp is still inside the window.
+			fresh, err := credentials.UseNonce(keyID, nonce, now, 2*maxClockSkew)
+			if err != nil {
+				logutil.Errorf(s, "Nonce check failed: %v", err)
+				utils.SetSomethingWrong(s)
+				return
+			}
+			if !fresh {
+				setSignatureError(s, r, "replayed nonce for key "+keyID)
+				return
+			}
+
+			setUserContextDataForAPI(s, credential.TenantID, "cluster:"+strconv.Itoa(credential.ClusterID), "", nil,
+				r.Header.Get(utils.HTTPAcceptLanguageKey))
+			s.User.ClusterID = credential.ClusterID
+
+			logutil.Debugf(s, "Signature check passed for URL - %s", r.URL)
+			f(s, w, r)
+		}
+	}
+}
+
+func setSignatureError(s *model.SessionContext, r *http.Request, reason string) {
+	logutil.Errorf(s, "Signature check failed (%s). URL - %s  Method - %s ", reason, r.URL, r.Method)
+	utils.SetUnauthorizedError(s)
+}
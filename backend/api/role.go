@@ -1,21 +1,27 @@
 package api
 
 import (
+	"nyota/backend/cppmsync"
 	"nyota/backend/logutil"
 	"nyota/backend/model"
 	"nyota/backend/model/config"
 	"nyota/backend/uicomponent"
 	"nyota/backend/utils"
-	"encoding/json"
 	"goprizm/httputils"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 )
 
 func (svc *Service) getRoles(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
 	logutil.Debugf(s, "Service layer - Get All Roles invoked...")
-	data, err := svc.Store.GetAllRoles(s)
+	columns := uicomponent.GetRoleGridViewColumns(s)
+	spec, ok := utils.ParseQuerySpec(s, req.URL.Query(), columns)
+	if !ok {
+		return
+	}
+	data, total, err := svc.Store.GetAllRoles(s, spec)
 	if err != nil {
 		logutil.Errorf(s, "Error- %v", err)
 		utils.SetSomethingWrong(s)
@@ -23,9 +29,9 @@ func (svc *Service) getRoles(s *model.SessionContext, w http.ResponseWriter, req
 		for _, role := range data {
 			updateRole(s, svc, role)
 		}
-		roleList := config.RoleList{}
+		roleList := config.RoleList{Total: total, Page: spec.Page, PageSize: spec.PageSize}
 		roleList.Roles = data
-		roleList.Structure = uicomponent.GetRoleGridViewColumns(s)
+		roleList.Structure = columns
 		httputils.ServeJSON(w, roleList)
 	}
 }
@@ -42,8 +48,9 @@ func (svc *Service) getRoleByID(s *model.SessionContext, w http.ResponseWriter,
 	data, err := svc.Store.GetRoleByID(s, id)
 	if err != nil {
 		logutil.Errorf(s, "Get Role Error - %v", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
+		setETag(w, data.Version)
 		updateRole(s, svc, data)
 		httputils.ServeJSON(w, uicomponent.GetRoleConfigFormatter(s, data))
 	}
@@ -57,62 +64,89 @@ func (svc *Service) UpsertRole(s *model.SessionContext, w http.ResponseWriter, r
 		return
 	}
 	logutil.Debugf(s, "Role object - %v ", role)
+	if svc.checkRoleVersions(s, &role); nil != s.Err {
+		return
+	}
+	if role.ID != 0 {
+		if !ifMatchUpdate(s, req, &role.Version) {
+			return
+		}
+		existing, err := svc.Store.GetRoleByID(s, strconv.Itoa(role.ID))
+		if err != nil {
+			utils.SetStoreError(s, err)
+			return
+		}
+		utils.SetAuditOld(s, existing)
+	}
 	err := svc.Store.UpsertRole(s, &role)
 	if err != nil {
 		logutil.Errorf(s, "Upsert Role Error - %v", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
-		for _, cluster := range role.Clusters {
-			eventObj := utils.GetEventObj(cluster.UUID, role.EntityName(), role.URL(), role.ID, 0,
-				utils.HttpPost, role)
-			if req.Method == utils.HttpPut {
-				eventObj.Data.CppmID = svc.Store.GetRoleClusterCPPMID(role.ID, cluster.ID, role.TenantID)
-				if eventObj.Data.CppmID != 0 {
-					eventObj.Data.Method = utils.HttpPut
-				}
+		utils.SetAuditNew(s, strconv.Itoa(role.ID), &role)
+		setETag(w, role.Version)
+		httputils.ServeJSON(w, role)
+	}
+}
+
+// checkRoleVersions - role must be representable on the CPPM version of every mapped cluster.
+func (svc *Service) checkRoleVersions(s *model.SessionContext, role *config.Role) {
+	for _, mapped := range role.Clusters {
+		cluster, err := svc.Store.GetClusterById(s, strconv.Itoa(mapped.ID))
+		if err != nil {
+			logutil.Errorf(s, "Get Cluster Error - %v", err)
+			utils.SetStoreError(s, err)
+			return
+		}
+		if _, err := cppmsync.Downgrade(role.EntityName(), role, cluster.CppmVersion); err != nil {
+			logutil.Errorf(s, "Role can not be synced to cluster %s - %v", cluster.UUID, err)
+			if conversionErr, ok := err.(*model.ConversionError); ok {
+				utils.SetConversionError(s, conversionErr)
+			} else {
+				utils.SetPreconditionFailedError(s, "key_cppm_version_invalid")
 			}
-			eventObj.TenantID = role.TenantID
-			eventByte, _ := json.Marshal(eventObj)
-			logutil.Debugf(s, "Notify Data  - %s", string(eventByte))
-			go svc.Store.Watcher.Notify("event", eventByte)
+			return
 		}
-		httputils.ServeJSON(w, role)
 	}
 }
 
 func (svc *Service) DeleteRole(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
 	id := mux.Vars(req)["id"]
 	logutil.Debugf(s, "Service layer - Delete Role by ID... Id = %v", id)
-
-	var cppmID int
-	var clusterID int
-	var uuid string
-	role, _ := svc.Store.GetRoleByID(s, id)
-
-	for _, cluster := range role.Clusters {
-		uuid = cluster.UUID
-		clusterID = cluster.ID
-		cppmID = svc.Store.GetRoleClusterCPPMID(role.ID, clusterID, role.TenantID)
-		logutil.Debugf(s, "role ID - %d", role.ID)
-		logutil.Debugf(s, "CPPM ID - %d", cppmID)
-		if cppmID != 0 {
-			eventObj := utils.GetEventObj(uuid, role.EntityName(), role.URL(), role.ID, cppmID,
-				utils.HttpDelete, nil)
-
-			logutil.Debugf(s, "Notify Data  - %v", eventObj)
-			go svc.Store.Watcher.Notify("event", eventObj)
-		}
+	existing, err := svc.Store.GetRoleByID(s, id)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	if !checkIfMatch(s, req, existing.Version) {
+		return
 	}
+	utils.SetAuditOld(s, existing)
 
-	err := svc.Store.DeleteRole(s, id)
+	err = svc.Store.DeleteRole(s, id)
 	if err != nil {
 		logutil.Errorf(s, "Delete Role Error - %v", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
 		w.WriteHeader(http.StatusOK)
 	}
 }
 
+func (svc *Service) getRoleSyncStatus(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Get Role Sync Status... Id=%v", id)
+	data, err := svc.Store.GetRoleSyncStatus(s, id)
+	if err != nil {
+		logutil.Errorf(s, "Get Role Sync Status Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	if data == nil {
+		data = []*config.OutboxEvent{}
+	}
+	httputils.ServeJSON(w, data)
+}
+
 func updateRole(s *model.SessionContext, svc *Service, data *config.Role) {
 	data.AddedAtEpoc = getEpoc(data.AddedAt)
 	data.UpdatedAtEpoc = getEpoc(data.UpdatedAt)
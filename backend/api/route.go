@@ -16,36 +16,120 @@ type Route struct {
 /*Routes defines all routes in the system*/
 type Routes []Route
 
-func getAllRoutes(srv *Service) (Routes, Routes) {
+func getAllRoutes(srv *Service) (Routes, Routes, Routes) {
 
 	nologinRoutes := Routes{
 		Route{"/login", "Login", utils.HttpPost, utils.ReadPermission, srv.login, utils.GenericMenuPermissionKey},
-		Route{"/init", "Init", utils.HttpPost, utils.ReadPermission, srv.addRecords, utils.GenericMenuPermissionKey},
+		Route{"/login/mfa", "Login-Second-Factor", utils.HttpPost, utils.ReadPermission, srv.loginSecondFactor, utils.GenericMenuPermissionKey},
+		Route{"/login/mfa/enroll", "Login-Enroll-Second-Factor", utils.HttpPost, utils.ReadPermission, srv.enrollLoginMFA, utils.GenericMenuPermissionKey},
+		Route{"/sso/{id}/login", "Single-Sign-On", utils.HttpGet, utils.ReadPermission, srv.ssoLogin, utils.GenericMenuPermissionKey},
+		Route{"/sso/callback", "Single-Sign-On-Callback", utils.HttpGet, utils.ReadPermission, srv.ssoCallback, utils.GenericMenuPermissionKey},
+		Route{"/calendar/{token}.ics", "Get-Calendar-Feed", utils.HttpGet, utils.ReadPermission, srv.getCalendarFeed, utils.GenericMenuPermissionKey},
+	}
+	/*clusterRoutes are called by CPPM clusters with requests signed by cluster credentials*/
+	clusterRoutes := Routes{
 		Route{"/event", "execute event", utils.HttpPost, utils.ModifyPermission, srv.ExecuteEvent, utils.GenericMenuPermissionKey},
 	}
 	/*GuardedRoutes are routes with Login*/
 	guardedRoutes := Routes{
-		Route{"/logout", "Logout", utils.HttpGet, utils.ReadPermission, logout, utils.GenericMenuPermissionKey},
-
-		Route{"/events", "Get-Events", utils.HttpGet, utils.ReadPermission, srv.getEvents, utils.GenericMenuPermissionKey},
-		Route{"/events/{id:[0-9]+}", "Get-Event-By-Id", utils.HttpGet, utils.ReadPermission, srv.getEventByID, utils.GenericMenuPermissionKey},
-		Route{"/events", "Add-Event", utils.HttpPost, utils.ModifyPermission, srv.UpsertEvent, utils.GenericMenuPermissionKey},
-		Route{"/events/{id:[0-9]+}", "Update-Event-By-Id", utils.HttpPut, utils.ModifyPermission, srv.UpsertEvent, utils.GenericMenuPermissionKey},
-		Route{"/events/{id:[0-9]+}", "Delete-Event-By-Id", utils.HttpDelete, utils.ModifyPermission, srv.DeleteEvent, utils.GenericMenuPermissionKey},
-		Route{"/events/qr/{id:[0-9]+}", "Get-Event-QR-By-Id", utils.HttpGet, utils.ReadPermission, srv.getEventQrByID, utils.GenericMenuPermissionKey},
-
-		Route{"/tenants", "Get-Tenants", utils.HttpGet, utils.ReadPermission, srv.getTenants, utils.GenericMenuPermissionKey},
-		Route{"/tenants/{id:[0-9]+}", "Get-Tenant-By-Id", utils.HttpGet, utils.ReadPermission, srv.getTenantById, utils.GenericMenuPermissionKey},
-		Route{"/tenants", "Add-Tenant", utils.HttpPost, utils.ModifyPermission, srv.UpsertTenant, utils.GenericMenuPermissionKey},
-		Route{"/tenants/{id:[0-9]+}", "Update-Tenant-By-Id", utils.HttpPut, utils.ModifyPermission, srv.UpsertTenant, utils.GenericMenuPermissionKey},
-		Route{"/tenants/{id:[0-9]+}", "Delete-Tenant-By-Id", utils.HttpDelete, utils.ModifyPermission, srv.DeleteTenant, utils.GenericMenuPermissionKey},
-
-		Route{"/roles", "Get-Roles", utils.HttpGet, utils.ReadPermission, srv.getRoles, utils.GenericMenuPermissionKey},
-		Route{"/roles/{id:[0-9]+}", "Get-Role-By-Id", utils.HttpGet, utils.ReadPermission, srv.getRoleByID, utils.GenericMenuPermissionKey},
-		Route{"/roles/formfields", "role fields", utils.HttpGet, utils.ReadPermission, srv.getRoleFields, utils.GenericMenuPermissionKey},
-		Route{"/roles", "Add-Role", utils.HttpPost, utils.ModifyPermission, srv.UpsertRole, utils.GenericMenuPermissionKey},
-		Route{"/roles/{id:[0-9]+}", "Update-Role-By-Id", utils.HttpPut, utils.ModifyPermission, srv.UpsertRole, utils.GenericMenuPermissionKey},
-		Route{"/roles/{id:[0-9]+}", "Delete-Role-By-Id", utils.HttpDelete, utils.ModifyPermission, srv.DeleteRole, utils.GenericMenuPermissionKey},
+		Route{"/logout", "Logout", utils.HttpGet, utils.ReadPermission, srv.logout, utils.GenericMenuPermissionKey},
+
+		Route{"/events", "Get-Events", utils.HttpGet, utils.ReadPermission, srv.getEvents, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}", "Get-Event-By-Id", utils.HttpGet, utils.ReadPermission, srv.getEventByID, utils.EventMenuPermissionKey},
+		Route{"/events", "Add-Event", utils.HttpPost, utils.ModifyPermission, srv.UpsertEvent, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}", "Update-Event-By-Id", utils.HttpPut, utils.ModifyPermission, srv.UpsertEvent, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}", "Delete-Event-By-Id", utils.HttpDelete, utils.ModifyPermission, srv.DeleteEvent, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}.ics", "Get-Event-iCalendar", utils.HttpGet, utils.ReadPermission, srv.getEventICS, utils.EventMenuPermissionKey},
+		Route{"/events/import", "Import-Events", utils.HttpPost, utils.ModifyPermission, srv.ImportEvents, utils.EventMenuPermissionKey},
+		Route{"/calendar/feed", "Get-Calendar-Feed-Info", utils.HttpGet, utils.ReadPermission, srv.getCalendarFeedInfo, utils.EventMenuPermissionKey},
+		Route{"/calendar/feed", "Add-Calendar-Feed", utils.HttpPost, utils.ReadPermission, srv.AddCalendarFeed, utils.EventMenuPermissionKey},
+		Route{"/calendar/feed", "Delete-Calendar-Feed", utils.HttpDelete, utils.ReadPermission, srv.DeleteCalendarFeed, utils.EventMenuPermissionKey},
+		Route{"/events/qr/{id:[0-9]+}", "Get-Event-QR-By-Id", utils.HttpGet, utils.ReadPermission, srv.getEventQrByID, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}/attendees", "Get-Event-Attendees", utils.HttpGet, utils.ReadPermission, srv.getEventAttendees, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}/attendees", "Add-Event-Attendee", utils.HttpPost, utils.ModifyPermission, srv.AddEventAttendee, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}/attendees/{attendeeId:[0-9]+}", "Get-Event-Attendee", utils.HttpGet, utils.ReadPermission, srv.getEventAttendee, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}/attendees/{attendeeId:[0-9]+}", "Delete-Event-Attendee", utils.HttpDelete, utils.ModifyPermission, srv.DeleteEventAttendee, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}/attendees/{attendeeId:[0-9]+}/ticket", "Get-Event-Attendee-Ticket", utils.HttpGet, utils.ReadPermission, srv.getEventAttendeeTicket, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}/checkin", "Check-In-Event-Attendee", utils.HttpPost, utils.ModifyPermission, srv.CheckInEventAttendee, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}/occurrences", "Get-Event-Occurrences", utils.HttpGet, utils.ReadPermission, srv.getEventOccurrences, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}/occurrences/{recurrenceId}", "Update-Event-Occurrence", utils.HttpPut, utils.ModifyPermission, srv.UpsertEventOccurrence, utils.EventMenuPermissionKey},
+		Route{"/events/{id:[0-9]+}/occurrences/{recurrenceId}", "Delete-Event-Occurrence", utils.HttpDelete, utils.ModifyPermission, srv.DeleteEventOccurrence, utils.EventMenuPermissionKey},
+
+		Route{"/tenants", "Get-Tenants", utils.HttpGet, utils.ReadPermission, srv.getTenants, utils.TenantMenuPermissionKey},
+		Route{"/tenants/{id:[0-9]+}", "Get-Tenant-By-Id", utils.HttpGet, utils.ReadPermission, srv.getTenantById, utils.TenantMenuPermissionKey},
+		Route{"/tenants", "Add-Tenant", utils.HttpPost, utils.ModifyPermission, srv.UpsertTenant, utils.TenantMenuPermissionKey},
+		Route{"/tenants/{id:[0-9]+}", "Update-Tenant-By-Id", utils.HttpPut, utils.ModifyPermission, srv.UpsertTenant, utils.TenantMenuPermissionKey},
+		Route{"/tenants/{id:[0-9]+}", "Delete-Tenant-By-Id", utils.HttpDelete, utils.ModifyPermission, srv.DeleteTenant, utils.TenantMenuPermissionKey},
+
+		Route{"/roles", "Get-Roles", utils.HttpGet, utils.ReadPermission, srv.getRoles, utils.RoleMenuPermissionKey},
+		Route{"/roles/{id:[0-9]+}", "Get-Role-By-Id", utils.HttpGet, utils.ReadPermission, srv.getRoleByID, utils.RoleMenuPermissionKey},
+		Route{"/roles/formfields", "role fields", utils.HttpGet, utils.ReadPermission, srv.getRoleFields, utils.RoleMenuPermissionKey},
+		Route{"/roles", "Add-Role", utils.HttpPost, utils.ModifyPermission, srv.UpsertRole, utils.RoleMenuPermissionKey},
+		Route{"/roles/{id:[0-9]+}", "Update-Role-By-Id", utils.HttpPut, utils.ModifyPermission, srv.UpsertRole, utils.RoleMenuPermissionKey},
+		Route{"/roles/{id:[0-9]+}", "Delete-Role-By-Id", utils.HttpDelete, utils.ModifyPermission, srv.DeleteRole, utils.RoleMenuPermissionKey},
+		Route{"/roles/{id:[0-9]+}/sync", "Get-Role-Sync-Status", utils.HttpGet, utils.ReadPermission, srv.getRoleSyncStatus, utils.RoleMenuPermissionKey},
+
+		Route{"/clusters", "Get-Clusters", utils.HttpGet, utils.ReadPermission, srv.getClusters, utils.ClusterMenuPermissionKey},
+		Route{"/clusters/{id:[0-9]+}", "Get-Cluster-By-Id", utils.HttpGet, utils.ReadPermission, srv.getClusterByID, utils.ClusterMenuPermissionKey},
+		Route{"/clusters/formfields", "cluster fields", utils.HttpGet, utils.ReadPermission, srv.getClusterFields, utils.ClusterMenuPermissionKey},
+		Route{"/clusters", "Add-Cluster", utils.HttpPost, utils.ModifyPermission, srv.UpsertCluster, utils.ClusterMenuPermissionKey},
+		Route{"/clusters/{id:[0-9]+}", "Update-Cluster-By-Id", utils.HttpPut, utils.ModifyPermission, srv.UpsertCluster, utils.ClusterMenuPermissionKey},
+		Route{"/clusters/{id:[0-9]+}", "Delete-Cluster-By-Id", utils.HttpDelete, utils.ModifyPermission, srv.DeleteCluster, utils.ClusterMenuPermissionKey},
+		Route{"/clusters/{id:[0-9]+}/nodes", "Get-CPPM-Nodes-For-Cluster", utils.HttpGet, utils.ReadPermission, srv.getCPPMNodesForCluster, utils.CPPMNodeMenuPermissionKey},
+		Route{"/clusters/{id:[0-9]+}/nodes", "Add-CPPM-Node-To-Cluster", utils.HttpPost, utils.ModifyPermission, srv.AddClusterCPPMNode, utils.CPPMNodeMenuPermissionKey},
+		Route{"/clusters/{id:[0-9]+}/sync", "Get-Cluster-Sync-Status", utils.HttpGet, utils.ReadPermission, srv.getClusterSyncStatus, utils.ClusterMenuPermissionKey},
+		Route{"/clusters/{id:[0-9]+}/credentials", "Get-Cluster-Credentials", utils.HttpGet, utils.ReadPermission, srv.getClusterCredentials, utils.ClusterMenuPermissionKey},
+		Route{"/clusters/{id:[0-9]+}/credentials", "Add-Cluster-Credential", utils.HttpPost, utils.ModifyPermission, srv.AddClusterCredential, utils.ClusterMenuPermissionKey},
+		Route{"/clusters/{id:[0-9]+}/credentials/{keyId}", "Delete-Cluster-Credential", utils.HttpDelete, utils.ModifyPermission, srv.DeleteClusterCredential, utils.ClusterMenuPermissionKey},
+
+		Route{"/cppmnodes", "Get-CPPM-Nodes", utils.HttpGet, utils.ReadPermission, srv.getCPPMNodes, utils.CPPMNodeMenuPermissionKey},
+		Route{"/cppmnodes/{id:[0-9]+}", "Get-CPPM-Node-By-Id", utils.HttpGet, utils.ReadPermission, srv.getCPPMNodeById, utils.CPPMNodeMenuPermissionKey},
+		Route{"/cppmnodes/formfields", "cppm node fields", utils.HttpGet, utils.ReadPermission, srv.getCPPMNodeFields, utils.CPPMNodeMenuPermissionKey},
+		Route{"/cppmnodes", "Add-CPPM-Node", utils.HttpPost, utils.ModifyPermission, srv.UpsertCPPMNode, utils.CPPMNodeMenuPermissionKey},
+		Route{"/cppmnodes/{id:[0-9]+}", "Update-CPPM-Node-By-Id", utils.HttpPut, utils.ModifyPermission, srv.UpsertCPPMNode, utils.CPPMNodeMenuPermissionKey},
+		Route{"/cppmnodes/{id:[0-9]+}", "Delete-CPPM-Node-By-Id", utils.HttpDelete, utils.ModifyPermission, srv.DeleteCPPMNode, utils.CPPMNodeMenuPermissionKey},
+
+		Route{"/audit", "Get-Audit-Logs", utils.HttpGet, utils.ReadPermission, srv.getAuditLogs, utils.UserMenuPermissionKey},
+		Route{"/audit/export", "Export-Audit-Logs", utils.HttpGet, utils.ReadPermission, srv.exportAuditLogs, utils.UserMenuPermissionKey},
+
+		Route{"/users", "Get-Users", utils.HttpGet, utils.ReadPermission, srv.getUsers, utils.UserMenuPermissionKey},
+		Route{"/users/{userName}", "Get-User-By-Name", utils.HttpGet, utils.ReadPermission, srv.getUserByName, utils.UserMenuPermissionKey},
+		Route{"/users", "Add-User", utils.HttpPost, utils.ModifyPermission, srv.UpsertUser, utils.UserMenuPermissionKey},
+		Route{"/users/{userName}", "Update-User-By-Name", utils.HttpPut, utils.ModifyPermission, srv.UpsertUser, utils.UserMenuPermissionKey},
+		Route{"/users/{userName}", "Delete-User-By-Name", utils.HttpDelete, utils.ModifyPermission, srv.DeleteUser, utils.UserMenuPermissionKey},
+
+		Route{"/users/{userName}/sessions", "Get-User-Sessions", utils.HttpGet, utils.ReadPermission, srv.getUserSessions, utils.UserMenuPermissionKey},
+		Route{"/users/{userName}/sessions", "Delete-User-Sessions", utils.HttpDelete, utils.ModifyPermission, srv.DeleteUserSessions, utils.UserMenuPermissionKey},
+		Route{"/users/{userName}/lockout", "Get-User-Lockout", utils.HttpGet, utils.ReadPermission, srv.getUserLockout, utils.UserMenuPermissionKey},
+		Route{"/users/{userName}/lockout", "Unlock-User", utils.HttpDelete, utils.ModifyPermission, srv.DeleteUserLockout, utils.UserMenuPermissionKey},
+		Route{"/users/{userName}/mfa", "Reset-User-MFA", utils.HttpDelete, utils.ModifyPermission, srv.DeleteUserMFA, utils.UserMenuPermissionKey},
+
+		Route{"/identityproviders", "Get-Identity-Providers", utils.HttpGet, utils.ReadPermission, srv.getIdentityProviders, utils.UserMenuPermissionKey},
+		Route{"/identityproviders/{id}", "Get-Identity-Provider-By-Id", utils.HttpGet, utils.ReadPermission, srv.getIdentityProviderByID, utils.UserMenuPermissionKey},
+		Route{"/identityproviders", "Add-Identity-Provider", utils.HttpPost, utils.ModifyPermission, srv.UpsertIdentityProvider, utils.UserMenuPermissionKey},
+		Route{"/identityproviders/{id}", "Update-Identity-Provider-By-Id", utils.HttpPut, utils.ModifyPermission, srv.UpsertIdentityProvider, utils.UserMenuPermissionKey},
+		Route{"/identityproviders/{id}", "Delete-Identity-Provider-By-Id", utils.HttpDelete, utils.ModifyPermission, srv.DeleteIdentityProvider, utils.UserMenuPermissionKey},
+		Route{"/sessions", "Get-Sessions", utils.HttpGet, utils.ReadPermission, srv.getSessions, utils.UserMenuPermissionKey},
+		Route{"/sessions/{id}", "Delete-Session", utils.HttpDelete, utils.ModifyPermission, srv.DeleteSession, utils.UserMenuPermissionKey},
+
+		Route{"/tokens", "Get-Personal-Tokens", utils.HttpGet, utils.ReadPermission, srv.getTokens, utils.GenericMenuPermissionKey},
+		Route{"/tokens", "Add-Personal-Token", utils.HttpPost, utils.ModifyPermission, srv.AddToken, utils.GenericMenuPermissionKey},
+		Route{"/tokens/{id}", "Delete-Personal-Token", utils.HttpDelete, utils.ModifyPermission, srv.DeleteToken, utils.GenericMenuPermissionKey},
+		Route{"/mfa", "Get-MFA", utils.HttpGet, utils.ReadPermission, srv.getMFA, utils.GenericMenuPermissionKey},
+		Route{"/mfa", "Enroll-MFA", utils.HttpPost, utils.ModifyPermission, srv.AddMFA, utils.GenericMenuPermissionKey},
+		Route{"/mfa", "Enable-MFA", utils.HttpPut, utils.ModifyPermission, srv.UpdateMFA, utils.GenericMenuPermissionKey},
+		Route{"/mfa", "Delete-MFA", utils.HttpDelete, utils.ModifyPermission, srv.DeleteMFA, utils.GenericMenuPermissionKey},
+		Route{"/mfa/recoverycodes", "Add-MFA-Recovery-Codes", utils.HttpPost, utils.ModifyPermission, srv.AddMFARecoveryCodes, utils.GenericMenuPermissionKey},
+		Route{"/apitokens", "Get-API-Tokens", utils.HttpGet, utils.ReadPermission, srv.getAPITokens, utils.UserMenuPermissionKey},
+		Route{"/apitokens", "Add-Service-Token", utils.HttpPost, utils.ModifyPermission, srv.AddAPIToken, utils.UserMenuPermissionKey},
+		Route{"/apitokens/{id}", "Delete-API-Token", utils.HttpDelete, utils.ModifyPermission, srv.DeleteAPIToken, utils.UserMenuPermissionKey},
+
+		Route{"/adminroles", "Get-Admin-Roles", utils.HttpGet, utils.ReadPermission, srv.getAdminRoles, utils.UserMenuPermissionKey},
+		Route{"/adminroles/groups", "Get-Permission-Groups", utils.HttpGet, utils.ReadPermission, srv.getPermissionGroups, utils.UserMenuPermissionKey},
+		Route{"/adminroles/{name}", "Get-Admin-Role-By-Name", utils.HttpGet, utils.ReadPermission, srv.getAdminRoleByName, utils.UserMenuPermissionKey},
+		Route{"/adminroles", "Add-Admin-Role", utils.HttpPost, utils.ModifyPermission, srv.UpsertAdminRole, utils.UserMenuPermissionKey},
+		Route{"/adminroles/{name}", "Update-Admin-Role-By-Name", utils.HttpPut, utils.ModifyPermission, srv.UpsertAdminRole, utils.UserMenuPermissionKey},
+		Route{"/adminroles/{name}", "Delete-Admin-Role-By-Name", utils.HttpDelete, utils.ModifyPermission, srv.DeleteAdminRole, utils.UserMenuPermissionKey},
 	}
-	return nologinRoutes, guardedRoutes
+	return nologinRoutes, guardedRoutes, clusterRoutes
 }
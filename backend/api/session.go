@@ -0,0 +1,150 @@
+package api
+
+import (
+	"goprizm/httputils"
+	"net/http"
+	"nyota/backend/auth"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// sessionTouchInterval - last use of sessions is recorded at most this often.
+const sessionTouchInterval = 30 * time.Second
+
+// getSessions - active sessions of the tenant, of a single user with ?user=.
+func (svc *Service) getSessions(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Get User Sessions...")
+	svc.serveSessions(s, w, req.URL.Query().Get("user"))
+}
+
+func (svc *Service) getUserSessions(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	userName := mux.Vars(req)["userName"]
+	logutil.Debugf(s, "Service layer - Get Sessions of User... UserName=%v", userName)
+	svc.serveSessions(s, w, userName)
+}
+
+func (svc *Service) serveSessions(s *model.SessionContext, w http.ResponseWriter, userName string) {
+	sessions, err := svc.Store.GetUserSessions(s, userName)
+	if err != nil {
+		logutil.Errorf(s, "Get User Sessions Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	for _, session := range sessions {
+		session.Current = session.ID == s.User.SessionID
+	}
+	if sessions == nil {
+		sessions = []*model.UserSession{}
+	}
+	httputils.ServeJSON(w, sessions)
+}
+
+// DeleteSession - revokes a single session, its user is logged out with the next request.
+func (svc *Service) DeleteSession(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Delete User Session... Id=%v", id)
+	existing, err := svc.Store.GetUserSessionByID(id)
+	if err == nil && existing.TenantID != s.User.TenantId && !s.User.IsSuperAdmin {
+		err = &model.NotFoundError{Entity: "session", ID: id}
+	}
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	if err := svc.Store.DeleteUserSession(s, id); err != nil {
+		logutil.Errorf(s, "Delete User Session Error - %v", err)
+		utils.SetStoreError(s, err)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// DeleteUserSessions - revokes all sessions of user.
+func (svc *Service) DeleteUserSessions(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	userName := mux.Vars(req)["userName"]
+	logutil.Debugf(s, "Service layer - Delete Sessions of User... UserName=%v", userName)
+	s.AuditID = userName
+	if err := svc.Store.DeleteUserSessions(s, userName, ""); err != nil {
+		logutil.Errorf(s, "Delete User Sessions Error - %v", err)
+		utils.SetStoreError(s, err)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// startSession - adds the session record of a login, dropping expired ones of all users.
+func (svc *Service) startSession(s *model.SessionContext, r *http.Request, userName, tenantID string) (*model.UserSession, time.Duration, error) {
+	now := time.Now()
+	err := svc.Store.DeleteExpiredSessions(now.Add(-config.MaxSessionIdleMinutes*time.Minute),
+		now.Add(-config.MaxSessionMaxMinutes*time.Minute))
+	if err != nil {
+		logutil.Errorf(s, "Removing expired sessions failed: %v", err)
+	}
+	id, err := auth.RandomToken(24)
+	if err != nil {
+		return nil, 0, err
+	}
+	userAgent := r.UserAgent()
+	if len(userAgent) > 255 {
+		userAgent = userAgent[:255]
+	}
+	session := &model.UserSession{ID: id, TenantID: tenantID, UserName: userName, RemoteAddr: r.RemoteAddr,
+		UserAgent: userAgent, AddedAt: now, LastSeenAt: now}
+	if err := svc.Store.AddUserSession(session); err != nil {
+		return nil, 0, err
+	}
+	idle, _ := svc.sessionLifetimes(s, tenantID)
+	return session, idle, nil
+}
+
+// CheckSession - idle lifetime of session id of user when it is still valid. Sessions unused for
+// longer than the idle lifetime of the tenant, or older than its absolute lifetime, are removed.
+func (svc *Service) CheckSession(s *model.SessionContext, id, userName string) (time.Duration, error) {
+	session, err := svc.Store.GetUserSessionByID(id)
+	if err != nil {
+		return 0, err
+	}
+	if session.TenantID != s.User.TenantId || session.UserName != userName {
+		return 0, &model.NotFoundError{Entity: "session", ID: id}
+	}
+	idle, max := svc.sessionLifetimes(s, session.TenantID)
+	now := time.Now()
+	if now.Sub(session.LastSeenAt) > idle || now.Sub(session.AddedAt) > max {
+		if err := svc.Store.DeleteUserSession(s, id); err != nil {
+			logutil.Errorf(s, "Removing expired session %s failed: %v", id, err)
+		}
+		return 0, &model.NotFoundError{Entity: "session", ID: id}
+	}
+	if now.Sub(session.LastSeenAt) > sessionTouchInterval {
+		if err := svc.Store.TouchUserSession(id, now); err != nil {
+			logutil.Errorf(s, "Recording use of session %s failed: %v", id, err)
+		}
+	}
+	return idle, nil
+}
+
+// sessionLifetimes - idle and absolute session lifetime of tenant, defaults when it has none set.
+func (svc *Service) sessionLifetimes(s *model.SessionContext, tenantID string) (time.Duration, time.Duration) {
+	tenant, err := svc.Store.GetTenantById(s, tenantID)
+	if err != nil {
+		tenant = &config.Tenant{ID: tenantID}
+	}
+	return tenant.SessionIdle(), tenant.SessionMax()
+}
+
+// revokeUserSessions - logs user out of all sessions but the one of request, on password or role
+// changes and deletion.
+func (svc *Service) revokeUserSessions(s *model.SessionContext, userName string) {
+	except := ""
+	if userName == s.User.UserName {
+		except = s.User.SessionID
+	}
+	if err := svc.Store.DeleteUserSessions(s, userName, except); err != nil {
+		logutil.Errorf(s, "Revoking sessions of %s failed: %v", userName, err)
+	}
+}
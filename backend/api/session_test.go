@@ -0,0 +1,191 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+)
+
+func TestSessionsListAndRevoke(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("analyst@nyota.com", "secret", "1", utils.AnalystUserRole)
+	c.login("admin@nyota.com", "secret")
+	other := c.newSession()
+	other.login("admin@nyota.com", "secret")
+	a := c.newSession()
+	a.login("analyst@nyota.com", "secret")
+
+	var sessions []model.UserSession
+	c.do(utils.HttpGet, "/users/admin@nyota.com/sessions", nil, &sessions)
+	if len(sessions) != 2 || sessions[0].UserAgent == "" || sessions[0].RemoteAddr == "" {
+		t.Fatalf("Expected two sessions of admin, got %+v", sessions)
+	}
+	var otherID string
+	for _, session := range sessions {
+		if !session.Current {
+			otherID = session.ID
+		}
+	}
+	if otherID == "" {
+		t.Fatalf("Expected one current session, got %+v", sessions)
+	}
+	c.do(utils.HttpGet, "/sessions", nil, &sessions)
+	if len(sessions) != 3 {
+		t.Errorf("Expected three sessions of tenant, got %+v", sessions)
+	}
+
+	if code := c.do(utils.HttpDelete, "/sessions/"+otherID, nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected session revoke 200, got %d", code)
+	}
+	if code := other.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected revoked session 401, got %d", code)
+	}
+	if code := c.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusOK {
+		t.Errorf("Expected current session to stay valid, got %d", code)
+	}
+
+	if code := c.do(utils.HttpDelete, "/users/analyst@nyota.com/sessions", nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected user sessions revoke 200, got %d", code)
+	}
+	if code := a.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected sessions of user to be revoked, got %d", code)
+	}
+
+	c.do(utils.HttpGet, "/logout", nil, nil)
+	if sessions, _ := c.store.GetUserSessions(&model.SessionContext{User: &model.UserContext{TenantId: "1"}}, ""); len(sessions) != 0 {
+		t.Errorf("Expected logout to remove the session, got %+v", sessions)
+	}
+}
+
+func TestSessionsRevokedOnUserChange(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("analyst@nyota.com", "secret", "1", utils.AnalystUserRole)
+	c.login("admin@nyota.com", "secret")
+
+	a := c.newSession()
+	a.login("analyst@nyota.com", "secret")
+	update := map[string]interface{}{"name": "analyst@nyota.com", "descrption": "ops",
+		"attributes": map[string]interface{}{"role": utils.AnalystUserRole}}
+	c.do(utils.HttpPut, "/users/analyst@nyota.com", update, nil)
+	if code := a.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusOK {
+		t.Errorf("Expected session to survive unrelated change, got %d", code)
+	}
+	update["attributes"] = map[string]interface{}{"role": utils.AdminUserRole}
+	c.do(utils.HttpPut, "/users/analyst@nyota.com", update, nil)
+	if code := a.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected role change to end session, got %d", code)
+	}
+
+	a.login("analyst@nyota.com", "secret")
+	update["password"] = "Changed123"
+	c.do(utils.HttpPut, "/users/analyst@nyota.com", update, nil)
+	if code := a.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected password change to end session, got %d", code)
+	}
+
+	// Own password change keeps the session it was made with.
+	self := map[string]interface{}{"name": "admin@nyota.com", "password": "Changed123",
+		"attributes": map[string]interface{}{"role": utils.AdminUserRole}}
+	c.do(utils.HttpPut, "/users/admin@nyota.com", self, nil)
+	if code := c.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusOK {
+		t.Errorf("Expected own session to stay valid, got %d", code)
+	}
+
+	a.login("analyst@nyota.com", "Changed123")
+	c.do(utils.HttpDelete, "/users/analyst@nyota.com", nil, nil)
+	if sessions, _ := c.store.GetUserSessions(&model.SessionContext{User: &model.UserContext{TenantId: "1"}}, "analyst@nyota.com"); len(sessions) != 0 {
+		t.Errorf("Expected deleted user to have no sessions, got %+v", sessions)
+	}
+}
+
+func TestSessionLifetimes(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.store.BootstrapAdmin(nil, &config.Tenant{ID: "1", Name: "one", SessionIdleMinutes: 5}, &model.UserTenantDetails{UserName: "admin@nyota.com"})
+	c.login("admin@nyota.com", "secret")
+
+	tenant := map[string]interface{}{"id": "1", "name": "one", "session_idle_minutes": 2}
+	if code := c.do(utils.HttpPut, "/tenants/1", tenant, nil); code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected idle lifetime below minimum 422, got %d", code)
+	}
+
+	s := &model.SessionContext{User: &model.UserContext{TenantId: "1"}}
+	age := func(idle, total time.Duration) {
+		sessions, _ := c.store.GetUserSessions(s, "admin@nyota.com")
+		if len(sessions) != 1 {
+			t.Fatalf("Expected one session, got %+v", sessions)
+		}
+		sessions[0].LastSeenAt = time.Now().Add(-idle)
+		sessions[0].AddedAt = time.Now().Add(-total)
+		c.store.AddUserSession(sessions[0])
+	}
+
+	age(4*time.Minute, time.Hour)
+	if code := c.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusOK {
+		t.Errorf("Expected session within idle lifetime of tenant, got %d", code)
+	}
+	age(6*time.Minute, time.Hour)
+	if code := c.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected session idle beyond tenant lifetime 401, got %d", code)
+	}
+
+	c.login("admin@nyota.com", "secret")
+	age(time.Minute, config.DefaultSessionMaxMinutes*time.Minute+time.Minute)
+	if code := c.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected session beyond absolute lifetime 401, got %d", code)
+	}
+}
+
+func TestCSRFToken(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+
+	resp, _ := c.raw(utils.HttpPost, "/login", `{"UserName": "admin@nyota.com", "Password": "secret"}`, nil)
+	token := resp.Header.Get(utils.HTTPCSRFTokenKey)
+	cookies := map[string]*http.Cookie{}
+	for _, cookie := range resp.Cookies() {
+		cookies[cookie.Name] = cookie
+	}
+	session, readable := cookies["auth-token-cookie"], cookies["csrf-token"]
+	if token == "" || readable == nil || readable.Value != token || readable.HttpOnly {
+		t.Fatalf("Expected CSRF token in header and readable cookie, got %q %+v", token, readable)
+	}
+	if session == nil || !session.HttpOnly || session.SameSite != http.SameSiteLaxMode || session.Secure {
+		t.Errorf("Expected HttpOnly SameSite session cookie, not secure over http, got %+v", session)
+	}
+
+	cluster := `{"name": "east", "uuid": "u-1"}`
+	for _, header := range []map[string]string{{utils.HTTPCSRFTokenKey: ""}, {utils.HTTPCSRFTokenKey: "forged"}} {
+		if resp, _ := c.raw(utils.HttpPost, "/clusters", cluster, header); resp.StatusCode != http.StatusForbidden {
+			t.Errorf("Expected POST with CSRF token %q 403, got %d", header[utils.HTTPCSRFTokenKey], resp.StatusCode)
+		}
+	}
+	if resp, _ := c.raw(utils.HttpGet, "/clusters", "", map[string]string{utils.HTTPCSRFTokenKey: ""}); resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected GET without CSRF token, got %d", resp.StatusCode)
+	}
+	if resp, _ := c.raw(utils.HttpPost, "/clusters", cluster, map[string]string{utils.HTTPCSRFTokenKey: token}); resp.StatusCode != http.StatusCreated {
+		t.Errorf("Expected POST with CSRF token, got %d", resp.StatusCode)
+	}
+
+	// Tokens carry no cookies, nothing to forge.
+	var created model.APIToken
+	c.do(utils.HttpPost, "/tokens", map[string]interface{}{"name": "ci", "scopes": map[string]string{utils.ClusterMenuPermissionKey: utils.ModifyPermission}}, &created)
+	if code := c.bearer(created.Token, utils.HttpPost, "/clusters", `{"name": "west", "uuid": "u-2"}`); code != http.StatusCreated {
+		t.Errorf("Expected API token request without CSRF token, got %d", code)
+	}
+
+	resp, _ = c.raw(utils.HttpPost, "/login", `{"UserName": "admin@nyota.com", "Password": "secret"}`, nil)
+	if renewed := resp.Header.Get(utils.HTTPCSRFTokenKey); renewed == "" || renewed == token {
+		t.Errorf("Expected new CSRF token with every login, got %q", renewed)
+	}
+}
@@ -0,0 +1,218 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"goprizm/sysutils"
+	"net/http"
+	"nyota/backend/api/requestinterceptor"
+	"nyota/backend/auth"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// ssoCallbackPath - redirect uri of single sign-on below the public URL, registered with providers.
+const ssoCallbackPath = "/api/v1/sso/callback"
+
+// ssoClient - requests to identity providers.
+var ssoClient = &http.Client{Timeout: 10 * time.Second}
+
+// ssoLogin - starts single sign-on with the identity provider of path, the user agent is redirected
+// to the provider with an authorization code request.
+func (svc *Service) ssoLogin(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	logutil.Printf(s, "Single Sign-On Start... Provider=%s", id)
+	idp, err := svc.Store.GetIdentityProviderByID(id)
+	if err != nil || !idp.Enabled {
+		utils.SetNotFoundError(s)
+		return
+	}
+	redirectURI, ok := ssoRedirectURI()
+	if !ok {
+		logutil.Errorf(s, "Single Sign-On refused, PUBLIC_URL is not set")
+		utils.SetPreconditionFailedError(s, "key_sso_public_url_required")
+		return
+	}
+	provider, err := auth.DiscoverOIDC(ssoClient, idp.Issuer)
+	if err != nil {
+		logutil.Errorf(s, "Single Sign-On discovery of %s failed: %v", idp.Issuer, err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	state, errState := auth.RandomToken(24)
+	nonce, errNonce := auth.RandomToken(24)
+	verifier, challenge, errPKCE := auth.NewPKCE()
+	if errState != nil || errNonce != nil || errPKCE != nil {
+		utils.SetSomethingWrong(s)
+		return
+	}
+	flow := requestinterceptor.SSOFlow{ProviderID: idp.ID, State: state, Nonce: nonce, Verifier: verifier}
+	if err := requestinterceptor.StartSSOFlow(r, w, flow); err != nil {
+		logutil.Errorf(s, "Single Sign-On state could not be saved: %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	http.Redirect(w, r, provider.AuthCodeURL(idp.ClientID, redirectURI, idp.Scopes, state, nonce, challenge), http.StatusFound)
+}
+
+// ssoCallback - completes single sign-on with the authorization code of the provider. The user is
+// created or updated from the id token, logged in and redirected to the UI.
+func (svc *Service) ssoCallback(s *model.SessionContext, w http.ResponseWriter, r *http.Request) {
+	flow, ok := requestinterceptor.EndSSOFlow(r, w)
+	query := r.URL.Query()
+	if !ok || query.Get("state") != flow.State {
+		logutil.Errorf(s, "Single Sign-On callback without matching state")
+		utils.SetUnauthorizedError(s)
+		return
+	}
+	if query.Get("error") != "" {
+		logutil.Errorf(s, "Single Sign-On refused by provider %s: %s %s", flow.ProviderID, query.Get("error"), query.Get("error_description"))
+		utils.SetUnauthorizedError(s)
+		return
+	}
+	idp, err := svc.Store.GetIdentityProviderByID(flow.ProviderID)
+	if err == nil && !idp.Enabled {
+		err = errors.New("provider disabled")
+	}
+	redirectURI, ok := ssoRedirectURI()
+	if err == nil && !ok {
+		err = errors.New("PUBLIC_URL not set")
+	}
+	var claims auth.OIDCClaims
+	if err == nil {
+		claims, err = svc.ssoClaims(idp, query.Get("code"), flow, redirectURI)
+	}
+	var user *model.UserTenantDetails
+	var role *model.AdminRole
+	if err == nil {
+		user, role, err = svc.ssoUser(s, r, idp, claims)
+	}
+	if err != nil {
+		logutil.Errorf(s, "Single Sign-On with provider %s failed: %v", flow.ProviderID, err)
+		utils.SetUnauthorizedError(s)
+		return
+	}
+	if !svc.beginSession(s, w, r, user, role) {
+		return
+	}
+	http.Redirect(w, r, "/", http.StatusFound)
+}
+
+// ssoClaims - verified claims of the id token the code is exchanged for.
+func (svc *Service) ssoClaims(idp *model.IdentityProvider, code string, flow requestinterceptor.SSOFlow, redirectURI string) (auth.OIDCClaims, error) {
+	provider, err := auth.DiscoverOIDC(ssoClient, idp.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	idToken, err := provider.ExchangeCode(ssoClient, idp.ClientID, idp.ClientSecret, code, redirectURI, flow.Verifier)
+	if err != nil {
+		return nil, err
+	}
+	return provider.VerifyIDToken(ssoClient, idToken, idp.ClientID, flow.Nonce, time.Now())
+}
+
+// ssoUser - user of claims with the role its groups map to. Users are created on first login and
+// get the mapped role on every login, users of other tenants or of password login are never taken
+// over.
+func (svc *Service) ssoUser(s *model.SessionContext, r *http.Request, idp *model.IdentityProvider, claims auth.OIDCClaims) (*model.UserTenantDetails, *model.AdminRole, error) {
+	if idp.TenantClaim != "" && !contains(claims.Strings(idp.TenantClaim), idp.TenantClaimValue) {
+		return nil, nil, fmt.Errorf("claim %s is not %s", idp.TenantClaim, idp.TenantClaimValue)
+	}
+	userName := claims.String(idp.UserClaim)
+	if userName == "" {
+		return nil, nil, fmt.Errorf("claim %s missing", idp.UserClaim)
+	}
+	if verified, ok := claims["email_verified"].(bool); ok && !verified && idp.UserClaim == "email" {
+		return nil, nil, fmt.Errorf("email %s not verified", userName)
+	}
+
+	roleName := idp.DefaultRole
+	groups := claims.Strings(idp.GroupsClaim)
+	mapped := make([]string, 0, len(idp.GroupRoles))
+	for group := range idp.GroupRoles {
+		mapped = append(mapped, group)
+	}
+	// Several matching groups: the first in alphabetical order decides.
+	sort.Strings(mapped)
+	for _, group := range mapped {
+		if contains(groups, group) {
+			roleName = idp.GroupRoles[group]
+			break
+		}
+	}
+	if roleName == "" {
+		return nil, nil, fmt.Errorf("no role for groups %v of %s", groups, userName)
+	}
+	s.User.TenantId = idp.TenantID
+	role, err := svc.adminRole(s, roleName)
+	if err != nil || role.Name == utils.SuperAdminUserRole {
+		return nil, nil, fmt.Errorf("role %s of %s not usable: %v", roleName, userName, err)
+	}
+
+	existing, _ := svc.Store.GetUserByName(s, userName)
+	if existing != nil && (existing.TenantID != idp.TenantID || existing.IdentityProvider != idp.ID) {
+		return nil, nil, fmt.Errorf("user %s exists and is not of the provider", userName)
+	}
+	user := &model.UserTenantDetails{UserName: userName, TenantID: idp.TenantID, Descrition: claims.String("name"),
+		UserTenantAttributes: model.UserTenantAttributes{Role: role.Name, Permissions: role.Permissions, IdentityProvider: idp.ID}}
+	entry := &model.AuditLog{TenantID: idp.TenantID, UserName: userName, Entity: "users", EntityID: userName,
+		Action: model.AuditActionAdd, RequestID: s.RequestID, RemoteAddr: r.RemoteAddr, AddedAt: time.Now()}
+	if existing != nil {
+		if existing.Role == role.Name {
+			return existing, role, nil
+		}
+		user.Descrition = existing.Descrition
+		entry.Action, entry.OldData = model.AuditActionUpdate, model.AuditData(existing.Audit())
+	}
+	if err := svc.Store.UpsertUser(s, user); err != nil {
+		return nil, nil, err
+	}
+	entry.NewData = model.AuditData(user.Audit())
+	if err := svc.Store.AddAuditLog(entry); err != nil {
+		logutil.Errorf(s, "Audit Log - write failed for single sign-on user %s: %v", userName, err)
+	}
+	return user, role, nil
+}
+
+// ssoRedirectURI - callback of single sign-on at PUBLIC_URL, ok false when it is not set. The host
+// of the request is up to the client and never used, codes would be sent wherever it points.
+func ssoRedirectURI() (uri string, ok bool) {
+	base := configuredPublicURL()
+	if base == "" {
+		return "", false
+	}
+	return base + ssoCallbackPath, true
+}
+
+// configuredPublicURL - PUBLIC_URL without trailing slash, empty when not set.
+func configuredPublicURL() string {
+	return strings.TrimSuffix(sysutils.Getenv("PUBLIC_URL", ""), "/")
+}
+
+// publicURL - PUBLIC_URL without trailing slash, the host of request when not set.
+func publicURL(r *http.Request) string {
+	base := configuredPublicURL()
+	if base == "" {
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		base = scheme + "://" + r.Host
+	}
+	return strings.TrimSuffix(base, "/")
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"nyota/backend/auth/oidctest"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+)
+
+// sso - status of single sign-on with identity provider id, following redirects up to the UI.
+func (c *testClient) sso(id string) int {
+	client := *c.client
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if req.URL.Path == "/" {
+			return http.ErrUseLastResponse
+		}
+		return nil
+	}
+	resp, err := client.Get(c.server.URL + "/api/v1/sso/" + id + "/login")
+	if err != nil {
+		c.t.Fatalf("Single sign-on with %s failed: %v", id, err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestSingleSignOn(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("analyst@nyota.com", "secret", "1", utils.AnalystUserRole)
+	c.login("admin@nyota.com", "secret")
+	provider := oidctest.NewProvider("nyota", "client-secret")
+	defer provider.Close()
+
+	idp := map[string]interface{}{"name": "corp", "issuer": provider.URL, "client_id": "nyota", "client_secret": "client-secret",
+		"scopes": []string{"email"}, "tenant_claim": "tid", "tenant_claim_value": "corp-1", "enabled": true,
+		"group_roles": map[string]string{"nyota-admins": utils.AdminUserRole, "nyota-ops": utils.AnalystUserRole}}
+	var created model.IdentityProvider
+	if code := c.do(utils.HttpPost, "/identityproviders", idp, &created); code != http.StatusCreated || created.ClientSecret != "" || created.UserClaim != "email" {
+		t.Fatalf("Expected identity provider create 201 without secret, got %d %+v", code, created)
+	}
+	stored, _ := c.store.GetIdentityProviderByID(created.ID)
+	if stored.ClientSecret != "client-secret" || stored.TenantID != "1" {
+		t.Fatalf("Expected secret stored for tenant, got %+v", stored)
+	}
+
+	// Without a public URL the redirect uri would come from the Host header of the client.
+	t.Setenv("PUBLIC_URL", "")
+	provider.SetClaims(map[string]interface{}{"email": "jane@corp.com", "tid": "corp-1", "groups": []string{"nyota-ops"}})
+	if code := c.newSession().sso(created.ID); code != http.StatusBadRequest {
+		t.Errorf("Expected single sign-on without PUBLIC_URL 400, got %d", code)
+	}
+	t.Setenv("PUBLIC_URL", c.server.URL+"/")
+
+	jane := c.newSession()
+	provider.SetClaims(map[string]interface{}{"email": "jane@corp.com", "tid": "corp-1", "groups": []string{"nyota-ops"}, "name": "Jane"})
+	if code := jane.sso(created.ID); code != http.StatusFound {
+		t.Fatalf("Expected single sign-on to redirect to the UI, got %d", code)
+	}
+	if code := jane.do(utils.HttpGet, "/clusters", nil, nil); code != http.StatusOK {
+		t.Errorf("Expected single sign-on session, got %d", code)
+	}
+	if code := jane.do(utils.HttpPost, "/clusters", map[string]string{"name": "east", "uuid": "u-1"}, nil); code != http.StatusForbidden {
+		t.Errorf("Expected analyst permissions from group, got %d", code)
+	}
+	user, _ := c.store.GetUserByName(nil, "jane@corp.com")
+	if user == nil || user.TenantID != "1" || user.Role != utils.AnalystUserRole || user.IdentityProvider != created.ID || user.Descrition != "Jane" {
+		t.Fatalf("Expected user provisioned on first login, got %+v", user)
+	}
+	var entries model.AuditLogPage
+	c.do(utils.HttpGet, "/audit?entity=users", nil, &entries)
+	if entries.Total != 1 || entries.Items[0].Action != model.AuditActionAdd || entries.Items[0].EntityID != "jane@corp.com" {
+		t.Errorf("Expected provisioning in audit log, got %+v", entries)
+	}
+
+	// Groups are applied on every login.
+	provider.SetClaims(map[string]interface{}{"email": "jane@corp.com", "tid": "corp-1", "groups": []string{"nyota-admins", "nyota-ops"}})
+	jane.sso(created.ID)
+	if code := jane.do(utils.HttpPost, "/clusters", map[string]string{"name": "east", "uuid": "u-1"}, nil); code != http.StatusCreated {
+		t.Errorf("Expected admin permissions from group, got %d", code)
+	}
+	if code := jane.login("jane@corp.com", ""); code != http.StatusUnauthorized {
+		t.Errorf("Expected password login of single sign-on user 401, got %d", code)
+	}
+
+	refused := []map[string]interface{}{
+		{"email": "joe@corp.com", "tid": "corp-2", "groups": []string{"nyota-ops"}},
+		{"email": "joe@corp.com", "tid": "corp-1", "groups": []string{"sales"}},
+		{"email": "joe@corp.com", "tid": "corp-1", "groups": []string{"nyota-ops"}, "email_verified": false},
+		{"email": "analyst@nyota.com", "tid": "corp-1", "groups": []string{"nyota-admins"}},
+	}
+	for _, claims := range refused {
+		provider.SetClaims(claims)
+		if code := c.newSession().sso(created.ID); code != http.StatusUnauthorized {
+			t.Errorf("Expected single sign-on refused for %v, got %d", claims, code)
+		}
+	}
+	if user, _ := c.store.GetUserByName(nil, "analyst@nyota.com"); user.IdentityProvider != "" || user.Role != utils.AnalystUserRole {
+		t.Errorf("Expected password user not to be taken over, got %+v", user)
+	}
+
+	if code := c.do(utils.HttpGet, "/sso/callback?state=forged&code=x", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("Expected callback without flow 401, got %d", code)
+	}
+	created.Enabled = false
+	c.do(utils.HttpPut, "/identityproviders/"+created.ID, created, nil)
+	if code := c.newSession().sso(created.ID); code != http.StatusNotFound {
+		t.Errorf("Expected disabled provider 404, got %d", code)
+	}
+	if stored, _ := c.store.GetIdentityProviderByID(created.ID); stored.ClientSecret != "client-secret" {
+		t.Errorf("Expected update without secret to keep it, got %+v", stored)
+	}
+}
+
+func TestIdentityProviderRoles(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "secret")
+
+	idp := map[string]interface{}{"name": "corp", "issuer": "https://login.corp.com", "client_id": "nyota",
+		"group_roles": map[string]string{"root": utils.SuperAdminUserRole}}
+	if code := c.do(utils.HttpPost, "/identityproviders", idp, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected super admin mapping 400, got %d", code)
+	}
+	idp["group_roles"] = map[string]string{}
+	idp["default_role"] = "missing"
+	if code := c.do(utils.HttpPost, "/identityproviders", idp, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected unknown default role 400, got %d", code)
+	}
+	idp["default_role"] = utils.AnalystUserRole
+	idp["issuer"] = "login.corp.com"
+	if code := c.do(utils.HttpPost, "/identityproviders", idp, nil); code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected issuer without scheme 422, got %d", code)
+	}
+}
@@ -0,0 +1,190 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"nyota/backend/cppmsync"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+)
+
+// published - events published to clusters so far.
+func (c *testClient) published() []model.Event {
+	var events []model.Event
+	for _, message := range c.watcher.Messages() {
+		var event model.Event
+		if err := json.Unmarshal(message.Data.([]byte), &event); err != nil {
+			c.t.Fatalf("Invalid published event: %v", err)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestRoleSyncLifecycle(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+	clusterID, credential := c.addCluster("east", "u-1")
+	dispatcher := cppmsync.NewDispatcher(c.store, c.watcher)
+
+	role := config.Role{}
+	if code := c.do(utils.HttpPost, "/roles", map[string]interface{}{"name": "guest",
+		"clusters": []map[string]interface{}{{"id": clusterID}}}, &role); code != http.StatusOK {
+		t.Fatalf("Expected role create 200, got %d", code)
+	}
+	syncPath := "/roles/" + strconv.Itoa(role.ID) + "/sync"
+	var status []config.OutboxEvent
+	c.do(utils.HttpGet, syncPath, nil, &status)
+	if len(status) != 1 || status[0].Status != config.SyncPending || status[0].ClusterID != clusterID || status[0].Attempts != 0 {
+		t.Fatalf("Expected pending sync before dispatch, got %+v", status)
+	}
+
+	// Redis down, event stays pending with the error and is retried later.
+	c.watcher.Fail(errors.New("redis unavailable"))
+	dispatcher.DispatchDue(time.Now())
+	c.do(utils.HttpGet, syncPath, nil, &status)
+	if status[0].Status != config.SyncPending || status[0].Attempts != 1 || status[0].LastError != "redis unavailable" {
+		t.Fatalf("Expected failed attempt recorded, got %+v", status[0])
+	}
+	c.watcher.Fail(nil)
+	if n, _ := dispatcher.DispatchDue(time.Now()); n != 0 {
+		t.Fatalf("Expected no dispatch before backoff, got %d", n)
+	}
+	if n, _ := dispatcher.DispatchDue(time.Now().Add(dispatcher.Backoff)); n != 1 {
+		t.Fatalf("Expected retry after backoff, got %d", n)
+	}
+	published := c.published()
+	if len(published) != 1 || published[0].Data.Method != utils.HttpPost || published[0].Data.EventID != status[0].ID {
+		t.Fatalf("Expected create published with event id, got %+v", published)
+	}
+
+	ack := model.Event{UUID: "u-1", Data: model.EventData{EntityName: "Role", CccID: role.ID, CppmID: 3001, EventID: published[0].Data.EventID}}
+	if code := c.signedEvent(credential.KeyID, credential.Secret, ack, time.Now(), nil); code != http.StatusOK {
+		t.Fatalf("Expected callback 200, got %d", code)
+	}
+	c.do(utils.HttpGet, syncPath, nil, &status)
+	if status[0].Status != config.SyncSynced || status[0].LastError != "" {
+		t.Fatalf("Expected synced after callback, got %+v", status[0])
+	}
+
+	// CPPM knows the role now, update and delete address it by its CPPM id.
+	c.do(utils.HttpPut, "/roles/"+strconv.Itoa(role.ID), map[string]interface{}{"name": "visitor",
+		"clusters": []map[string]interface{}{{"id": clusterID}}}, nil)
+	c.do(utils.HttpDelete, "/roles/"+strconv.Itoa(role.ID), nil, nil)
+	dispatcher.DispatchDue(time.Now().Add(time.Hour))
+	published = c.published()
+	if len(published) != 2 || published[1].Data.Method != utils.HttpPut || published[1].Data.CppmID != 3001 {
+		t.Fatalf("Expected update published before delete, got %+v", published)
+	}
+	ack = model.Event{UUID: "u-1", Data: model.EventData{EntityName: "Role", CccID: role.ID, CppmID: 3001}}
+	if code := c.signedEvent(credential.KeyID, credential.Secret, ack, time.Now(), nil); code != http.StatusOK {
+		t.Fatalf("Expected callback without event id 200, got %d", code)
+	}
+	dispatcher.DispatchDue(time.Now().Add(time.Hour))
+	published = c.published()
+	if len(published) != 3 || published[2].Data.Method != utils.HttpDelete || published[2].Data.URI != "https://localhost/tips/api/role/3001" {
+		t.Fatalf("Expected delete published after update ack, got %+v", published)
+	}
+
+	var clusterStatus []config.OutboxEvent
+	c.do(utils.HttpGet, "/clusters/"+strconv.Itoa(clusterID)+"/sync", nil, &clusterStatus)
+	if len(clusterStatus) != 1 || clusterStatus[0].Method != utils.HttpDelete || clusterStatus[0].Status != config.SyncPending {
+		t.Fatalf("Expected pending delete as latest cluster sync, got %+v", clusterStatus)
+	}
+	if code := c.do(utils.HttpGet, syncPath, nil, nil); code != http.StatusNotFound {
+		t.Errorf("Expected 404 for sync of deleted role, got %d", code)
+	}
+}
+
+func TestRolePublishedInClusterVersion(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+	c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "old", "uuid": "u-66", "cppm_version": "6.6.2"}, nil)
+	c.do(utils.HttpPost, "/clusters", map[string]interface{}{"name": "new", "uuid": "u-67", "cppm_version": "6.7"}, nil)
+	old := c.store.GetClusterByUUID(nil, "u-66")
+	latest := c.store.GetClusterByUUID(nil, "u-67")
+
+	role := map[string]interface{}{"name": "guest", "extras": map[string]interface{}{"vlan": 12},
+		"clusters": []map[string]interface{}{{"id": latest.ID}, {"id": old.ID}}}
+	if code := c.do(utils.HttpPost, "/roles", role, nil); code != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected 422 for extras on CPPM 6.6 cluster, got %d", code)
+	}
+
+	delete(role, "extras")
+	if code := c.do(utils.HttpPost, "/roles", role, nil); code != http.StatusOK {
+		t.Fatalf("Expected role create 200, got %d", code)
+	}
+	cppmsync.NewDispatcher(c.store, c.watcher).DispatchDue(time.Now())
+	payloads := make(map[string]map[string]interface{})
+	for _, event := range c.published() {
+		payloads[event.UUID] = event.Data.Payload.(map[string]interface{})
+	}
+	if _, ok := payloads["u-67"]["tenant_id"]; !ok {
+		t.Errorf("Expected 6.7 shape for 6.7 cluster, got %v", payloads["u-67"])
+	}
+	if _, ok := payloads["u-66"]["tenant_id"]; ok || payloads["u-66"]["name"] != "guest" {
+		t.Errorf("Expected 6.6 shape for 6.6 cluster, got %v", payloads["u-66"])
+	}
+}
+
+func TestEventClusterMappingSync(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "Secret123", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "Secret123")
+	eastID, east := c.addCluster("east", "u-1")
+	westID, _ := c.addCluster("west", "u-2")
+	dispatcher := cppmsync.NewDispatcher(c.store, c.watcher)
+
+	event := config.Event{}
+	if code := c.do(utils.HttpPost, "/events", map[string]interface{}{"event_name": "launch",
+		"clusters": []map[string]interface{}{{"id": eastID}, {"id": westID}, {"id": eastID}}}, &event); code != http.StatusOK {
+		t.Fatalf("Expected event create 200, got %d", code)
+	}
+	dispatcher.DispatchDue(time.Now())
+	published := c.published()
+	if len(published) != 2 || published[0].Data.EntityName != "Event" || published[0].Data.Method != utils.HttpPost {
+		t.Fatalf("Expected event create published once per cluster, got %+v", published)
+	}
+	ack := model.Event{UUID: "u-1", Data: model.EventData{EntityName: "Event", CccID: event.ID, CppmID: 4001, EventID: published[0].Data.EventID}}
+	if code := c.signedEvent(east.KeyID, east.Secret, ack, time.Now(), nil); code != http.StatusOK {
+		t.Fatalf("Expected callback 200, got %d", code)
+	}
+
+	// Unmapped cluster gets a delete of the event it has, the mapping is gone.
+	eventPath := "/events/" + strconv.Itoa(event.ID)
+	if code := c.do(utils.HttpPut, eventPath, map[string]interface{}{"event_id": event.ID, "event_name": "launch",
+		"clusters": []map[string]interface{}{{"id": westID}}}, nil); code != http.StatusOK {
+		t.Fatalf("Expected event update 200, got %d", code)
+	}
+	c.do(utils.HttpGet, eventPath, nil, &event)
+	if len(event.Clusters) != 1 || event.Clusters[0].ID != westID {
+		t.Errorf("Expected event mapped to west only, got %+v", event.Clusters)
+	}
+	dispatcher.DispatchDue(time.Now().Add(time.Hour))
+	published = c.published()
+	var deleted bool
+	for _, sent := range published[2:] {
+		if sent.UUID == "u-1" {
+			deleted = sent.Data.Method == utils.HttpDelete && sent.Data.URI == "https://localhost/tips/api/event/4001"
+		}
+	}
+	if !deleted {
+		t.Errorf("Expected delete published to unmapped cluster, got %+v", published)
+	}
+
+	if code := c.do(utils.HttpPut, eventPath, map[string]interface{}{"event_id": event.ID, "event_name": "launch",
+		"clusters": []map[string]interface{}{{"id": westID + eastID + 100}}}, nil); code != http.StatusNotFound {
+		t.Errorf("Expected unknown cluster 404, got %d", code)
+	}
+}
@@ -28,8 +28,9 @@ func (svc *Service) getTenantById(s *model.SessionContext, w http.ResponseWriter
 	data, err := svc.Store.GetTenantById(s, id)
 	if err != nil {
 		logutil.Errorf(s, "Get Tenant Error - ", err)
-		utils.SetSomethingWrong(s)
-	} else {
+		utils.SetStoreError(s, err)
+	} else if !notModified(w, req, data.Version) {
+		setETag(w, data.Version)
 		httputils.ServeJSON(w, data)
 	}
 }
@@ -42,11 +43,19 @@ func (svc *Service) UpsertTenant(s *model.SessionContext, w http.ResponseWriter,
 		return
 	}
 	logutil.Debugf(s, "Tenant object - %v ", tenant)
+	if tenant.ID != "" && !ifMatchUpdate(s, req, &tenant.Version) {
+		return
+	}
+	if existing, err := svc.Store.GetTenantById(s, tenant.ID); err == nil {
+		utils.SetAuditOld(s, existing)
+	}
 	_, err := svc.Store.UpsertTenant(s, &tenant)
 	if err != nil {
 		logutil.Errorf(s, "Upsert Tenant Error - ", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
+		utils.SetAuditNew(s, tenant.ID, &tenant)
+		setETag(w, tenant.Version)
 		w.WriteHeader(http.StatusCreated)
 	}
 }
@@ -54,10 +63,19 @@ func (svc *Service) UpsertTenant(s *model.SessionContext, w http.ResponseWriter,
 func (svc *Service) DeleteTenant(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
 	id := mux.Vars(req)["id"]
 	logutil.Debugf(s, "Service layer - Delete Tenant by id... Id=", id)
-	err := svc.Store.DeleteTenantById(s, id)
+	existing, err := svc.Store.GetTenantById(s, id)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	if !checkIfMatch(s, req, existing.Version) {
+		return
+	}
+	utils.SetAuditOld(s, existing)
+	err = svc.Store.DeleteTenantById(s, id)
 	if err != nil {
 		logutil.Errorf(s, "Delete Tenant Error - ", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
 	} else {
 		w.WriteHeader(http.StatusOK)
 	}
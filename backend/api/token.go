@@ -0,0 +1,196 @@
+package api
+
+import (
+	"goprizm/httputils"
+	"net/http"
+	"nyota/backend/auth"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// Lifetime of API tokens created without expires_at, and the longest accepted.
+const (
+	DefaultTokenLifetime = 90 * 24 * time.Hour
+	MaxTokenLifetime     = 365 * 24 * time.Hour
+
+	// tokenTouchInterval - last use of tokens is recorded at most this often.
+	tokenTouchInterval = time.Minute
+)
+
+// getTokens - personal tokens of the logged in user.
+func (svc *Service) getTokens(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Get Personal API Tokens...")
+	svc.serveTokens(s, w, func(token *model.APIToken) bool {
+		return token.Kind == model.TokenPersonal && token.UserName == s.User.UserName
+	})
+}
+
+// getAPITokens - all tokens of the tenant.
+func (svc *Service) getAPITokens(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Get API Tokens...")
+	svc.serveTokens(s, w, func(token *model.APIToken) bool { return true })
+}
+
+func (svc *Service) serveTokens(s *model.SessionContext, w http.ResponseWriter, match func(*model.APIToken) bool) {
+	tokens, err := svc.Store.GetAPITokens(s)
+	if err != nil {
+		logutil.Errorf(s, "Get API Tokens Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	list := []*model.APIToken{}
+	for _, token := range tokens {
+		if match(token) {
+			list = append(list, token)
+		}
+	}
+	httputils.ServeJSON(w, list)
+}
+
+// AddToken - personal token acting as the logged in user.
+func (svc *Service) AddToken(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Add Personal API Token Invoked")
+	var token model.APIToken
+	utils.DecodeAndValidate(s, w, req, &token)
+	if nil != s.Err {
+		return
+	}
+	token.Kind = model.TokenPersonal
+	token.UserName = s.User.UserName
+	svc.addToken(s, w, &token)
+}
+
+// AddAPIToken - service account token of the tenant.
+func (svc *Service) AddAPIToken(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Add Service API Token Invoked")
+	var token model.APIToken
+	utils.DecodeAndValidate(s, w, req, &token)
+	if nil != s.Err {
+		return
+	}
+	if token.UserName == "" {
+		utils.SetPreconditionFailedError(s, "key_service_account_required")
+		return
+	}
+	if _, err := svc.Store.GetUserByName(s, token.UserName); err == nil {
+		// Audit entries of service accounts must not be mistaken for those of users.
+		utils.SetConflictError(s)
+		return
+	}
+	token.Kind = model.TokenService
+	svc.addToken(s, w, &token)
+}
+
+func (svc *Service) addToken(s *model.SessionContext, w http.ResponseWriter, token *model.APIToken) {
+	if s.User.TokenID != "" {
+		// Tokens can't extend their own lifetime by creating new ones.
+		utils.SetForbiddenError(s)
+		return
+	}
+	if !utils.ValidPermissions(token.Scopes) {
+		utils.SetPreconditionFailedError(s, "key_permissions_invalid")
+		return
+	}
+	if !utils.PermissionsWithin(token.Scopes, s.User.Permission) {
+		utils.SetPreconditionFailedError(s, "key_permissions_exceeded")
+		return
+	}
+	now := time.Now()
+	if token.ExpiresAt.IsZero() {
+		token.ExpiresAt = now.Add(DefaultTokenLifetime)
+	}
+	if !token.ExpiresAt.After(now) || token.ExpiresAt.After(now.Add(MaxTokenLifetime)) {
+		utils.SetPreconditionFailedError(s, "key_token_expiry_invalid")
+		return
+	}
+
+	var err error
+	token.Token, token.ID, token.Hash, err = auth.NewAPIToken()
+	if err != nil {
+		logutil.Errorf(s, "API Token generation Error - %v", err)
+		utils.SetSomethingWrong(s)
+		return
+	}
+	token.TenantID = s.User.TenantId
+	token.LastUsedAt = nil
+	if err := svc.Store.AddAPIToken(s, token); err != nil {
+		logutil.Errorf(s, "Add API Token Error - %v", err)
+		utils.SetStoreError(s, err)
+		return
+	}
+	utils.SetAuditNew(s, token.ID, token)
+	httputils.ServeJSONWithStatus(w, token, http.StatusCreated)
+}
+
+// DeleteToken - revokes personal token of the logged in user.
+func (svc *Service) DeleteToken(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Delete Personal API Token... Id=%v", id)
+	svc.deleteToken(s, w, id, func(token *model.APIToken) bool {
+		return token.Kind == model.TokenPersonal && token.UserName == s.User.UserName
+	})
+}
+
+// DeleteAPIToken - revokes any token of the tenant.
+func (svc *Service) DeleteAPIToken(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["id"]
+	logutil.Debugf(s, "Service layer - Delete API Token... Id=%v", id)
+	svc.deleteToken(s, w, id, func(token *model.APIToken) bool { return true })
+}
+
+func (svc *Service) deleteToken(s *model.SessionContext, w http.ResponseWriter, id string, match func(*model.APIToken) bool) {
+	existing, err := svc.Store.GetAPITokenByID(id)
+	if err == nil && (existing.TenantID != s.User.TenantId || !match(existing)) {
+		err = &model.NotFoundError{Entity: "api token", ID: id}
+	}
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	existing.Hash = ""
+	utils.SetAuditOld(s, existing)
+	if err := svc.Store.DeleteAPIToken(s, id); err != nil {
+		logutil.Errorf(s, "Delete API Token Error - %v", err)
+		utils.SetStoreError(s, err)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// TokenPermissions - token and its permissions for a bearer token. Personal tokens get at most the
+// current permissions of their user, so they stop working with the user and follow role changes.
+func (svc *Service) TokenPermissions(s *model.SessionContext, bearer string) (*model.APIToken, map[string]string, error) {
+	id, secret, ok := auth.ParseAPIToken(bearer)
+	if !ok {
+		return nil, nil, &model.NotFoundError{Entity: "api token", ID: ""}
+	}
+	token, err := svc.Store.GetAPITokenByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	if !auth.CheckTokenSecret(secret, token.Hash) || !now.Before(token.ExpiresAt) {
+		return nil, nil, &model.NotFoundError{Entity: "api token", ID: id}
+	}
+
+	permissions := token.Scopes
+	if token.Kind == model.TokenPersonal {
+		s.User.TenantId = token.TenantID
+		_, held, err := svc.UserPermissions(s, token.UserName)
+		if err != nil {
+			return nil, nil, err
+		}
+		permissions = utils.LimitPermissions(token.Scopes, held)
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) > tokenTouchInterval {
+		if err := svc.Store.TouchAPIToken(token.ID, now); err != nil {
+			logutil.Errorf(s, "Recording use of API token %s failed: %v", token.ID, err)
+		}
+	}
+	return token, permissions, nil
+}
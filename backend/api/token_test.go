@@ -0,0 +1,144 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"nyota/backend/auth"
+	"nyota/backend/model"
+	"nyota/backend/utils"
+)
+
+// bearer - status of request to path authenticated only with token.
+func (c *testClient) bearer(token, method, path, body string) int {
+	resp, _ := c.newSession().raw(method, path, body, map[string]string{"Authorization": "Bearer " + token})
+	return resp.StatusCode
+}
+
+func TestPersonalToken(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.login("admin@nyota.com", "secret")
+
+	var created model.APIToken
+	scopes := map[string]string{utils.ClusterMenuPermissionKey: utils.ModifyPermission, utils.EventMenuPermissionKey: utils.ReadPermission}
+	if code := c.do(utils.HttpPost, "/tokens", map[string]interface{}{"name": "ci", "scopes": scopes}, &created); code != http.StatusCreated {
+		t.Fatalf("Expected token create 201, got %d", code)
+	}
+	stored, _ := c.store.GetAPITokenByID(created.ID)
+	if created.Token == "" || stored.Hash == "" || stored.Hash == created.Token || created.ExpiresAt.Before(time.Now().Add(89*24*time.Hour)) {
+		t.Fatalf("Expected token returned once and stored hashed, got %+v", created)
+	}
+
+	checks := []struct {
+		method, path string
+		expected     int
+	}{
+		{utils.HttpGet, "/clusters", http.StatusOK},
+		{utils.HttpPost, "/clusters", http.StatusCreated},
+		{utils.HttpGet, "/events", http.StatusOK},
+		{utils.HttpPost, "/events", http.StatusForbidden},
+		{utils.HttpGet, "/users", http.StatusForbidden},
+		{utils.HttpPost, "/tokens", http.StatusForbidden},
+	}
+	for _, check := range checks {
+		body := `{"name": "east", "uuid": "u-1", "scopes": {"CLUSTERS": "READ"}}`
+		if code := c.bearer(created.Token, check.method, check.path, body); code != check.expected {
+			t.Errorf("Expected %d for token %s %s, got %d", check.expected, check.method, check.path, code)
+		}
+	}
+	if code := c.bearer(created.Token+"x", utils.HttpGet, "/clusters", ""); code != http.StatusUnauthorized {
+		t.Errorf("Expected wrong secret 401, got %d", code)
+	}
+
+	var tokens []model.APIToken
+	c.do(utils.HttpGet, "/tokens", nil, &tokens)
+	if len(tokens) != 1 || tokens[0].Token != "" || tokens[0].LastUsedAt == nil {
+		t.Errorf("Expected listed token without secret and with last use, got %+v", tokens)
+	}
+
+	// Personal tokens follow the role of their user.
+	user, _ := c.store.GetUserByName(nil, "admin@nyota.com")
+	user.Role = utils.AnalystUserRole
+	c.store.UpsertUser(nil, user)
+	if code := c.bearer(created.Token, utils.HttpPost, "/clusters", `{"name": "west", "uuid": "u-2"}`); code != http.StatusForbidden {
+		t.Errorf("Expected token limited to analyst permissions, got %d", code)
+	}
+	if code := c.bearer(created.Token, utils.HttpGet, "/clusters", ""); code != http.StatusOK {
+		t.Errorf("Expected token to keep read access, got %d", code)
+	}
+
+	if code := c.do(utils.HttpDelete, "/tokens/"+created.ID, nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected token revoke 200, got %d", code)
+	}
+	if code := c.bearer(created.Token, utils.HttpGet, "/clusters", ""); code != http.StatusUnauthorized {
+		t.Errorf("Expected revoked token 401, got %d", code)
+	}
+}
+
+func TestTokenScopesAndExpiry(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("analyst@nyota.com", "secret", "1", utils.AnalystUserRole)
+	c.login("analyst@nyota.com", "secret")
+
+	wide := map[string]interface{}{"name": "ci", "scopes": map[string]string{utils.UserMenuPermissionKey: utils.ReadPermission}}
+	if code := c.do(utils.HttpPost, "/tokens", wide, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected scopes beyond own permissions 400, got %d", code)
+	}
+	late := map[string]interface{}{"name": "ci", "scopes": map[string]string{utils.ClusterMenuPermissionKey: utils.ReadPermission},
+		"expires_at": time.Now().Add(2 * MaxTokenLifetime)}
+	if code := c.do(utils.HttpPost, "/tokens", late, nil); code != http.StatusBadRequest {
+		t.Errorf("Expected expiry beyond a year 400, got %d", code)
+	}
+
+	token, id, hash, _ := auth.NewAPIToken()
+	s := &model.SessionContext{User: &model.UserContext{TenantId: "1", UserName: "analyst@nyota.com"}}
+	c.store.AddAPIToken(s, &model.APIToken{ID: id, TenantID: "1", UserName: "analyst@nyota.com", Kind: model.TokenPersonal,
+		Hash: hash, Scopes: map[string]string{utils.ClusterMenuPermissionKey: utils.ReadPermission}, ExpiresAt: time.Now().Add(-time.Second)})
+	if code := c.bearer(token, utils.HttpGet, "/clusters", ""); code != http.StatusUnauthorized {
+		t.Errorf("Expected expired token 401, got %d", code)
+	}
+}
+
+func TestServiceToken(t *testing.T) {
+	c := newTestClient(t)
+	defer c.Close()
+	c.addUser("admin@nyota.com", "secret", "1", utils.AdminUserRole)
+	c.addUser("analyst@nyota.com", "secret", "1", utils.AnalystUserRole)
+	c.login("admin@nyota.com", "secret")
+
+	service := map[string]interface{}{"name": "deploy", "user_name": "ci-bot",
+		"scopes": map[string]string{utils.ClusterMenuPermissionKey: utils.ModifyPermission}}
+	var created model.APIToken
+	if code := c.do(utils.HttpPost, "/apitokens", service, &created); code != http.StatusCreated || created.Kind != model.TokenService {
+		t.Fatalf("Expected service token create 201, got %d %+v", code, created)
+	}
+	service["user_name"] = "analyst@nyota.com"
+	if code := c.do(utils.HttpPost, "/apitokens", service, nil); code != http.StatusConflict {
+		t.Errorf("Expected service account named like a user 409, got %d", code)
+	}
+	if code := c.bearer(created.Token, utils.HttpPost, "/clusters", `{"name": "east", "uuid": "u-1"}`); code != http.StatusCreated {
+		t.Errorf("Expected service token to add cluster, got %d", code)
+	}
+	var entries model.AuditLogPage
+	c.do(utils.HttpGet, "/audit?entity=clusters", nil, &entries)
+	if entries.Total != 1 || entries.Items[0].UserName != "ci-bot" {
+		t.Errorf("Expected audit entry of service account, got %+v", entries)
+	}
+
+	// Personal tokens of other users are only revoked through the tenant wide endpoint.
+	c.login("analyst@nyota.com", "secret")
+	if code := c.do(utils.HttpDelete, "/tokens/"+created.ID, nil, nil); code != http.StatusNotFound {
+		t.Errorf("Expected service token not to be a personal token, got %d", code)
+	}
+	c.login("admin@nyota.com", "secret")
+	if code := c.do(utils.HttpDelete, "/apitokens/"+created.ID, nil, nil); code != http.StatusOK {
+		t.Fatalf("Expected service token revoke 200, got %d", code)
+	}
+	if code := c.bearer(created.Token, utils.HttpGet, "/clusters", ""); code != http.StatusUnauthorized {
+		t.Errorf("Expected revoked service token 401, got %d", code)
+	}
+}
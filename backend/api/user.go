@@ -3,6 +3,7 @@ package api
 import (
 	"goprizm/httputils"
 	"net/http"
+	"nyota/backend/auth"
 	"nyota/backend/logutil"
 	"nyota/backend/model"
 	"nyota/backend/utils"
@@ -19,6 +20,7 @@ func (svc *Service) getUsers(s *model.SessionContext, w http.ResponseWriter, req
 	} else {
 		userList := model.UserTenantDetailsArray{}
 		for _, userTenantDetails := range data {
+			userTenantDetails.Password = ""
 			userList = append(userList, *userTenantDetails)
 		}
 		httputils.ServeJSON(w, userList)
@@ -31,39 +33,120 @@ func (svc *Service) getUserByName(s *model.SessionContext, w http.ResponseWriter
 	data, err := svc.Store.GetUserByName(s, userName)
 	if err != nil {
 		logutil.Errorf(s, "Get User Error - %v", err)
-		utils.SetSomethingWrong(s)
+		utils.SetStoreError(s, err)
+	} else if data.TenantID != s.User.TenantId && !s.User.IsSuperAdmin {
+		logutil.Errorf(s, "Get User Error - user %s does not belong to tenant", userName)
+		utils.SetNotFoundError(s)
 	} else {
+		data.Password = ""
 		httputils.ServeJSON(w, data)
 	}
 }
 
-// func (svc *Service) UpsertUser(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
-// 	logutil.Debugf(s, "Service layer - Add / Update Role Invoked")
-// 	var user model.UserTenantDetails
-// 	utils.DecodeAndValidate(s, w, req, &user)
-// 	if nil != s.Err {
-// 		return
-// 	}
-// 	logutil.Debugf(s, "Role object - %v ", role)
-// 	err := svc.Store.UpsertRole(s, &role)
-// 	if err != nil {
-// 		logutil.Errorf(s, "Upsert Role Error - %v", err)
-// 		utils.SetSomethingWrong(s)
-// 	} else {
-// 		for _, cluster := range role.Clusters {
-// 			eventObj := utils.GetEventObj(cluster.UUID, role.EntityName(), role.URL(), role.ID, 0,
-// 				utils.HttpPost, role)
-// 			if req.Method == utils.HttpPut {
-// 				eventObj.Data.CppmID = svc.Store.GetRoleClusterCPPMID(role.ID, cluster.ID, role.TenantID)
-// 				if eventObj.Data.CppmID != 0 {
-// 					eventObj.Data.Method = utils.HttpPut
-// 				}
-// 			}
-// 			eventObj.TenantID = role.TenantID
-// 			eventByte, _ := json.Marshal(eventObj)
-// 			logutil.Debugf(s, "Notify Data  - %s", string(eventByte))
-// 			go svc.Store.Watcher.Notify("event", eventByte)
-// 		}
-// 		httputils.ServeJSON(w, role)
-// 	}
-// }
+func (svc *Service) UpsertUser(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	logutil.Debugf(s, "Service layer - Add / Update User Invoked")
+	var user model.UserTenantDetails
+	utils.DecodeAndValidate(s, w, req, &user)
+	if nil != s.Err {
+		return
+	}
+	if req.Method == utils.HttpPut {
+		// User name is the resource id for users.
+		user.UserName = mux.Vars(req)["userName"]
+	}
+
+	role, err := svc.adminRole(s, user.UserTenantAttributes.Role)
+	if err != nil || (role.Name == utils.SuperAdminUserRole && !s.User.IsSuperAdmin) {
+		// Only a super admin can grant access across tenants.
+		utils.SetPreconditionFailedError(s, "key_role_invalid")
+		return
+	}
+	if !s.User.IsSuperAdmin && !utils.PermissionsWithin(role.Permissions, s.User.Permission) {
+		utils.SetPreconditionFailedError(s, "key_permissions_exceeded")
+		return
+	}
+	// Kept for display, sessions always use the current permissions of the role.
+	user.UserTenantAttributes.Permissions = role.Permissions
+
+	existing, _ := svc.Store.GetUserByName(s, user.UserName)
+	if existing != nil && existing.TenantID != s.User.TenantId {
+		// User names are global, never let a tenant take over another tenant's user.
+		utils.SetBadRequestError(s)
+		return
+	}
+	if req.Method == utils.HttpPost && existing != nil {
+		utils.SetBadRequestError(s)
+		return
+	}
+	if req.Method == utils.HttpPut && existing == nil {
+		utils.SetNotFoundError(s)
+		return
+	}
+	if existing != nil {
+		utils.SetAuditOld(s, existing)
+	}
+	// Only single sign-on links users to identity providers.
+	user.IdentityProvider = ""
+	if existing != nil {
+		user.IdentityProvider = existing.IdentityProvider
+	}
+
+	if user.Password == "" {
+		if existing == nil {
+			utils.SetPreconditionFailedError(s, "key_password_required")
+			return
+		}
+		user.Password, user.Salt = existing.Password, existing.Salt
+	} else {
+		hash, salt, err := auth.HashPassword(user.Password)
+		if err != nil {
+			logutil.Errorf(s, "Password hash Error - %v", err)
+			utils.SetSomethingWrong(s)
+			return
+		}
+		user.Password, user.Salt = hash, salt
+	}
+
+	logutil.Debugf(s, "User object - %s ", user.Audit())
+	err = svc.Store.UpsertUser(s, &user)
+	if err != nil {
+		logutil.Errorf(s, "Upsert User Error - %v", err)
+		utils.SetStoreError(s, err)
+	} else {
+		utils.SetAuditNew(s, user.UserName, &user)
+		if existing != nil && (existing.Password != user.Password || existing.Role != user.Role) {
+			svc.revokeUserSessions(s, user.UserName)
+		}
+		user.Password = ""
+		httputils.ServeJSON(w, user)
+	}
+}
+
+func (svc *Service) DeleteUser(s *model.SessionContext, w http.ResponseWriter, req *http.Request) {
+	userName := mux.Vars(req)["userName"]
+	logutil.Debugf(s, "Service layer - Delete User by UserName... UserName = %v", userName)
+	if userName == s.User.UserName {
+		utils.SetPreconditionFailedError(s, "key_user_delete_self")
+		return
+	}
+	existing, err := svc.Store.GetUserByName(s, userName)
+	if err != nil {
+		utils.SetStoreError(s, err)
+		return
+	}
+	if existing.TenantID != s.User.TenantId && !s.User.IsSuperAdmin {
+		// User names are global, users of other tenants are not there for this one.
+		logutil.Errorf(s, "Delete User Error - user %s does not belong to tenant", userName)
+		utils.SetNotFoundError(s)
+		return
+	}
+	utils.SetAuditOld(s, existing)
+	err = svc.Store.DeleteUser(s, userName)
+	if err != nil {
+		logutil.Errorf(s, "Delete User Error - %v", err)
+		utils.SetStoreError(s, err)
+	} else {
+		svc.revokeUserSessions(s, userName)
+		w.WriteHeader(http.StatusOK)
+	}
+}
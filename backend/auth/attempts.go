@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	redis "gopkg.in/redis.v5"
+)
+
+// AttemptCounter - counts failed attempts and blocks keys for a while, shared by all API servers.
+type AttemptCounter interface {
+	// Fail - records a failed attempt of key, counts reset window after the first failure.
+	Fail(key string, window time.Duration) (int64, error)
+	// Failures - failed attempts of key in current window.
+	Failures(key string) (int64, error)
+	// Block - refuses key for d.
+	Block(key string, d time.Duration) error
+	// Blocked - time key is still refused for, 0 when it is not.
+	Blocked(key string) (time.Duration, error)
+	// Reset - forgets failures and block of key.
+	Reset(key string) error
+}
+
+const (
+	attemptsPrefix = "ATTEMPTS:"
+	blockedPrefix  = "BLOCKED:"
+)
+
+// RedisAttempts - AttemptCounter in redis, keys expire on their own.
+type RedisAttempts struct {
+	Client *redis.Client
+}
+
+// Fail - increments the failures of key, the first one starts the window. The counter is created
+// with its expiry in the same transaction, so it can't be left without one.
+func (attempts *RedisAttempts) Fail(key string, window time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+	_, err := attempts.Client.TxPipelined(func(pipe *redis.Pipeline) error {
+		pipe.SetNX(attemptsPrefix+key, 0, window)
+		incr = pipe.Incr(attemptsPrefix + key)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// Failures - failures of key, 0 when the window has passed.
+func (attempts *RedisAttempts) Failures(key string) (int64, error) {
+	count, err := attempts.Client.Get(attemptsPrefix + key).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return count, err
+}
+
+// Block - refuses key for d.
+func (attempts *RedisAttempts) Block(key string, d time.Duration) error {
+	return attempts.Client.Set(blockedPrefix+key, 1, d).Err()
+}
+
+// Blocked - remaining ttl of the block of key.
+func (attempts *RedisAttempts) Blocked(key string) (time.Duration, error) {
+	ttl, err := attempts.Client.PTTL(blockedPrefix + key).Result()
+	if err != nil || ttl < 0 {
+		return 0, err
+	}
+	return ttl, nil
+}
+
+// Reset - removes failures and block of key.
+func (attempts *RedisAttempts) Reset(key string) error {
+	return attempts.Client.Del(attemptsPrefix+key, blockedPrefix+key).Err()
+}
+
+// MemAttempts - AttemptCounter in memory of a single server. Used where redis is not available,
+// e.g. tests.
+type MemAttempts struct {
+	// Now - clock of expiry, time.Now when nil.
+	Now func() time.Time
+
+	mu       sync.Mutex
+	failures map[string]memAttempt
+	blocked  map[string]time.Time
+}
+
+type memAttempt struct {
+	count   int64
+	expires time.Time
+}
+
+func (attempts *MemAttempts) now() time.Time {
+	if attempts.Now != nil {
+		return attempts.Now()
+	}
+	return time.Now()
+}
+
+// Fail - increments the failures of key, the first one starts the window.
+func (attempts *MemAttempts) Fail(key string, window time.Duration) (int64, error) {
+	attempts.mu.Lock()
+	defer attempts.mu.Unlock()
+	if attempts.failures == nil {
+		attempts.failures = map[string]memAttempt{}
+	}
+	now := attempts.now()
+	attempt := attempts.failures[key]
+	if !now.Before(attempt.expires) {
+		attempt = memAttempt{expires: now.Add(window)}
+	}
+	attempt.count++
+	attempts.failures[key] = attempt
+	return attempt.count, nil
+}
+
+// Failures - failures of key, 0 when the window has passed.
+func (attempts *MemAttempts) Failures(key string) (int64, error) {
+	attempts.mu.Lock()
+	defer attempts.mu.Unlock()
+	attempt := attempts.failures[key]
+	if !attempts.now().Before(attempt.expires) {
+		return 0, nil
+	}
+	return attempt.count, nil
+}
+
+// Block - refuses key for d.
+func (attempts *MemAttempts) Block(key string, d time.Duration) error {
+	attempts.mu.Lock()
+	defer attempts.mu.Unlock()
+	if attempts.blocked == nil {
+		attempts.blocked = map[string]time.Time{}
+	}
+	attempts.blocked[key] = attempts.now().Add(d)
+	return nil
+}
+
+// Blocked - time until the block of key ends.
+func (attempts *MemAttempts) Blocked(key string) (time.Duration, error) {
+	attempts.mu.Lock()
+	defer attempts.mu.Unlock()
+	if left := attempts.blocked[key].Sub(attempts.now()); left > 0 {
+		return left, nil
+	}
+	return 0, nil
+}
+
+// Reset - removes failures and block of key.
+func (attempts *MemAttempts) Reset(key string) error {
+	attempts.mu.Lock()
+	defer attempts.mu.Unlock()
+	delete(attempts.failures, key)
+	delete(attempts.blocked, key)
+	return nil
+}
@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	redis "gopkg.in/redis.v5"
+)
+
+func TestMemAttempts(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	attempts := &MemAttempts{Now: func() time.Time { return now }}
+
+	for i := int64(1); i <= 3; i++ {
+		if count, _ := attempts.Fail("user", time.Minute); count != i {
+			t.Errorf("Expected failure %d, got %d", i, count)
+		}
+	}
+	now = now.Add(time.Minute)
+	if count, _ := attempts.Failures("user"); count != 0 {
+		t.Errorf("Expected failures to reset after window, got %d", count)
+	}
+	if count, _ := attempts.Fail("user", time.Minute); count != 1 {
+		t.Errorf("Expected new window to start at 1, got %d", count)
+	}
+
+	attempts.Block("user", 10*time.Second)
+	now = now.Add(4 * time.Second)
+	if left, _ := attempts.Blocked("user"); left != 6*time.Second {
+		t.Errorf("Expected 6s of block left, got %s", left)
+	}
+	if left, _ := attempts.Blocked("other"); left != 0 {
+		t.Errorf("Expected other key unblocked, got %s", left)
+	}
+	attempts.Reset("user")
+	left, _ := attempts.Blocked("user")
+	count, _ := attempts.Failures("user")
+	if left != 0 || count != 0 {
+		t.Errorf("Expected reset to clear block and failures, got %s %d", left, count)
+	}
+}
+
+func TestRedisAttemptsFailIsAtomic(t *testing.T) {
+	server := newFakeRedis(t)
+	attempts := &RedisAttempts{Client: redis.NewClient(&redis.Options{Addr: server.addr})}
+	defer attempts.Client.Close()
+
+	for i := int64(1); i <= 2; i++ {
+		if count, err := attempts.Fail("user", time.Minute); err != nil || count != i {
+			t.Fatalf("Expected failure %d, got %d %v", i, count, err)
+		}
+	}
+	expected := "MULTI;set ATTEMPTS:user 0 ex 60 nx;incr ATTEMPTS:user;EXEC"
+	if commands := server.commands(); strings.Join(commands, ";") != expected+";"+expected {
+		t.Errorf("Expected counter and expiry in one transaction, got %v", commands)
+	}
+}
+
+// fakeRedis - redis server answering the commands of RedisAttempts.Fail, counters never expire.
+type fakeRedis struct {
+	addr string
+
+	mu       sync.Mutex
+	log      []string
+	counters map[string]int64
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	server := &fakeRedis{addr: listener.Addr().String(), counters: map[string]int64{}}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+	return server
+}
+
+func (server *fakeRedis) commands() []string {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return append([]string(nil), server.log...)
+}
+
+func (server *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	var queued [][]string
+	for {
+		args, err := readRedisCommand(reader)
+		if err != nil {
+			return
+		}
+		server.mu.Lock()
+		server.log = append(server.log, strings.Join(args, " "))
+		server.mu.Unlock()
+		switch strings.ToUpper(args[0]) {
+		case "MULTI":
+			queued = [][]string{}
+			fmt.Fprint(conn, "+OK\r\n")
+		case "EXEC":
+			fmt.Fprintf(conn, "*%d\r\n", len(queued))
+			for _, command := range queued {
+				fmt.Fprint(conn, server.exec(command))
+			}
+			queued = nil
+		default:
+			if queued != nil {
+				queued = append(queued, args)
+				fmt.Fprint(conn, "+QUEUED\r\n")
+			} else {
+				fmt.Fprint(conn, server.exec(args))
+			}
+		}
+	}
+}
+
+func (server *fakeRedis) exec(args []string) string {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	switch strings.ToUpper(args[0]) {
+	case "SET":
+		if _, ok := server.counters[args[1]]; ok {
+			return "$-1\r\n"
+		}
+		server.counters[args[1]], _ = strconv.ParseInt(args[2], 10, 64)
+		return "+OK\r\n"
+	case "INCR":
+		server.counters[args[1]]++
+		return fmt.Sprintf(":%d\r\n", server.counters[args[1]])
+	}
+	return "-ERR unknown command\r\n"
+}
+
+// readRedisCommand - command sent as array of bulk strings.
+func readRedisCommand(reader *bufio.Reader) ([]string, error) {
+	var n int
+	if _, err := fmt.Fscanf(reader, "*%d\r\n", &n); err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		var size int
+		if _, err := fmt.Fscanf(reader, "$%d\r\n", &size); err != nil {
+			return nil, err
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return nil, err
+		}
+		args[i] = string(data[:size])
+	}
+	return args, nil
+}
@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Headers and formats of AWS Signature Version 4, as used by S3 compatible object stores.
+const (
+	HeaderAmzDate        = "X-Amz-Date"
+	HeaderAmzContentHash = "X-Amz-Content-Sha256"
+
+	awsV4Algorithm  = "AWS4-HMAC-SHA256"
+	awsV4TimeFormat = "20060102T150405Z"
+)
+
+// PayloadHash - hex sha256 of body, sent as X-Amz-Content-Sha256.
+func PayloadHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// SignAWSV4 - signs req with the host, date and payload hash headers for service of region, the
+// Authorization header names accessKey. Requests must not be changed after signing.
+func SignAWSV4(req *http.Request, accessKey, secretKey, region, service string, body []byte, now time.Time) {
+	amzDate := now.UTC().Format(awsV4TimeFormat)
+	req.Header.Set(HeaderAmzDate, amzDate)
+	req.Header.Set(HeaderAmzContentHash, PayloadHash(body))
+	signedHeaders := []string{"host", strings.ToLower(HeaderAmzContentHash), strings.ToLower(HeaderAmzDate)}
+	scope := amzDate[:8] + "/" + region + "/" + service + "/aws4_request"
+	req.Header.Set("Authorization", awsV4Algorithm+" Credential="+accessKey+"/"+scope+", SignedHeaders="+
+		strings.Join(signedHeaders, ";")+", Signature="+awsV4Signature(req, secretKey, scope, signedHeaders))
+}
+
+// CheckAWSV4 - Authorization header of req is a Signature Version 4 of accessKey and secretKey for
+// service of region. The payload hash header is taken as is, callers compare it with the body.
+func CheckAWSV4(req *http.Request, accessKey, secretKey, region, service string) bool {
+	header := req.Header.Get("Authorization")
+	if !strings.HasPrefix(header, awsV4Algorithm+" ") {
+		return false
+	}
+	fields := make(map[string]string)
+	for _, field := range strings.Split(strings.TrimPrefix(header, awsV4Algorithm+" "), ",") {
+		if kv := strings.SplitN(strings.TrimSpace(field), "=", 2); len(kv) == 2 {
+			fields[kv[0]] = kv[1]
+		}
+	}
+	credential := strings.SplitN(fields["Credential"], "/", 2)
+	amzDate := req.Header.Get(HeaderAmzDate)
+	if len(credential) != 2 || credential[0] != accessKey || len(amzDate) < 8 {
+		return false
+	}
+	scope := amzDate[:8] + "/" + region + "/" + service + "/aws4_request"
+	if credential[1] != scope {
+		return false
+	}
+	signedHeaders := strings.Split(fields["SignedHeaders"], ";")
+	expected := awsV4Signature(req, secretKey, scope, signedHeaders)
+	return hmac.Equal([]byte(fields["Signature"]), []byte(expected))
+}
+
+// awsV4Signature - hex signature of the canonical request of req with signedHeaders in scope.
+func awsV4Signature(req *http.Request, secretKey, scope string, signedHeaders []string) string {
+	sort.Strings(signedHeaders)
+	var headers strings.Builder
+	for _, name := range signedHeaders {
+		value := req.Header.Get(name)
+		if name == "host" {
+			if value = req.Host; value == "" {
+				value = req.URL.Host
+			}
+		}
+		headers.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonical := strings.Join([]string{req.Method, path, canonicalQuery(req), headers.String(),
+		strings.Join(signedHeaders, ";"), req.Header.Get(HeaderAmzContentHash)}, "\n")
+	canonicalSum := sha256.Sum256([]byte(canonical))
+	stringToSign := awsV4Algorithm + "\n" + req.Header.Get(HeaderAmzDate) + "\n" + scope + "\n" +
+		hex.EncodeToString(canonicalSum[:])
+
+	parts := strings.Split(scope, "/")
+	key := []byte("AWS4" + secretKey)
+	for _, part := range parts {
+		key = hmacSHA256(key, part)
+	}
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// canonicalQuery - escaped parameters of the query of req, sorted.
+func canonicalQuery(req *http.Request) string {
+	query := req.URL.Query()
+	var params []string
+	for name, values := range query {
+		for _, value := range values {
+			params = append(params, awsEscape(name)+"="+awsEscape(value))
+		}
+	}
+	sort.Strings(params)
+	return strings.Join(params, "&")
+}
+
+// awsEscape - percent encoding of all but unreserved characters, as Signature Version 4 requires.
+func awsEscape(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			b.WriteString("%" + strings.ToUpper(hex.EncodeToString([]byte{c})))
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
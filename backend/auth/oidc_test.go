@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"nyota/backend/auth/oidctest"
+)
+
+func TestPKCEChallenge(t *testing.T) {
+	if challenge := PKCEChallenge("dBjftJeZ4CVP-mJ92K8hjFf1hnwQkYwHn-bwoftaBJw"); challenge != "BHkggjTTtvz474BBDzMURyP9i4nUR6WeKc4nsCqwiyM" {
+		t.Errorf("Unexpected challenge %s", challenge)
+	}
+	verifier, challenge, err := NewPKCE()
+	if err != nil || len(verifier) < 43 || challenge != PKCEChallenge(verifier) {
+		t.Errorf("Unexpected PKCE pair %q %q: %v", verifier, challenge, err)
+	}
+}
+
+func TestOIDCCodeFlow(t *testing.T) {
+	idp := oidctest.NewProvider("nyota", "secret")
+	defer idp.Close()
+	idp.SetClaims(map[string]interface{}{"sub": "42", "email": "jane@corp.com", "groups": []string{"ops"}})
+
+	provider, err := DiscoverOIDC(http.DefaultClient, idp.URL)
+	if err != nil {
+		t.Fatalf("Discovery failed: %v", err)
+	}
+	verifier, challenge, _ := NewPKCE()
+	authURL := provider.AuthCodeURL("nyota", "http://localhost/cb", []string{"email"}, "st", "no", challenge)
+	noRedirect := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := noRedirect.Get(authURL)
+	if err != nil || resp.StatusCode != http.StatusFound {
+		t.Fatalf("Authorization request failed: %v", err)
+	}
+	callback, _ := url.Parse(resp.Header.Get("Location"))
+	code := callback.Query().Get("code")
+	if callback.Query().Get("state") != "st" || code == "" {
+		t.Fatalf("Unexpected callback %s", callback)
+	}
+
+	if _, err := provider.ExchangeCode(http.DefaultClient, "nyota", "secret", code, "http://localhost/cb", "wrong"); err == nil {
+		t.Errorf("Expected exchange with wrong verifier to fail")
+	}
+	resp, _ = noRedirect.Get(authURL)
+	callback, _ = url.Parse(resp.Header.Get("Location"))
+	token, err := provider.ExchangeCode(http.DefaultClient, "nyota", "secret", callback.Query().Get("code"), "http://localhost/cb", verifier)
+	if err != nil {
+		t.Fatalf("Exchange failed: %v", err)
+	}
+	claims, err := provider.VerifyIDToken(http.DefaultClient, token, "nyota", "no", time.Now())
+	if err != nil || claims.String("email") != "jane@corp.com" || claims.Strings("groups")[0] != "ops" {
+		t.Fatalf("Unexpected claims %v: %v", claims, err)
+	}
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	idp := oidctest.NewProvider("nyota", "secret")
+	defer idp.Close()
+	provider, _ := DiscoverOIDC(http.DefaultClient, idp.URL)
+	now := time.Now()
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{"iss": idp.URL, "aud": []string{"other", "nyota"}, "exp": now.Add(time.Minute).Unix(),
+			"iat": now.Unix(), "nonce": "n-1", "email": "jane@corp.com"}
+	}
+	if _, err := provider.VerifyIDToken(http.DefaultClient, idp.Sign(valid()), "nyota", "n-1", now); err != nil {
+		t.Fatalf("Expected valid token, got %v", err)
+	}
+
+	invalid := map[string]func(map[string]interface{}){
+		"issuer":   func(c map[string]interface{}) { c["iss"] = "https://evil" },
+		"audience": func(c map[string]interface{}) { c["aud"] = "other" },
+		"expired":  func(c map[string]interface{}) { c["exp"] = now.Add(-2 * time.Minute).Unix() },
+		"future":   func(c map[string]interface{}) { c["iat"] = now.Add(time.Hour).Unix() },
+		"nonce":    func(c map[string]interface{}) { c["nonce"] = "n-2" },
+	}
+	for name, change := range invalid {
+		claims := valid()
+		change(claims)
+		if _, err := provider.VerifyIDToken(http.DefaultClient, idp.Sign(claims), "nyota", "n-1", now); err == nil {
+			t.Errorf("Expected %s check to fail", name)
+		}
+	}
+
+	parts := strings.Split(idp.Sign(valid()), ".")
+	tampered := parts[0] + "." + strings.Split(idp.Sign(map[string]interface{}{"email": "admin@corp.com"}), ".")[1] + "." + parts[2]
+	if _, err := provider.VerifyIDToken(http.DefaultClient, tampered, "nyota", "n-1", now); err == nil {
+		t.Errorf("Expected tampered token to fail")
+	}
+	unsigned := "eyJhbGciOiJub25lIn0." + parts[1] + "."
+	if _, err := provider.VerifyIDToken(http.DefaultClient, unsigned, "nyota", "n-1", now); err == nil {
+		t.Errorf("Expected unsigned token to fail")
+	}
+}
@@ -0,0 +1,170 @@
+// Package oidctest provides a stand-in OpenID Connect provider. Used where no identity provider is
+// available, e.g. tests.
+package oidctest
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const keyID = "test-key"
+
+// Provider approves every authorization request right away and redirects back with a code. Its
+// token endpoint checks client secret, redirect uri and PKCE verifier of the code and issues an id
+// token with Claims, signed with a key of its JWKS.
+type Provider struct {
+	URL          string // issuer
+	ClientID     string
+	ClientSecret string
+
+	mu     sync.Mutex
+	claims map[string]interface{}
+	grants map[string]grant
+	server *httptest.Server
+	key    *rsa.PrivateKey
+}
+
+type grant struct {
+	nonce, challenge, redirectURI string
+}
+
+// NewProvider - started provider for client, Close stops it.
+func NewProvider(clientID, clientSecret string) *Provider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	provider := &Provider{ClientID: clientID, ClientSecret: clientSecret, key: key,
+		claims: map[string]interface{}{}, grants: map[string]grant{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", provider.discovery)
+	mux.HandleFunc("/authorize", provider.authorize)
+	mux.HandleFunc("/token", provider.token)
+	mux.HandleFunc("/jwks", provider.jwks)
+	provider.server = httptest.NewServer(mux)
+	provider.URL = provider.server.URL
+	return provider
+}
+
+// Close - stops the provider.
+func (provider *Provider) Close() {
+	provider.server.Close()
+}
+
+// SetClaims - claims of the next id tokens besides iss, aud, exp, iat and nonce.
+func (provider *Provider) SetClaims(claims map[string]interface{}) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+	provider.claims = claims
+}
+
+// Sign - id token with claims signed by the key of the provider.
+func (provider *Provider) Sign(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": keyID})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, provider.key, crypto.SHA256, digest[:])
+	if err != nil {
+		panic(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (provider *Provider) discovery(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]string{
+		"issuer":                 provider.URL,
+		"authorization_endpoint": provider.URL + "/authorize",
+		"token_endpoint":         provider.URL + "/token",
+		"jwks_uri":               provider.URL + "/jwks",
+	})
+}
+
+func (provider *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("client_id") != provider.ClientID || query.Get("response_type") != "code" ||
+		query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+	code := randomString()
+	provider.mu.Lock()
+	provider.grants[code] = grant{nonce: query.Get("nonce"), challenge: query.Get("code_challenge"),
+		redirectURI: query.Get("redirect_uri")}
+	provider.mu.Unlock()
+	redirect, _ := url.Parse(query.Get("redirect_uri"))
+	params := redirect.Query()
+	params.Set("code", code)
+	params.Set("state", query.Get("state"))
+	redirect.RawQuery = params.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (provider *Provider) token(w http.ResponseWriter, r *http.Request) {
+	clientID, secret, _ := r.BasicAuth()
+	clientID, _ = url.QueryUnescape(clientID)
+	secret, _ = url.QueryUnescape(secret)
+	provider.mu.Lock()
+	code := r.PostFormValue("code")
+	grant, ok := provider.grants[code]
+	delete(provider.grants, code)
+	claims := map[string]interface{}{}
+	for name, value := range provider.claims {
+		claims[name] = value
+	}
+	provider.mu.Unlock()
+
+	verifier := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+	switch {
+	case clientID != provider.ClientID || secret != provider.ClientSecret:
+		tokenError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	case !ok || grant.redirectURI != r.PostFormValue("redirect_uri") || r.PostFormValue("grant_type") != "authorization_code":
+		tokenError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	case base64.RawURLEncoding.EncodeToString(verifier[:]) != grant.challenge:
+		tokenError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+
+	now := time.Now()
+	claims["iss"] = provider.URL
+	claims["aud"] = provider.ClientID
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(5 * time.Minute).Unix()
+	claims["nonce"] = grant.nonce
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"id_token": provider.Sign(claims), "token_type": "Bearer",
+		"access_token": randomString()})
+}
+
+func (provider *Provider) jwks(w http.ResponseWriter, r *http.Request) {
+	key := provider.key.PublicKey
+	json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+		"kty": "RSA", "use": "sig", "alg": "RS256", "kid": keyID,
+		"n": base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+}
+
+func tokenError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+func randomString() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2id parameters for new hashes. Parameters are stored with every hash, so they can be
+// raised later, older hashes keep verifying and are upgraded on next login.
+const (
+	argonTime    = 1
+	argonMemory  = 64 * 1024 // KiB
+	argonThreads = 2
+	argonKeyLen  = 32
+	saltLen      = 16
+
+	hashPrefix = "argon2id$"
+)
+
+// HashPassword - argon2id hash of password with a new random salt, both base64 encoded.
+// Hash has the form argon2id$v=19$m=65536,t=1,p=2$<key>.
+func HashPassword(password string) (hash string, salt string, err error) {
+	saltBytes := make([]byte, saltLen)
+	if _, err := rand.Read(saltBytes); err != nil {
+		return "", "", err
+	}
+	key := argon2.IDKey([]byte(password), saltBytes, argonTime, argonMemory, argonThreads, argonKeyLen)
+	hash = fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s", hashPrefix, argon2.Version, argonMemory, argonTime,
+		argonThreads, base64.RawStdEncoding.EncodeToString(key))
+	return hash, base64.RawStdEncoding.EncodeToString(saltBytes), nil
+}
+
+// CheckPassword - verifies password against stored hash and salt. needsRehash is set on a match
+// when the stored value is a legacy plaintext password or uses older parameters.
+func CheckPassword(password, hash, salt string) (ok bool, needsRehash bool) {
+	if !strings.HasPrefix(hash, hashPrefix) {
+		// Legacy rows stored the password itself.
+		ok = hash != "" && subtle.ConstantTimeCompare([]byte(password), []byte(hash)) == 1
+		return ok, ok
+	}
+
+	var version, memory, time, threads int
+	parts := strings.Split(strings.TrimPrefix(hash, hashPrefix), "$")
+	if len(parts) != 3 {
+		return false, false
+	}
+	if _, err := fmt.Sscanf(parts[0], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, false
+	}
+	if _, err := fmt.Sscanf(parts[1], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil ||
+		memory <= 0 || time <= 0 || threads <= 0 || threads > 255 {
+		return false, false
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil || len(key) == 0 {
+		return false, false
+	}
+	saltBytes, err := base64.RawStdEncoding.DecodeString(salt)
+	if err != nil || len(saltBytes) == 0 {
+		return false, false
+	}
+
+	derived := argon2.IDKey([]byte(password), saltBytes, uint32(time), uint32(memory), uint8(threads), uint32(len(key)))
+	if subtle.ConstantTimeCompare(derived, key) != 1 {
+		return false, false
+	}
+	return true, memory != argonMemory || time != argonTime || threads != argonThreads || len(key) != argonKeyLen
+}
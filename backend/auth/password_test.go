@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/argon2"
+)
+
+func TestHashPassword(t *testing.T) {
+	hash, salt, err := HashPassword("Secret123")
+	if err != nil {
+		t.Fatalf("HashPassword failed: %v", err)
+	}
+	if !strings.HasPrefix(hash, "argon2id$v=19$m=65536,t=1,p=2$") || strings.Contains(hash, "Secret123") {
+		t.Errorf("Unexpected hash %q", hash)
+	}
+	if ok, rehash := CheckPassword("Secret123", hash, salt); !ok || rehash {
+		t.Errorf("Expected password to match without rehash, got %v %v", ok, rehash)
+	}
+	if ok, _ := CheckPassword("secret123", hash, salt); ok {
+		t.Errorf("Expected wrong password to fail")
+	}
+
+	otherHash, otherSalt, _ := HashPassword("Secret123")
+	if otherHash == hash || otherSalt == salt {
+		t.Errorf("Expected different salt and hash for every call")
+	}
+	if ok, _ := CheckPassword("Secret123", hash, otherSalt); ok {
+		t.Errorf("Expected hash to fail with salt of another user")
+	}
+}
+
+func TestCheckLegacyPassword(t *testing.T) {
+	if ok, rehash := CheckPassword("pass123", "pass123", ""); !ok || !rehash {
+		t.Errorf("Expected legacy password to match and need rehash, got %v %v", ok, rehash)
+	}
+	if ok, _ := CheckPassword("pass124", "pass123", ""); ok {
+		t.Errorf("Expected wrong legacy password to fail")
+	}
+	if ok, _ := CheckPassword("", "", ""); ok {
+		t.Errorf("Expected empty stored password to never match")
+	}
+}
+
+func TestCheckPasswordOldParameters(t *testing.T) {
+	salt := []byte("saltsaltsaltsalt")
+	key := argon2.IDKey([]byte("Secret123"), salt, 1, 8*1024, 1, 32)
+	hash := "argon2id$v=19$m=8192,t=1,p=1$" + base64.RawStdEncoding.EncodeToString(key)
+	encodedSalt := base64.RawStdEncoding.EncodeToString(salt)
+	if ok, rehash := CheckPassword("Secret123", hash, encodedSalt); !ok || !rehash {
+		t.Errorf("Expected older parameters to match and need rehash, got %v %v", ok, rehash)
+	}
+	if ok, _ := CheckPassword("Secret123", "argon2id$v=19$m=8192,t=1,p=1$invalid!", encodedSalt); ok {
+		t.Errorf("Expected malformed hash to fail")
+	}
+	if ok, _ := CheckPassword("Secret123", "argon2id$v=19$m=0,t=1,p=1$AAAA", encodedSalt); ok {
+		t.Errorf("Expected invalid parameters to fail")
+	}
+}
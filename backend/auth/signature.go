@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Headers of requests signed with cluster credentials.
+const (
+	HeaderKeyID     = "X-Nyota-Key"
+	HeaderTimestamp = "X-Nyota-Timestamp" // unix seconds
+	HeaderNonce     = "X-Nyota-Nonce"     // unique per request, 16 to 128 url safe characters
+	HeaderSignature = "X-Nyota-Signature" // hex HMAC-SHA256
+)
+
+// Signature - HMAC-SHA256 with secret over method, path, timestamp, nonce and sha256 of
+// body, each on its own line.
+func Signature(secret, method, path, timestamp, nonce string, body []byte) string {
+	bodySum := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(method + "\n" + path + "\n" + timestamp + "\n" + nonce + "\n" + hex.EncodeToString(bodySum[:])))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CheckSignature - constant time comparison of signature with the expected one.
+func CheckSignature(signature, secret, method, path, timestamp, nonce string, body []byte) bool {
+	expected := Signature(secret, method, path, timestamp, nonce, body)
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+// SignRequest - sets body and signature headers on req, as CPPM clusters do for callbacks.
+func SignRequest(req *http.Request, keyID, secret string, body []byte, now time.Time) error {
+	nonce, err := RandomToken(18)
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set(HeaderKeyID, keyID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderNonce, nonce)
+	req.Header.Set(HeaderSignature, Signature(secret, req.Method, req.URL.Path, timestamp, nonce, body))
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	return nil
+}
+
+// RandomToken - n random bytes, url safe base64 encoded.
+func RandomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
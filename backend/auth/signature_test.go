@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignRequest(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost/api/v1/event", nil)
+	body := []byte(`{"uuid":"u-1"}`)
+	if err := SignRequest(req, "key-1", "secret", body, time.Unix(1700000000, 0)); err != nil {
+		t.Fatalf("SignRequest failed: %v", err)
+	}
+	if req.Header.Get(HeaderKeyID) != "key-1" || req.Header.Get(HeaderTimestamp) != "1700000000" {
+		t.Errorf("Unexpected headers %v", req.Header)
+	}
+	if nonce := req.Header.Get(HeaderNonce); len(nonce) != 24 || strings.ContainsAny(nonce, "+/=") {
+		t.Errorf("Expected url safe nonce, got %q", nonce)
+	}
+	sent, _ := ioutil.ReadAll(req.Body)
+	if string(sent) != string(body) {
+		t.Errorf("Expected body to be set, got %q", sent)
+	}
+
+	signature := req.Header.Get(HeaderSignature)
+	nonce := req.Header.Get(HeaderNonce)
+	if !CheckSignature(signature, "secret", http.MethodPost, "/api/v1/event", "1700000000", nonce, body) {
+		t.Errorf("Expected signature to verify")
+	}
+	tampered := []struct {
+		secret, method, path, timestamp, nonce, body string
+	}{
+		{"other", http.MethodPost, "/api/v1/event", "1700000000", nonce, string(body)},
+		{"secret", http.MethodPut, "/api/v1/event", "1700000000", nonce, string(body)},
+		{"secret", http.MethodPost, "/api/v1/events", "1700000000", nonce, string(body)},
+		{"secret", http.MethodPost, "/api/v1/event", "1700000001", nonce, string(body)},
+		{"secret", http.MethodPost, "/api/v1/event", "1700000000", nonce + "x", string(body)},
+		{"secret", http.MethodPost, "/api/v1/event", "1700000000", nonce, `{"uuid":"u-2"}`},
+	}
+	for _, test := range tampered {
+		if CheckSignature(signature, test.secret, test.method, test.path, test.timestamp, test.nonce, []byte(test.body)) {
+			t.Errorf("Expected signature to fail for %v", test)
+		}
+	}
+}
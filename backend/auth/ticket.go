@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+)
+
+// TicketToken - "<event id>.<attendee id>.<signature>" carried by the QR code of a ticket. Signature
+// is the url safe base64 HMAC-SHA256 with the ticket key of the event over both ids, tickets stay
+// valid until the attendee is removed.
+func TicketToken(key string, eventID, attendeeID int) string {
+	payload := strconv.Itoa(eventID) + "." + strconv.Itoa(attendeeID)
+	return payload + "." + ticketSignature(key, payload)
+}
+
+// ParseTicketToken - event and attendee id of token, false when it is malformed. The signature has to
+// be checked with CheckTicketToken and the key of the event.
+func ParseTicketToken(token string) (eventID, attendeeID int, ok bool) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 || parts[2] == "" {
+		return 0, 0, false
+	}
+	eventID, err := strconv.Atoi(parts[0])
+	if err != nil || eventID <= 0 {
+		return 0, 0, false
+	}
+	attendeeID, err = strconv.Atoi(parts[1])
+	if err != nil || attendeeID <= 0 {
+		return 0, 0, false
+	}
+	return eventID, attendeeID, true
+}
+
+// CheckTicketToken - constant time comparison of the signature of token with the one of key.
+func CheckTicketToken(token, key string) bool {
+	token = strings.TrimSpace(token)
+	i := strings.LastIndex(token, ".")
+	if i < 0 || key == "" {
+		return false
+	}
+	return hmac.Equal([]byte(token[i+1:]), []byte(ticketSignature(key, token[:i])))
+}
+
+func ticketSignature(key, payload string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
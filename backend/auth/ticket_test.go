@@ -0,0 +1,25 @@
+package auth
+
+import "testing"
+
+func TestTicketToken(t *testing.T) {
+	token := TicketToken("key", 12, 345)
+	eventID, attendeeID, ok := ParseTicketToken(token)
+	if !ok || eventID != 12 || attendeeID != 345 {
+		t.Fatalf("Expected token %q to parse, got %d %d %v", token, eventID, attendeeID, ok)
+	}
+	if !CheckTicketToken(token, "key") {
+		t.Errorf("Expected token to verify with its key")
+	}
+	if CheckTicketToken(token, "other") || CheckTicketToken(token, "") {
+		t.Errorf("Expected other keys to fail")
+	}
+	if forged := "12.346" + token[len("12.345"):]; CheckTicketToken(forged, "key") {
+		t.Errorf("Expected token of other attendee with copied signature to fail")
+	}
+	for _, invalid := range []string{"", "12.345", "12.345.", "a.345.sig", "12.b.sig", "0.345.sig", "12.345.sig.x"} {
+		if _, _, ok := ParseTicketToken(invalid); ok {
+			t.Errorf("Expected %q to be invalid", invalid)
+		}
+	}
+}
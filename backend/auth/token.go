@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// Prefixes of tokens, make them recognizable to secret scanners. API tokens are sent as bearer token,
+// calendar tokens are part of the URL of calendar feeds.
+const (
+	APITokenPrefix      = "nyt_"
+	CalendarTokenPrefix = "nyc_"
+)
+
+// NewAPIToken - new token "nyt_<id>.<secret>", its id for lookup and the hash of secret to store.
+// The token itself is shown once and never stored.
+func NewAPIToken() (token, id, hash string, err error) {
+	return newToken(APITokenPrefix)
+}
+
+// ParseAPIToken - id and secret of token, false when it is not an API token.
+func ParseAPIToken(token string) (id, secret string, ok bool) {
+	return parseToken(APITokenPrefix, token)
+}
+
+// NewCalendarToken - new token "nyc_<id>.<secret>" like NewAPIToken.
+func NewCalendarToken() (token, id, hash string, err error) {
+	return newToken(CalendarTokenPrefix)
+}
+
+// ParseCalendarToken - id and secret of token, false when it is not a calendar token.
+func ParseCalendarToken(token string) (id, secret string, ok bool) {
+	return parseToken(CalendarTokenPrefix, token)
+}
+
+func newToken(prefix string) (token, id, hash string, err error) {
+	if id, err = RandomToken(9); err != nil {
+		return "", "", "", err
+	}
+	secret, err := RandomToken(32)
+	if err != nil {
+		return "", "", "", err
+	}
+	return prefix + id + "." + secret, id, HashTokenSecret(secret), nil
+}
+
+func parseToken(prefix, token string) (id, secret string, ok bool) {
+	if !strings.HasPrefix(token, prefix) {
+		return "", "", false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(token, prefix), ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// HashTokenSecret - hex sha256 of secret. Secrets are random, a slow hash adds nothing.
+func HashTokenSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckTokenSecret - constant time comparison of the hash of secret with hash.
+func CheckTokenSecret(secret, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashTokenSecret(secret)), []byte(hash)) == 1
+}
+
+// BearerToken - token of the "Authorization: Bearer" header of r, empty when there is none.
+func BearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if len(header) < 7 || !strings.EqualFold(header[:7], "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(header[7:])
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAPIToken(t *testing.T) {
+	token, id, hash, err := NewAPIToken()
+	if err != nil {
+		t.Fatalf("NewAPIToken failed: %v", err)
+	}
+	if !strings.HasPrefix(token, APITokenPrefix) || strings.Contains(hash, token) {
+		t.Errorf("Unexpected token %q with hash %q", token, hash)
+	}
+	parsedID, secret, ok := ParseAPIToken(token)
+	if !ok || parsedID != id || !CheckTokenSecret(secret, hash) {
+		t.Fatalf("Expected token to parse and verify, got %q %q %v", parsedID, secret, ok)
+	}
+	if CheckTokenSecret(secret+"x", hash) {
+		t.Errorf("Expected other secret to fail")
+	}
+	for _, invalid := range []string{"", "nyt_", "nyt_id", "nyt_.secret", "nyt_id.", "abc_id.secret"} {
+		if _, _, ok := ParseAPIToken(invalid); ok {
+			t.Errorf("Expected %q to be invalid", invalid)
+		}
+	}
+}
+
+func TestCalendarToken(t *testing.T) {
+	token, id, hash, err := NewCalendarToken()
+	if err != nil {
+		t.Fatalf("NewCalendarToken failed: %v", err)
+	}
+	parsedID, secret, ok := ParseCalendarToken(token)
+	if !ok || parsedID != id || !CheckTokenSecret(secret, hash) {
+		t.Fatalf("Expected token to parse and verify, got %q %q %v", parsedID, secret, ok)
+	}
+	if _, _, ok := ParseAPIToken(token); ok {
+		t.Errorf("Expected calendar token not to be an API token")
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		header, expected string
+	}{
+		{"Bearer nyt_a.b", "nyt_a.b"},
+		{"bearer  nyt_a.b ", "nyt_a.b"},
+		{"Basic abc", ""},
+		{"Bearer", ""},
+		{"", ""},
+	}
+	for _, test := range tests {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost/", nil)
+		req.Header.Set("Authorization", test.header)
+		if token := BearerToken(req); token != test.expected {
+			t.Errorf("BearerToken(%q) = %q, expected %q", test.header, token, test.expected)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc6238Secret - base32 of the SHA1 key of the RFC 6238 test vectors.
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 appendix B, last six of the eight digits.
+	vectors := map[int64]string{59: "287082", 1111111109: "081804", 1111111111: "050471", 1234567890: "005924", 2000000000: "279037"}
+	for unix, expected := range vectors {
+		if code, err := TOTPCode(rfc6238Secret, time.Unix(unix, 0)); err != nil || code != expected {
+			t.Errorf("Expected %s at %d, got %s: %v", expected, unix, code, err)
+		}
+	}
+}
+
+func TestCheckTOTP(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	code, _ := TOTPCode(rfc6238Secret, now)
+	step, ok := CheckTOTP(rfc6238Secret, code, now, 0)
+	if !ok || step != 1234567890/30 {
+		t.Fatalf("Expected code valid at step %d, got %d %t", 1234567890/30, step, ok)
+	}
+	if _, ok := CheckTOTP(rfc6238Secret, code, now, step); ok {
+		t.Errorf("Expected used code to be refused")
+	}
+	if _, ok := CheckTOTP(rfc6238Secret, code, now.Add(TOTPPeriod), 0); !ok {
+		t.Errorf("Expected code of previous step accepted")
+	}
+	if _, ok := CheckTOTP(rfc6238Secret, code, now.Add(2*TOTPPeriod), 0); ok {
+		t.Errorf("Expected code two steps old refused")
+	}
+	if _, ok := CheckTOTP(rfc6238Secret, "12345", now, 0); ok {
+		t.Errorf("Expected short code refused")
+	}
+}
+
+func TestTOTPEnrollment(t *testing.T) {
+	secret, err := NewTOTPSecret()
+	if err != nil || len(secret) != 32 {
+		t.Fatalf("Unexpected secret %q: %v", secret, err)
+	}
+	uri, _ := url.Parse(TOTPURI("Nyota", "jane@corp.com", secret))
+	if uri.Scheme != "otpauth" || uri.Host != "totp" || uri.Query().Get("secret") != secret || !strings.HasSuffix(uri.Path, ":jane@corp.com") {
+		t.Errorf("Unexpected uri %s", uri)
+	}
+
+	codes, err := NewRecoveryCodes(10)
+	if err != nil || len(codes) != 10 || len(codes[0]) != 9 {
+		t.Fatalf("Unexpected recovery codes %v: %v", codes, err)
+	}
+	hashes := []string{HashRecoveryCode(codes[0]), HashRecoveryCode(codes[1])}
+	if i, ok := CheckRecoveryCode(" "+strings.ToUpper(strings.Replace(codes[1], "-", "", 1)), hashes); !ok || i != 1 {
+		t.Errorf("Expected recovery code found regardless of case and separator, got %d %t", i, ok)
+	}
+	if _, ok := CheckRecoveryCode(codes[2], hashes); ok {
+		t.Errorf("Expected unknown recovery code refused")
+	}
+}
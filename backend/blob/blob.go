@@ -0,0 +1,55 @@
+// Package blob stores binary objects like generated images outside of the config tables. Objects
+// are addressed by keys like "events/12/qr-256.png" and can be regenerated, backends are chosen
+// with BLOB_STORE.
+package blob
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"goprizm/sysutils"
+	"regexp"
+	"strings"
+)
+
+// ErrNotFound - no object is stored under the key.
+var ErrNotFound = errors.New("blob not found")
+
+// validKey - lower case path segments, no empty or relative ones.
+var validKey = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*(/[a-z0-9][a-z0-9._-]*)*$`)
+
+// Store - object storage backend. Put replaces existing objects, Delete of missing objects is no error.
+type Store interface {
+	Get(key string) ([]byte, error)
+	Put(key string, data []byte, contentType string) error
+	Delete(key string) error
+}
+
+// checkKey - error for keys which are no valid object key.
+func checkKey(key string) error {
+	if !validKey.MatchString(key) || strings.Contains(key, "..") {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	return nil
+}
+
+// FromEnv - backend of BLOB_STORE: "postgres" (default) keeps objects in db, "file" under BLOB_DIR
+// and "s3" in BLOB_S3_BUCKET of an S3 compatible BLOB_S3_ENDPOINT.
+func FromEnv(db *sql.DB) (Store, error) {
+	switch kind := sysutils.Getenv("BLOB_STORE", "postgres"); kind {
+	case "postgres":
+		return NewPgStore(db), nil
+	case "file":
+		return NewFileStore(sysutils.Getenv("BLOB_DIR", "/var/lib/nyota/blobs"))
+	case "s3":
+		return &S3Store{
+			Endpoint:  sysutils.Getenv("BLOB_S3_ENDPOINT", ""),
+			Bucket:    sysutils.Getenv("BLOB_S3_BUCKET", ""),
+			Region:    sysutils.Getenv("BLOB_S3_REGION", "us-east-1"),
+			AccessKey: sysutils.Getenv("BLOB_S3_ACCESS_KEY", ""),
+			SecretKey: sysutils.Getenv("BLOB_S3_SECRET_KEY", ""),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE %q", kind)
+	}
+}
@@ -0,0 +1,68 @@
+package blob
+
+import (
+	"bytes"
+	"testing"
+
+	"nyota/backend/blob/s3test"
+)
+
+// testStore - put, get, replace and delete through store.
+func testStore(t *testing.T, store Store) {
+	if _, err := store.Get("events/1/qr-256.png"); err != ErrNotFound {
+		t.Fatalf("Expected ErrNotFound for missing object, got %v", err)
+	}
+	if err := store.Put("events/1/qr-256.png", []byte("first"), "image/png"); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if err := store.Put("events/1/qr-256.png", []byte("second"), "image/png"); err != nil {
+		t.Fatalf("Put of existing object failed: %v", err)
+	}
+	if data, err := store.Get("events/1/qr-256.png"); err != nil || !bytes.Equal(data, []byte("second")) {
+		t.Fatalf("Expected replaced object, got %q %v", data, err)
+	}
+	if err := store.Delete("events/1/qr-256.png"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := store.Get("events/1/qr-256.png"); err != ErrNotFound {
+		t.Errorf("Expected deleted object to be gone, got %v", err)
+	}
+	if err := store.Delete("events/1/qr-256.png"); err != nil {
+		t.Errorf("Expected delete of missing object to succeed, got %v", err)
+	}
+	for _, key := range []string{"", "../etc/passwd", "events/../x", "/events/1", "events//1", "Events/1"} {
+		if err := store.Put(key, []byte("x"), ""); err == nil {
+			t.Errorf("Expected key %q to be refused", key)
+		}
+	}
+}
+
+func TestMemStore(t *testing.T) {
+	testStore(t, NewMemStore())
+}
+
+func TestFileStore(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore failed: %v", err)
+	}
+	testStore(t, store)
+}
+
+func TestS3Store(t *testing.T) {
+	server := s3test.NewServer("nyota", "access", "secret")
+	defer server.Close()
+	store := &S3Store{Endpoint: server.URL, Bucket: "nyota", Region: s3test.Region, AccessKey: "access", SecretKey: "secret"}
+	testStore(t, store)
+
+	if err := store.Put("events/2/qr-128.svg", []byte("<svg/>"), "image/svg+xml"); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if data, contentType, ok := server.Object("events/2/qr-128.svg"); !ok || string(data) != "<svg/>" || contentType != "image/svg+xml" {
+		t.Errorf("Expected object with content type on server, got %q %q %v", data, contentType, ok)
+	}
+	wrong := &S3Store{Endpoint: server.URL, Bucket: "nyota", Region: s3test.Region, AccessKey: "access", SecretKey: "wrong"}
+	if _, err := wrong.Get("events/2/qr-128.svg"); err == nil || err == ErrNotFound {
+		t.Errorf("Expected wrong secret to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package blob
+
+import "sync"
+
+// MemStore - objects in memory, for tests and local runs. Objects are lost on restart.
+type MemStore struct {
+	mu      sync.RWMutex
+	objects map[string][]byte
+}
+
+// NewMemStore - empty in memory store.
+func NewMemStore() *MemStore {
+	return &MemStore{objects: make(map[string][]byte)}
+}
+
+// Get - copy of object of key.
+func (store *MemStore) Get(key string) ([]byte, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	data, ok := store.objects[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return append([]byte(nil), data...), nil
+}
+
+// Put - stores a copy of data under key.
+func (store *MemStore) Put(key string, data []byte, contentType string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.objects[key] = append([]byte(nil), data...)
+	return nil
+}
+
+// Delete - removes object of key.
+func (store *MemStore) Delete(key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	delete(store.objects, key)
+	return nil
+}
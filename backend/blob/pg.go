@@ -0,0 +1,46 @@
+package blob
+
+import "database/sql"
+
+// PgStore - objects in the blob_object table of the nyota database, as bytea.
+type PgStore struct {
+	db *sql.DB
+}
+
+// NewPgStore - store in db, its table is created by the migrations of the store package.
+func NewPgStore(db *sql.DB) *PgStore {
+	return &PgStore{db: db}
+}
+
+// Get - data of the row of key.
+func (store *PgStore) Get(key string) ([]byte, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	var data []byte
+	err := store.db.QueryRow("SELECT DATA FROM BLOB_OBJECT WHERE KEY = $1", key).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+// Put - inserts or replaces the row of key.
+func (store *PgStore) Put(key string, data []byte, contentType string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	_, err := store.db.Exec(`INSERT INTO BLOB_OBJECT (KEY, CONTENT_TYPE, DATA, UPDATED_AT) VALUES ($1, $2, $3, now())
+		ON CONFLICT (KEY) DO UPDATE SET CONTENT_TYPE = EXCLUDED.CONTENT_TYPE, DATA = EXCLUDED.DATA, UPDATED_AT = now()`,
+		key, contentType, data)
+	return err
+}
+
+// Delete - deletes the row of key.
+func (store *PgStore) Delete(key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	_, err := store.db.Exec("DELETE FROM BLOB_OBJECT WHERE KEY = $1", key)
+	return err
+}
@@ -0,0 +1,95 @@
+package blob
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"nyota/backend/auth"
+	"strings"
+	"time"
+)
+
+// s3Timeout - limit of a single request to the object store.
+const s3Timeout = 30 * time.Second
+
+// S3Store - objects in Bucket of an S3 compatible object store at Endpoint (e.g. "https://s3.eu-west-1.amazonaws.com"
+// or a MinIO server), addressed path style. Requests are signed with Signature Version 4.
+type S3Store struct {
+	Endpoint  string
+	Bucket    string
+	Region    string
+	AccessKey string
+	SecretKey string
+	Client    *http.Client // http.Client with s3Timeout when nil
+}
+
+// Get - object of key.
+func (store *S3Store) Get(key string) ([]byte, error) {
+	resp, err := store.do(http.MethodGet, key, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, s3Error(resp)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// Put - uploads data as object of key.
+func (store *S3Store) Put(key string, data []byte, contentType string) error {
+	resp, err := store.do(http.MethodPut, key, data, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return s3Error(resp)
+	}
+	return nil
+}
+
+// Delete - deletes object of key, S3 answers 204 whether it existed or not.
+func (store *S3Store) Delete(key string) error {
+	resp, err := store.do(http.MethodDelete, key, nil, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return s3Error(resp)
+	}
+	return nil
+}
+
+// do - signed request for the object of key.
+func (store *S3Store) do(method, key string, body []byte, contentType string) (*http.Response, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	url := strings.TrimRight(store.Endpoint, "/") + "/" + store.Bucket + "/" + key
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	auth.SignAWSV4(req, store.AccessKey, store.SecretKey, store.Region, "s3", body, time.Now())
+	client := store.Client
+	if client == nil {
+		client = &http.Client{Timeout: s3Timeout}
+	}
+	return client.Do(req)
+}
+
+// s3Error - error of an unexpected response, with the start of the error document S3 sends.
+func s3Error(resp *http.Response) error {
+	detail, _ := ioutil.ReadAll(&io.LimitedReader{R: resp.Body, N: 512})
+	return fmt.Errorf("s3 %s %s: %s %s", resp.Request.Method, resp.Request.URL.Path, resp.Status, bytes.TrimSpace(detail))
+}
@@ -0,0 +1,97 @@
+// Package s3test provides a stand-in S3 compatible object store. Used where no object store is
+// available, e.g. tests.
+package s3test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"nyota/backend/auth"
+	"strings"
+	"sync"
+)
+
+// Region - region requests to the server have to be signed for.
+const Region = "us-east-1"
+
+// Server keeps objects of a single bucket in memory. It answers GET, PUT and DELETE of objects like
+// S3 does for path style requests, when they are signed with Signature Version 4 of its credentials.
+type Server struct {
+	URL       string // endpoint
+	Bucket    string
+	AccessKey string
+	SecretKey string
+
+	mu      sync.Mutex
+	objects map[string]object
+	server  *httptest.Server
+}
+
+type object struct {
+	data        []byte
+	contentType string
+}
+
+// NewServer - started server with bucket for the credentials, Close stops it.
+func NewServer(bucket, accessKey, secretKey string) *Server {
+	server := &Server{Bucket: bucket, AccessKey: accessKey, SecretKey: secretKey, objects: map[string]object{}}
+	server.server = httptest.NewServer(http.HandlerFunc(server.serve))
+	server.URL = server.server.URL
+	return server
+}
+
+// Close - stops the server.
+func (server *Server) Close() {
+	server.server.Close()
+}
+
+// Object - content and content type of the object of key, false when there is none.
+func (server *Server) Object(key string) ([]byte, string, bool) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	obj, ok := server.objects[key]
+	return obj.data, obj.contentType, ok
+}
+
+func (server *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !auth.CheckAWSV4(r, server.AccessKey, server.SecretKey, Region, "s3") {
+		http.Error(w, "<Error><Code>SignatureDoesNotMatch</Code></Error>", http.StatusForbidden)
+		return
+	}
+	if r.Header.Get(auth.HeaderAmzContentHash) != auth.PayloadHash(body) {
+		http.Error(w, "<Error><Code>XAmzContentSHA256Mismatch</Code></Error>", http.StatusBadRequest)
+		return
+	}
+	prefix := "/" + server.Bucket + "/"
+	if !strings.HasPrefix(r.URL.Path, prefix) || len(r.URL.Path) == len(prefix) {
+		http.Error(w, "<Error><Code>NoSuchBucket</Code></Error>", http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, prefix)
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	switch r.Method {
+	case http.MethodGet:
+		obj, ok := server.objects[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", obj.contentType)
+		w.Write(obj.data)
+	case http.MethodPut:
+		server.objects[key] = object{data: body, contentType: r.Header.Get("Content-Type")}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(server.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "<Error><Code>MethodNotAllowed</Code></Error>", http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,81 @@
+package cppmsync
+
+import (
+	"encoding/json"
+	"fmt"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// shape - payload type of an entity for a CPPM version.
+type shape struct {
+	version  int
+	newShape func() model.VersionEntity
+}
+
+// converters - shapes of every synced entity keyed by entity name, newest version first.
+var converters = make(map[string][]shape)
+
+func init() {
+	Register((&config.Role{}).EntityName(), func() model.VersionEntity { return &config.Role{} })
+	Register((&config.Role{}).EntityName(), func() model.VersionEntity { return &config.Role66{} })
+}
+
+// Register adds the payload shape newShape of entity for the CPPM version newShape().GetVersion().
+func Register(entityName string, newShape func() model.VersionEntity) {
+	shapes := append(converters[entityName], shape{version: newShape().GetVersion(), newShape: newShape})
+	sort.Slice(shapes, func(i, j int) bool { return shapes[i].version > shapes[j].version })
+	converters[entityName] = shapes
+}
+
+// ParseVersion - version of CPPM release "major.minor[.patch[.build]]" as major*10000 + minor*100 + patch.
+func ParseVersion(release string) (int, error) {
+	parts := strings.Split(strings.TrimSpace(release), ".")
+	if len(parts) < 2 {
+		return 0, fmt.Errorf("invalid CPPM version %q", release)
+	}
+	version := 0
+	for i, factor := range []int{10000, 100, 1} {
+		if i == len(parts) {
+			break
+		}
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < 0 || n > 99 {
+			return 0, fmt.Errorf("invalid CPPM version %q", release)
+		}
+		version += n * factor
+	}
+	return version, nil
+}
+
+// Downgrade - payload of entity in the shape known to CPPM release. Payload may be any value
+// encoding to the newest shape. Payloads of entities without registered shapes, nil payloads
+// and clusters without known release are returned unchanged. ConversionError when the release
+// is older than all shapes or a field of payload can not be represented on it.
+func Downgrade(entityName string, payload interface{}, release string) (interface{}, error) {
+	shapes := converters[entityName]
+	if len(shapes) == 0 || payload == nil || release == "" {
+		return payload, nil
+	}
+	version, err := ParseVersion(release)
+	if err != nil {
+		return nil, err
+	}
+	if version < shapes[len(shapes)-1].version {
+		return nil, &model.ConversionError{Entity: entityName, Version: version}
+	}
+
+	latest := shapes[0].newShape()
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, latest); err != nil {
+		return nil, err
+	}
+	return utils.Convert(latest, version)
+}
@@ -0,0 +1,86 @@
+package cppmsync
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		release string
+		version int
+		valid   bool
+	}{
+		{"6.7", 60700, true},
+		{"6.6.10", 60610, true},
+		{" 6.11.2.123456 ", 61102, true},
+		{"6", 0, false},
+		{"6.x", 0, false},
+		{"6.100", 0, false},
+		{"", 0, false},
+	}
+	for _, test := range tests {
+		version, err := ParseVersion(test.release)
+		if (err == nil) != test.valid || version != test.version {
+			t.Errorf("ParseVersion(%q) = %d, %v, expected %d valid %v", test.release, version, err, test.version, test.valid)
+		}
+	}
+}
+
+func TestDowngradeRole(t *testing.T) {
+	role := &config.Role{ID: 4, Name: "guest", Description: "visitors", PermitID: 2, TenantID: "1",
+		Clusters: []*config.Cluster{{ID: 1}}}
+	withExtras := *role
+	withExtras.Extras = config.ExtraParam{"vlan": 12.0}
+
+	tests := []struct {
+		name     string
+		payload  interface{}
+		release  string
+		expected interface{}
+		err      string
+	}{
+		{"6.7 keeps shape", role, "6.7", role, ""},
+		{"newer release keeps shape", &withExtras, "6.9.1", &withExtras, ""},
+		{"unknown release unchanged", &withExtras, "", &withExtras, ""},
+		{"6.7 to 6.6", role, "6.6.4", &config.Role66{ID: 4, Name: "guest", Description: "visitors", PermitID: 2}, ""},
+		{"6.7 map payload to 6.6", map[string]interface{}{"id": 4, "name": "guest", "extras": nil}, "6.6",
+			&config.Role66{ID: 4, Name: "guest"}, ""},
+		{"extras not on 6.6", &withExtras, "6.6", nil, "extras of Role can not be synced to CPPM 6.6"},
+		{"6.5 not supported", role, "6.5", nil, "Role can not be synced to CPPM 6.5"},
+		{"invalid release", role, "latest", nil, `invalid CPPM version "latest"`},
+	}
+	for _, test := range tests {
+		got, err := Downgrade("Role", test.payload, test.release)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("%s: expected error %q, got %v %v", test.name, test.err, got, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		gotJSON, _ := json.Marshal(got)
+		expectedJSON, _ := json.Marshal(test.expected)
+		if reflect.TypeOf(got) != reflect.TypeOf(test.expected) || string(gotJSON) != string(expectedJSON) {
+			t.Errorf("%s: got %T %s, expected %T %s", test.name, got, gotJSON, test.expected, expectedJSON)
+		}
+	}
+}
+
+func TestDowngradeConversionError(t *testing.T) {
+	_, err := Downgrade("Role", &config.Role{Extras: config.ExtraParam{"a": 1}}, "6.6")
+	conversionErr, ok := err.(*model.ConversionError)
+	if !ok || conversionErr.Field != "extras" || conversionErr.Release() != "6.6" {
+		t.Errorf("Expected conversion error for extras on 6.6, got %#v", err)
+	}
+	if payload, err := Downgrade("cppmnode", map[string]interface{}{"x": 1}, "6.6"); err != nil || payload.(map[string]interface{})["x"] != 1 {
+		t.Errorf("Expected payload of unregistered entity unchanged, got %v %v", payload, err)
+	}
+}
@@ -0,0 +1,143 @@
+package cppmsync
+
+import (
+	"encoding/json"
+	"fmt"
+	"goprizm/sysutils"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/watch"
+	"time"
+)
+
+// Channel events are published on, CPPM clusters subscribe to it.
+const EventChannel = "event"
+
+// Outbox - events waiting for delivery to CPPM clusters.
+type Outbox interface {
+	GetDueOutboxEvents(now time.Time, limit int) ([]*config.OutboxEvent, error)
+	UpdateOutboxEvent(event *config.OutboxEvent, prevAttempts int) (bool, error)
+}
+
+// Dispatcher publishes outbox events, downgraded to the CPPM version of their cluster, and
+// republishes them with exponential backoff until the cluster acknowledges them with a callback
+// or MaxAttempts is reached. Events which can not be downgraded are failed.
+type Dispatcher struct {
+	Outbox      Outbox
+	Notifier    watch.Notifier
+	MaxAttempts int           // attempts before an event is failed
+	Backoff     time.Duration // wait after first attempt, doubled for every further attempt
+	MaxBackoff  time.Duration
+	BatchSize   int // events dispatched per run
+}
+
+// NewDispatcher - dispatcher configured from env SYNC_MAX_ATTEMPTS, SYNC_BACKOFF_SECONDS and
+// SYNC_MAX_BACKOFF_SECONDS.
+func NewDispatcher(outbox Outbox, notifier watch.Notifier) *Dispatcher {
+	return &Dispatcher{
+		Outbox:      outbox,
+		Notifier:    notifier,
+		MaxAttempts: sysutils.GetenvInt("SYNC_MAX_ATTEMPTS", 8),
+		Backoff:     time.Duration(sysutils.GetenvInt("SYNC_BACKOFF_SECONDS", 30)) * time.Second,
+		MaxBackoff:  time.Duration(sysutils.GetenvInt("SYNC_MAX_BACKOFF_SECONDS", 3600)) * time.Second,
+		BatchSize:   100,
+	}
+}
+
+// Run dispatches due events every interval until stop is closed.
+func (d *Dispatcher) Run(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := d.DispatchDue(time.Now()); err != nil {
+			logutil.Errorf(nil, "Sync dispatch failed: %v", err)
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue publishes events due at now, returns number of events published.
+func (d *Dispatcher) DispatchDue(now time.Time) (int, error) {
+	events, err := d.Outbox.GetDueOutboxEvents(now, d.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	published := 0
+	for _, event := range events {
+		ok, err := d.dispatch(event, now)
+		if err != nil {
+			return published, err
+		}
+		if ok {
+			published++
+		}
+	}
+	return published, nil
+}
+
+// dispatch claims event for the next attempt and publishes it. An event already retried
+// MaxAttempts times is failed instead.
+func (d *Dispatcher) dispatch(event *config.OutboxEvent, now time.Time) (bool, error) {
+	prevAttempts := event.Attempts
+	event.UpdatedAt = now
+	if event.Attempts > 0 && event.LastError == "" {
+		event.LastError = "no acknowledgement from cluster"
+	}
+	if event.Attempts >= d.MaxAttempts {
+		event.Status = config.SyncFailed
+		logutil.Errorf(nil, "Sync event %d for cluster %s failed after %d attempts: %s", event.ID,
+			event.ClusterUUID, event.Attempts, event.LastError)
+		_, err := d.Outbox.UpdateOutboxEvent(event, prevAttempts)
+		return false, err
+	}
+
+	// Claiming first keeps concurrent dispatchers from publishing the same attempt twice.
+	event.Attempts++
+	event.NextAttemptAt = now.Add(d.backoff(event.Attempts))
+	if claimed, err := d.Outbox.UpdateOutboxEvent(event, prevAttempts); err != nil || !claimed {
+		return false, err
+	}
+
+	var data model.Event
+	err := json.Unmarshal([]byte(event.Payload), &data)
+	if err != nil {
+		return false, d.fail(event, fmt.Sprintf("invalid payload: %v", err))
+	}
+	if data.Data.Payload, err = Downgrade(data.Data.EntityName, data.Data.Payload, data.CPPMVersion); err != nil {
+		return false, d.fail(event, err.Error())
+	}
+	data.Data.EventID = event.ID
+	message, _ := json.Marshal(data)
+	if err := d.Notifier.Notify(EventChannel, message); err != nil {
+		event.LastError = err.Error()
+		_, err = d.Outbox.UpdateOutboxEvent(event, event.Attempts)
+		return false, err
+	}
+	logutil.Debugf(nil, "Sync event %d published to cluster %s, attempt %d", event.ID, event.ClusterUUID, event.Attempts)
+	return true, nil
+}
+
+// fail - event can never be delivered.
+func (d *Dispatcher) fail(event *config.OutboxEvent, reason string) error {
+	event.Status = config.SyncFailed
+	event.LastError = reason
+	_, err := d.Outbox.UpdateOutboxEvent(event, event.Attempts)
+	return err
+}
+
+// backoff - wait after attempt before the event is published again.
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	wait := d.Backoff
+	for i := 1; i < attempt && wait < d.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > d.MaxBackoff {
+		wait = d.MaxBackoff
+	}
+	return wait
+}
@@ -0,0 +1,65 @@
+package cppmsync
+
+import (
+	"testing"
+	"time"
+
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/store"
+	"nyota/backend/watch"
+)
+
+func TestBackoff(t *testing.T) {
+	d := &Dispatcher{Backoff: 30 * time.Second, MaxBackoff: 5 * time.Minute}
+	expected := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for i, wait := range expected {
+		if got := d.backoff(i + 1); got != wait {
+			t.Errorf("backoff(%d) = %v, expected %v", i+1, got, wait)
+		}
+	}
+}
+
+func TestDispatchOrderAndFailure(t *testing.T) {
+	memStore := store.NewMemStore()
+	s := &model.SessionContext{User: &model.UserContext{TenantId: "1"}}
+	east, _ := memStore.UpsertCluster(s, &config.Cluster{Name: "east", UUID: "u-1", TenantID: "1"})
+	west, _ := memStore.UpsertCluster(s, &config.Cluster{Name: "west", UUID: "u-2", TenantID: "1"})
+	first := &config.Role{Name: "guest", TenantID: "1", Clusters: []*config.Cluster{east, west}}
+	second := &config.Role{Name: "staff", TenantID: "1", Clusters: []*config.Cluster{east}}
+	memStore.UpsertRole(s, first)
+	memStore.UpsertRole(s, second)
+
+	recorder := &watch.Recorder{}
+	d := &Dispatcher{Outbox: memStore, Notifier: recorder, MaxAttempts: 2, Backoff: time.Minute, MaxBackoff: time.Hour, BatchSize: 10}
+	now := time.Now()
+
+	// One event per cluster at a time, second role waits for first on east.
+	if n, err := d.DispatchDue(now); n != 2 || err != nil {
+		t.Fatalf("Expected first role dispatched to both clusters, got %d %v", n, err)
+	}
+	if n, _ := d.DispatchDue(now.Add(time.Minute)); n != 2 {
+		t.Fatalf("Expected unacknowledged events to be retried, got %d", n)
+	}
+	if err := memStore.AckOutboxEvent(s, west.ID, 0, "Role", first.ID); err != nil {
+		t.Fatalf("Ack failed: %v", err)
+	}
+
+	// East never answers, first role fails there and second role is sent.
+	d.DispatchDue(now.Add(time.Hour))
+	status, _ := memStore.GetRoleSyncStatus(s, "1")
+	for _, event := range status {
+		if event.ClusterID == east.ID && (event.Status != config.SyncFailed || event.LastError == "") {
+			t.Errorf("Expected east failed with error, got %+v", event)
+		}
+		if event.ClusterID == west.ID && event.Status != config.SyncSynced {
+			t.Errorf("Expected west synced, got %+v", event)
+		}
+	}
+	if n, _ := d.DispatchDue(now.Add(time.Hour)); n != 1 {
+		t.Fatalf("Expected second role dispatched after first failed, got %d", n)
+	}
+	if messages := recorder.Messages(); len(messages) != 5 {
+		t.Errorf("Expected 5 published events, got %d", len(messages))
+	}
+}
@@ -418,4 +418,92 @@ const EnUs = `[{
   { "id": "cppm_version_is_required","translation": "CPPM Version is required."},
   { "id": "server_ip_is_invalid","translation": "Server IP is not valid."},
   { "id": "mgmt_ip_is_invalid","translation": "Management IP is not valid."},
-  { "id": "permit_id","translation": "Permit ID"}]`
+  { "id": "permit_id","translation": "Permit ID"},
+  { "id": "key_username_length","translation": "User Name length must be between 1 to 255"},
+  { "id": "key_role_invalid","translation": "Role must be ADMIN, ANALYST or a role of the tenant"},
+  { "id": "key_user_delete_self","translation": "Logged in user cannot be deleted"},
+  { "id": "cluster_id","translation": "Cluster"},
+  { "id": "cppm_version","translation": "CPPM Version"},
+  { "id": "server_uuid","translation": "Server UUID"},
+  { "id": "server_dns_name","translation": "Server DNS Name"},
+  { "id": "fqdn","translation": "FQDN"},
+  { "id": "server_ip","translation": "Server IP"},
+  { "id": "management_ip","translation": "Management IP"},
+  { "id": "ipv6_server_ip","translation": "IPv6 Server IP"},
+  { "id": "ipv6_management_ip","translation": "IPv6 Management IP"},
+  { "id": "is_standby","translation": "Standby"},
+  { "id": "is_master","translation": "Publisher"},
+  { "id": "provider_uuid","translation": "Provider UUID"},
+  { "id": "domain_id","translation": "Domain ID"},
+  { "id": "is_profiler_enabled","translation": "Profiler Enabled"},
+  { "id": "is_insight_enabled","translation": "Insight Enabled"},
+  { "id": "is_insight_master","translation": "Insight Master"},
+  { "id": "is_perfmas_enabled","translation": "Perfmas Enabled"},
+  { "id": "is_cloud_tunnel_enabled","translation": "Cloud Tunnel Enabled"},
+  { "id": "is_ingress_events_enabled","translation": "Ingress Events Enabled"},
+  { "id": "dhcp_span_intf","translation": "DHCP Span Interface"},
+  { "id": "replication_status","translation": "Replication Status"},
+  { "id": "key_cluster_invalid","translation": "Cluster must be a valid cluster of the tenant"},
+  { "id": "key_password_policy","translation": "Password must be 8 to 128 characters with upper case, lower case and digit"},
+  { "id": "key_event_invalid","translation": "Event is invalid"},
+  { "id": "key_event_entity_invalid","translation": "Event entity must be one of Role or cppmnode"},
+  { "id": "key_cppm_field_unsupported","translation": "Not supported by the CPPM version of a mapped cluster"},
+  { "id": "key_cppm_version_unsupported","translation": "CPPM version of a mapped cluster is not supported"},
+  { "id": "key_cppm_version_invalid","translation": "CPPM version of a mapped cluster is invalid"},
+  { "id": "key_validation_failed","translation": "Some fields are invalid"},
+  { "id": "key_not_found","translation": "Not found"},
+  { "id": "key_already_exists","translation": "Already exists"},
+  { "id": "key_unauthorized","translation": "Unauthorized"},
+  { "id": "key_forbidden","translation": "Access is denied"},
+  { "id": "key_something_wrong","translation": "Something went wrong"},
+  { "id": "key_invalid_json","translation": "Request body is not valid JSON"},
+  { "id": "key_invalid_field_type","translation": "Value has the wrong type"},
+  { "id": "key_audit_filter_invalid","translation": "Invalid audit log filter"},
+  { "id": "key_event_date","translation": "Event date"},
+  { "id": "key_query_invalid","translation": "Invalid list query"},
+  { "id": "key_query_page_invalid","translation": "Must be a positive number"},
+  { "id": "key_query_sort_invalid","translation": "Column can not be sorted on"},
+  { "id": "key_query_filter_invalid","translation": "Must be field:operator:value on a filterable column"},
+  { "id": "key_query_value_invalid","translation": "Value does not match the column type"},
+  { "id": "key_query_search_invalid","translation": "List can not be searched"},
+  { "id": "key_permissions_required","translation": "Permissions are required"},
+  { "id": "key_permissions_invalid","translation": "Permissions must be READ, MODIFY or BLOCK on known groups"},
+  { "id": "key_permissions_exceeded","translation": "Permissions can not exceed those of the logged in user"},
+  { "id": "key_admin_role_builtin","translation": "Built in roles can not be changed"},
+  { "id": "key_admin_role_in_use","translation": "Role is assigned to users"},
+  { "id": "key_service_account_required","translation": "Service account name is required"},
+  { "id": "key_token_expiry_invalid","translation": "Expiry must be in the future and within a year"},
+  { "id": "key_session_idle_range","translation": "Session idle minutes must be between 5 and 1440"},
+  { "id": "key_session_max_range","translation": "Session lifetime minutes must be between 60 and 43200"},
+  { "id": "key_login_delayed","translation": "Too many failed logins, try again in a few seconds"},
+  { "id": "key_login_locked","translation": "Login locked after too many failed attempts, try again later or ask an administrator to unlock it"},
+  { "id": "key_issuer_required","translation": "Issuer is required"},
+  { "id": "key_issuer_invalid","translation": "Issuer must be an http(s) URL"},
+  { "id": "key_client_id_required","translation": "Client id is required and at most 255 characters"},
+  { "id": "key_tenant_claim_value_required","translation": "Tenant claim value is required with a tenant claim"},
+  { "id": "key_mfa_not_enrolled","translation": "No second factor is enrolled"},
+  { "id": "key_mfa_required","translation": "A second factor is required for users of the tenant"},
+  { "id": "key_mfa_sso","translation": "The second factor of single sign-on users is managed by the identity provider"},
+  { "id": "key_mfa_code_invalid","translation": "The code is not valid"},
+  { "id": "key_version_mismatch","translation": "Changed by someone else since it was loaded, reload and try again"},
+  { "id": "key_invalid_ticket","translation": "Ticket is not valid for this event"},
+  { "id": "key_ticket_checked_in","translation": "Ticket was already checked in"},
+  { "id": "key_qr_format_invalid","translation": "Format must be png or svg"},
+  { "id": "key_qr_size_invalid","translation": "Size must be 128, 256, 512 or 1024"},
+  { "id": "key_event_date_missing","translation": "Event has no date"},
+  { "id": "key_ics_invalid","translation": "File is not a valid iCalendar file"},
+  { "id": "key_ics_no_events","translation": "Calendar file has no events"},
+  { "id": "key_ics_too_many_events","translation": "Calendar file has more than 500 events"},
+  { "id": "key_time_zone_invalid","translation": "Time zone is not a known IANA zone"},
+  { "id": "key_rrule_invalid","translation": "Recurrence rule is invalid or not supported"},
+  { "id": "key_rrule_event_date_required","translation": "Recurring events need an event date"},
+  { "id": "key_event_not_recurring","translation": "Event does not recur"},
+  { "id": "key_occurrence_invalid","translation": "Event has no such occurrence"},
+  { "id": "key_occurrence_required","translation": "Occurrence of the recurring event is required"},
+  { "id": "key_occurrence_cancelled","translation": "Occurrence of the event is cancelled"},
+  { "id": "key_occurrence_range_invalid","translation": "Range of occurrences needs from and to of at most 366 days"},
+  { "id": "key_ticket_other_occurrence","translation": "Ticket is for another occurrence of the event"},
+  { "id": "key_capacity_invalid","translation": "Capacity can not be negative"},
+  { "id": "key_ticket_waitlisted","translation": "Ticket is on the waitlist of the event"},
+  { "id": "key_lockout_address_invalid","translation": "Address must be an IP address"},
+  { "id": "key_sso_public_url_required","translation": "Single sign-on requires PUBLIC_URL to be configured"}]`
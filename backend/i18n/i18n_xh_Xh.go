@@ -402,4 +402,92 @@ const XhXh = `[{"id": "program_greeting", "translation": "英語 - Welcome to Ny
   { "id": "cppm_version_is_required","translation": "英語 - CPPM Version is required."},
   { "id": "server_ip_is_invalid","translation": "英語 - Server IP is not valid."},
   { "id": "mgmt_ip_is_invalid","translation": "英語 - Management IP is not valid."},
-  { "id": "permit_id","translation": "英語 - Permit ID"}]`
+  { "id": "permit_id","translation": "英語 - Permit ID"},
+  { "id": "key_username_length","translation": "英語 - User Name length must be between 1 to 255"},
+  { "id": "key_role_invalid","translation": "英語 - Role must be ADMIN, ANALYST or a role of the tenant"},
+  { "id": "key_user_delete_self","translation": "英語 - Logged in user cannot be deleted"},
+  { "id": "cluster_id","translation": "英語 - Cluster"},
+  { "id": "cppm_version","translation": "英語 - CPPM Version"},
+  { "id": "server_uuid","translation": "英語 - Server UUID"},
+  { "id": "server_dns_name","translation": "英語 - Server DNS Name"},
+  { "id": "fqdn","translation": "英語 - FQDN"},
+  { "id": "server_ip","translation": "英語 - Server IP"},
+  { "id": "management_ip","translation": "英語 - Management IP"},
+  { "id": "ipv6_server_ip","translation": "英語 - IPv6 Server IP"},
+  { "id": "ipv6_management_ip","translation": "英語 - IPv6 Management IP"},
+  { "id": "is_standby","translation": "英語 - Standby"},
+  { "id": "is_master","translation": "英語 - Publisher"},
+  { "id": "provider_uuid","translation": "英語 - Provider UUID"},
+  { "id": "domain_id","translation": "英語 - Domain ID"},
+  { "id": "is_profiler_enabled","translation": "英語 - Profiler Enabled"},
+  { "id": "is_insight_enabled","translation": "英語 - Insight Enabled"},
+  { "id": "is_insight_master","translation": "英語 - Insight Master"},
+  { "id": "is_perfmas_enabled","translation": "英語 - Perfmas Enabled"},
+  { "id": "is_cloud_tunnel_enabled","translation": "英語 - Cloud Tunnel Enabled"},
+  { "id": "is_ingress_events_enabled","translation": "英語 - Ingress Events Enabled"},
+  { "id": "dhcp_span_intf","translation": "英語 - DHCP Span Interface"},
+  { "id": "replication_status","translation": "英語 - Replication Status"},
+  { "id": "key_cluster_invalid","translation": "英語 - Cluster must be a valid cluster of the tenant"},
+  { "id": "key_password_policy","translation": "英語 - Password must be 8 to 128 characters with upper case, lower case and digit"},
+  { "id": "key_event_invalid","translation": "英語 - Event is invalid"},
+  { "id": "key_event_entity_invalid","translation": "英語 - Event entity must be one of Role or cppmnode"},
+  { "id": "key_cppm_field_unsupported","translation": "英語 - Not supported by the CPPM version of a mapped cluster"},
+  { "id": "key_cppm_version_unsupported","translation": "英語 - CPPM version of a mapped cluster is not supported"},
+  { "id": "key_cppm_version_invalid","translation": "英語 - CPPM version of a mapped cluster is invalid"},
+  { "id": "key_validation_failed","translation": "英語 - Some fields are invalid"},
+  { "id": "key_not_found","translation": "英語 - Not found"},
+  { "id": "key_already_exists","translation": "英語 - Already exists"},
+  { "id": "key_unauthorized","translation": "英語 - Unauthorized"},
+  { "id": "key_forbidden","translation": "英語 - Access is denied"},
+  { "id": "key_something_wrong","translation": "英語 - Something went wrong"},
+  { "id": "key_invalid_json","translation": "英語 - Request body is not valid JSON"},
+  { "id": "key_invalid_field_type","translation": "英語 - Value has the wrong type"},
+  { "id": "key_audit_filter_invalid","translation": "英語 - Invalid audit log filter"},
+  { "id": "key_event_date","translation": "英語 - Event date"},
+  { "id": "key_query_invalid","translation": "英語 - Invalid list query"},
+  { "id": "key_query_page_invalid","translation": "英語 - Must be a positive number"},
+  { "id": "key_query_sort_invalid","translation": "英語 - Column can not be sorted on"},
+  { "id": "key_query_filter_invalid","translation": "英語 - Must be field:operator:value on a filterable column"},
+  { "id": "key_query_value_invalid","translation": "英語 - Value does not match the column type"},
+  { "id": "key_query_search_invalid","translation": "英語 - List can not be searched"},
+  { "id": "key_permissions_required","translation": "英語 - Permissions are required"},
+  { "id": "key_permissions_invalid","translation": "英語 - Permissions must be READ, MODIFY or BLOCK on known groups"},
+  { "id": "key_permissions_exceeded","translation": "英語 - Permissions can not exceed those of the logged in user"},
+  { "id": "key_admin_role_builtin","translation": "英語 - Built in roles can not be changed"},
+  { "id": "key_admin_role_in_use","translation": "英語 - Role is assigned to users"},
+  { "id": "key_service_account_required","translation": "英語 - Service account name is required"},
+  { "id": "key_token_expiry_invalid","translation": "英語 - Expiry must be in the future and within a year"},
+  { "id": "key_session_idle_range","translation": "英語 - Session idle minutes must be between 5 and 1440"},
+  { "id": "key_session_max_range","translation": "英語 - Session lifetime minutes must be between 60 and 43200"},
+  { "id": "key_login_delayed","translation": "英語 - Too many failed logins, try again in a few seconds"},
+  { "id": "key_login_locked","translation": "英語 - Login locked after too many failed attempts, try again later or ask an administrator to unlock it"},
+  { "id": "key_issuer_required","translation": "英語 - Issuer is required"},
+  { "id": "key_issuer_invalid","translation": "英語 - Issuer must be an http(s) URL"},
+  { "id": "key_client_id_required","translation": "英語 - Client id is required and at most 255 characters"},
+  { "id": "key_tenant_claim_value_required","translation": "英語 - Tenant claim value is required with a tenant claim"},
+  { "id": "key_mfa_not_enrolled","translation": "英語 - No second factor is enrolled"},
+  { "id": "key_mfa_required","translation": "英語 - A second factor is required for users of the tenant"},
+  { "id": "key_mfa_sso","translation": "英語 - The second factor of single sign-on users is managed by the identity provider"},
+  { "id": "key_mfa_code_invalid","translation": "英語 - The code is not valid"},
+  { "id": "key_version_mismatch","translation": "英語 - Changed by someone else since it was loaded, reload and try again"},
+  { "id": "key_invalid_ticket","translation": "英語 - Ticket is not valid for this event"},
+  { "id": "key_ticket_checked_in","translation": "英語 - Ticket was already checked in"},
+  { "id": "key_qr_format_invalid","translation": "英語 - Format must be png or svg"},
+  { "id": "key_qr_size_invalid","translation": "英語 - Size must be 128, 256, 512 or 1024"},
+  { "id": "key_event_date_missing","translation": "英語 - Event has no date"},
+  { "id": "key_ics_invalid","translation": "英語 - File is not a valid iCalendar file"},
+  { "id": "key_ics_no_events","translation": "英語 - Calendar file has no events"},
+  { "id": "key_ics_too_many_events","translation": "英語 - Calendar file has more than 500 events"},
+  { "id": "key_time_zone_invalid","translation": "英語 - Time zone is not a known IANA zone"},
+  { "id": "key_rrule_invalid","translation": "英語 - Recurrence rule is invalid or not supported"},
+  { "id": "key_rrule_event_date_required","translation": "英語 - Recurring events need an event date"},
+  { "id": "key_event_not_recurring","translation": "英語 - Event does not recur"},
+  { "id": "key_occurrence_invalid","translation": "英語 - Event has no such occurrence"},
+  { "id": "key_occurrence_required","translation": "英語 - Occurrence of the recurring event is required"},
+  { "id": "key_occurrence_cancelled","translation": "英語 - Occurrence of the event is cancelled"},
+  { "id": "key_occurrence_range_invalid","translation": "英語 - Range of occurrences needs from and to of at most 366 days"},
+  { "id": "key_ticket_other_occurrence","translation": "英語 - Ticket is for another occurrence of the event"},
+  { "id": "key_capacity_invalid","translation": "英語 - Capacity can not be negative"},
+  { "id": "key_ticket_waitlisted","translation": "英語 - Ticket is on the waitlist of the event"},
+  { "id": "key_lockout_address_invalid","translation": "英語 - Address must be an IP address"},
+  { "id": "key_sso_public_url_required","translation": "英語 - Single sign-on requires PUBLIC_URL to be configured"}]`
@@ -0,0 +1,374 @@
+// Package ical writes and reads the VEVENT components of iCalendar (RFC 5545) files.
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType - media type of iCalendar files.
+const ContentType = "text/calendar; charset=utf-8"
+
+// Formats of DATE and DATE-TIME values.
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405"
+	utcFormat      = "20060102T150405Z"
+)
+
+// maxLineOctets - content lines are folded after this many octets, not counting the line break.
+const maxLineOctets = 75
+
+// ErrNoCalendar - input has no VCALENDAR.
+var ErrNoCalendar = errors.New("ical: no VCALENDAR")
+
+// Event - VEVENT of a calendar. Times in UTC are written as such, others with the TZID of their
+// location. AllDay events start at the date of Start. Events with RecurrenceID replace that
+// occurrence of the recurring event of the same UID.
+type Event struct {
+	UID          string
+	Summary      string
+	Description  string
+	Location     string
+	Start        time.Time
+	AllDay       bool
+	RRule        string
+	ExDates      []time.Time
+	RecurrenceID time.Time
+	Sequence     int
+	Created      time.Time
+	LastModified time.Time
+}
+
+// Calendar - VCALENDAR with its events. Name is shown by clients subscribing to the calendar.
+type Calendar struct {
+	ProdID string
+	Name   string
+	Events []*Event
+}
+
+// Write - calendar as iCalendar file. Stamp is the DTSTAMP of all events, the time the file was
+// generated.
+func (cal *Calendar) Write(w io.Writer, stamp time.Time) error {
+	b := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeLine(b, name+":"+value)
+	}
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", cal.ProdID)
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		line("X-WR-CALNAME", escapeText(cal.Name))
+	}
+	for _, event := range cal.Events {
+		line("BEGIN", "VEVENT")
+		line("UID", event.UID)
+		line("DTSTAMP", stamp.UTC().Format(utcFormat))
+		if event.AllDay {
+			line("DTSTART;VALUE=DATE", event.Start.Format(dateFormat))
+		} else {
+			writeLine(b, formatTime("DTSTART", event.Start))
+		}
+		if !event.RecurrenceID.IsZero() {
+			writeLine(b, formatTime("RECURRENCE-ID", event.RecurrenceID))
+		}
+		if event.RRule != "" {
+			line("RRULE", event.RRule)
+		}
+		for _, exDate := range event.ExDates {
+			writeLine(b, formatTime("EXDATE", exDate))
+		}
+		line("SEQUENCE", strconv.Itoa(event.Sequence))
+		line("SUMMARY", escapeText(event.Summary))
+		if event.Description != "" {
+			line("DESCRIPTION", escapeText(event.Description))
+		}
+		if event.Location != "" {
+			line("LOCATION", escapeText(event.Location))
+		}
+		if !event.Created.IsZero() {
+			line("CREATED", event.Created.UTC().Format(utcFormat))
+		}
+		if !event.LastModified.IsZero() {
+			line("LAST-MODIFIED", event.LastModified.UTC().Format(utcFormat))
+		}
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+	return b.Flush()
+}
+
+// formatTime - content line of property name with DATE-TIME value t, in UTC or with TZID.
+func formatTime(name string, t time.Time) string {
+	if t.Location() == time.UTC {
+		return name + ":" + t.Format(utcFormat)
+	}
+	return name + ";TZID=" + t.Location().String() + ":" + t.Format(dateTimeFormat)
+}
+
+// writeLine - content line ended by CRLF, folded into lines of at most maxLineOctets octets without
+// splitting UTF-8 sequences.
+func writeLine(b *bufio.Writer, content string) {
+	limit := maxLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		b.WriteString(content[:cut])
+		b.WriteString("\r\n ")
+		content = content[cut:]
+		limit = maxLineOctets - 1 // the space starting the continuation line
+	}
+	b.WriteString(content)
+	b.WriteString("\r\n")
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(text string) string {
+	return textEscaper.Replace(text)
+}
+
+func unescapeText(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] != '\\' || i+1 == len(text) {
+			b.WriteByte(text[i])
+			continue
+		}
+		i++
+		switch text[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(text[i])
+		}
+	}
+	return b.String()
+}
+
+// property - content line split into name, parameters and value.
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse - calendar of iCalendar file r. Times with TZID are read in that zone, floating times in the
+// zone of X-WR-TIMEZONE or UTC. Components other than VEVENT are skipped, events need a DTSTART.
+func Parse(r io.Reader) (*Calendar, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		cal     *Calendar
+		event   *Event
+		times   []*eventTimes // of events, read once X-WR-TIMEZONE is known
+		nesting []string
+	)
+	floating := time.UTC
+	for n, line := range lines {
+		prop, ok := parseProperty(line)
+		if !ok {
+			return nil, fmt.Errorf("ical: line %d: invalid content line", n+1)
+		}
+		switch {
+		case prop.name == "BEGIN":
+			component := strings.ToUpper(prop.value)
+			if component == "VCALENDAR" && cal == nil {
+				cal = &Calendar{}
+			} else if cal == nil {
+				return nil, ErrNoCalendar
+			} else if component == "VEVENT" && len(nesting) == 1 {
+				event = &Event{}
+				times = append(times, &eventTimes{})
+			}
+			nesting = append(nesting, component)
+		case prop.name == "END":
+			if len(nesting) == 0 || nesting[len(nesting)-1] != strings.ToUpper(prop.value) {
+				return nil, fmt.Errorf("ical: line %d: unexpected END:%s", n+1, prop.value)
+			}
+			nesting = nesting[:len(nesting)-1]
+			if event != nil && len(nesting) == 1 {
+				if times[len(times)-1].start.value == "" {
+					return nil, fmt.Errorf("ical: line %d: event without DTSTART", n+1)
+				}
+				cal.Events = append(cal.Events, event)
+				event = nil
+			}
+		case len(nesting) == 1:
+			switch prop.name {
+			case "PRODID":
+				cal.ProdID = prop.value
+			case "X-WR-CALNAME":
+				cal.Name = unescapeText(prop.value)
+			case "X-WR-TIMEZONE":
+				if floating, err = time.LoadLocation(prop.value); err != nil {
+					return nil, fmt.Errorf("ical: line %d: unknown time zone %s", n+1, prop.value)
+				}
+			}
+		case event != nil && len(nesting) == 2:
+			if err := event.set(prop, times[len(times)-1]); err != nil {
+				return nil, fmt.Errorf("ical: line %d: %v", n+1, err)
+			}
+		}
+	}
+	if cal == nil {
+		return nil, ErrNoCalendar
+	}
+	if len(nesting) != 0 {
+		return nil, errors.New("ical: unexpected end of calendar")
+	}
+	for i, event := range cal.Events {
+		if err := times[i].resolve(event, floating); err != nil {
+			return nil, fmt.Errorf("ical: %s: %v", event.UID, err)
+		}
+	}
+	return cal, nil
+}
+
+// eventTimes - properties of an event with times that may be floating.
+type eventTimes struct {
+	start, recurrenceID property
+	exDates             []property
+}
+
+func (times *eventTimes) resolve(event *Event, floating *time.Location) (err error) {
+	if event.Start, event.AllDay, err = parseTime(times.start, floating); err != nil {
+		return fmt.Errorf("DTSTART: %v", err)
+	}
+	if times.recurrenceID.value != "" {
+		if event.RecurrenceID, _, err = parseTime(times.recurrenceID, floating); err != nil {
+			return fmt.Errorf("RECURRENCE-ID: %v", err)
+		}
+	}
+	for _, prop := range times.exDates {
+		for _, value := range strings.Split(prop.value, ",") {
+			prop.value = value
+			exDate, _, err := parseTime(prop, floating)
+			if err != nil {
+				return fmt.Errorf("EXDATE: %v", err)
+			}
+			event.ExDates = append(event.ExDates, exDate)
+		}
+	}
+	return nil
+}
+
+func (event *Event) set(prop property, times *eventTimes) (err error) {
+	switch prop.name {
+	case "UID":
+		event.UID = prop.value
+	case "SUMMARY":
+		event.Summary = unescapeText(prop.value)
+	case "DESCRIPTION":
+		event.Description = unescapeText(prop.value)
+	case "LOCATION":
+		event.Location = unescapeText(prop.value)
+	case "DTSTART":
+		times.start = prop
+	case "RECURRENCE-ID":
+		times.recurrenceID = prop
+	case "EXDATE":
+		times.exDates = append(times.exDates, prop)
+	case "RRULE":
+		event.RRule = prop.value
+	case "SEQUENCE":
+		if event.Sequence, err = strconv.Atoi(prop.value); err != nil {
+			return fmt.Errorf("invalid SEQUENCE %s", prop.value)
+		}
+	case "CREATED":
+		event.Created, _, err = parseTime(prop, time.UTC)
+	case "LAST-MODIFIED":
+		event.LastModified, _, err = parseTime(prop, time.UTC)
+	}
+	return err
+}
+
+// parseTime - DATE or DATE-TIME value of prop, true for dates.
+func parseTime(prop property, floating *time.Location) (time.Time, bool, error) {
+	if prop.params["VALUE"] == "DATE" || len(prop.value) == len(dateFormat) {
+		t, err := time.ParseInLocation(dateFormat, prop.value, time.UTC)
+		return t, true, err
+	}
+	if strings.HasSuffix(prop.value, "Z") {
+		t, err := time.Parse(utcFormat, prop.value)
+		return t, false, err
+	}
+	location := floating
+	if tzid := prop.params["TZID"]; tzid != "" {
+		var err error
+		if location, err = time.LoadLocation(strings.TrimPrefix(tzid, "/")); err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown time zone %s", tzid)
+		}
+	}
+	t, err := time.ParseInLocation(dateTimeFormat, prop.value, location)
+	return t, false, err
+}
+
+// unfold - content lines of r with folded lines joined, empty lines dropped.
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), 1<<20)
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+		} else if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// parseProperty - name, parameters and value of content line, quoted parameter values may contain
+// ':', ';' and ','.
+func parseProperty(line string) (property, bool) {
+	prop := property{params: map[string]string{}}
+	end := strings.IndexAny(line, ";:")
+	if end <= 0 {
+		return prop, false
+	}
+	prop.name = strings.ToUpper(line[:end])
+	rest := line[end:]
+	for rest != "" && rest[0] == ';' {
+		eq := strings.IndexByte(rest, '=')
+		if eq < 0 {
+			return prop, false
+		}
+		name := strings.ToUpper(rest[1:eq])
+		rest = rest[eq+1:]
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			closing := strings.IndexByte(rest[1:], '"')
+			if closing < 0 {
+				return prop, false
+			}
+			value, rest = rest[1:closing+1], rest[closing+2:]
+		} else {
+			stop := strings.IndexAny(rest, ";:")
+			if stop < 0 {
+				return prop, false
+			}
+			value, rest = rest[:stop], rest[stop:]
+		}
+		prop.params[name] = value
+	}
+	if rest == "" || rest[0] != ':' {
+		return prop, false
+	}
+	prop.value = rest[1:]
+	prop.params["VALUE"] = strings.ToUpper(prop.params["VALUE"])
+	return prop, true
+}
@@ -0,0 +1,143 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteAndParse(t *testing.T) {
+	start := time.Date(2026, 3, 14, 9, 30, 0, 0, time.FixedZone("EAT", 3*60*60))
+	cal := &Calendar{ProdID: "-//Nyota//Events//EN", Name: "Events of admin", Events: []*Event{{
+		UID:         "event-1@nyota",
+		Summary:     "Launch; party, again",
+		Description: "Doors open at 9\nBring the badge " + strings.Repeat("ñ", 60),
+		Location:    "Hall 2",
+		Start:       start.UTC(),
+		Sequence:    42,
+		Created:     start.Add(-time.Hour),
+	}}}
+	var b bytes.Buffer
+	if err := cal.Write(&b, start); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	text := b.String()
+	for _, expected := range []string{"DTSTART:20260314T063000Z\r\n", "SEQUENCE:42\r\n", `SUMMARY:Launch\; party\, again`} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("Expected %q in\n%s", expected, text)
+		}
+	}
+	for _, line := range strings.Split(text, "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("Expected lines folded at %d octets, got %d: %q", maxLineOctets, len(line), line)
+		}
+	}
+
+	parsed, err := Parse(&b)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if parsed.Name != cal.Name || len(parsed.Events) != 1 {
+		t.Fatalf("Expected calendar with one event, got %+v", parsed)
+	}
+	event := parsed.Events[0]
+	if event.UID != "event-1@nyota" || event.Summary != cal.Events[0].Summary || event.Description != cal.Events[0].Description ||
+		event.Location != "Hall 2" || !event.Start.Equal(start) || event.Sequence != 42 || !event.Created.Equal(start.Add(-time.Hour)) {
+		t.Errorf("Expected event to survive round trip, got %+v", event)
+	}
+}
+
+func TestWriteAndParseRecurring(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := time.Date(2026, 3, 23, 10, 0, 0, 0, berlin)
+	cal := &Calendar{ProdID: "-//Nyota//Events//EN", Events: []*Event{
+		{UID: "event-1@nyota", Summary: "Standup", Start: start, RRule: "FREQ=WEEKLY;COUNT=4", ExDates: []time.Time{start.AddDate(0, 0, 7)}},
+		{UID: "event-1@nyota", Summary: "Standup", Start: start.AddDate(0, 0, 15), RecurrenceID: start.AddDate(0, 0, 14)},
+	}}
+	var b bytes.Buffer
+	cal.Write(&b, start)
+	for _, expected := range []string{"DTSTART;TZID=Europe/Berlin:20260323T100000\r\n", "EXDATE;TZID=Europe/Berlin:20260330T100000\r\n",
+		"RECURRENCE-ID;TZID=Europe/Berlin:20260406T100000\r\n", "RRULE:FREQ=WEEKLY;COUNT=4\r\n"} {
+		if !strings.Contains(b.String(), expected) {
+			t.Errorf("Expected %q in\n%s", expected, b.String())
+		}
+	}
+	parsed, err := Parse(&b)
+	if err != nil || len(parsed.Events) != 2 {
+		t.Fatalf("Expected two events, got %v %v", parsed, err)
+	}
+	master, moved := parsed.Events[0], parsed.Events[1]
+	if !master.Start.Equal(start) || master.RRule != "FREQ=WEEKLY;COUNT=4" || len(master.ExDates) != 1 || !master.ExDates[0].Equal(start.AddDate(0, 0, 7)) {
+		t.Errorf("Unexpected recurring event %+v", master)
+	}
+	if !moved.RecurrenceID.Equal(start.AddDate(0, 0, 14)) || !moved.Start.Equal(start.AddDate(0, 0, 15)) {
+		t.Errorf("Unexpected moved occurrence %+v", moved)
+	}
+}
+
+func TestParseTimeZones(t *testing.T) {
+	input := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"X-WR-TIMEZONE:Africa/Nairobi",
+		"BEGIN:VTIMEZONE",
+		"TZID:Europe/Berlin",
+		"BEGIN:STANDARD",
+		"DTSTART:19701025T030000",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:zoned",
+		`DTSTART;TZID="Europe/Berlin":20260701T100000`,
+		"BEGIN:VALARM",
+		"SUMMARY:not the event",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:floating",
+		"DTSTART:20260701T100000",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:all-day",
+		"SUMMARY:Holi",
+		" day",
+		"DTSTART;VALUE=DATE:20260704",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+	cal, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(cal.Events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(cal.Events))
+	}
+	expected := []time.Time{
+		time.Date(2026, 7, 1, 8, 0, 0, 0, time.UTC),
+		time.Date(2026, 7, 1, 7, 0, 0, 0, time.UTC),
+		time.Date(2026, 7, 4, 0, 0, 0, 0, time.UTC),
+	}
+	for i, event := range cal.Events {
+		if !event.Start.Equal(expected[i]) {
+			t.Errorf("Expected %s to start %s, got %s", event.UID, expected[i], event.Start.UTC())
+		}
+	}
+	if cal.Events[0].Summary != "" || !cal.Events[2].AllDay || cal.Events[2].Summary != "Holiday" {
+		t.Errorf("Expected alarm skipped and all day event unfolded, got %+v %+v", cal.Events[0], cal.Events[2])
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"BEGIN:VEVENT\r\nEND:VEVENT",
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:x\r\nEND:VEVENT\r\nEND:VCALENDAR",
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;TZID=Mars/Olympus:20260101T000000\r\nEND:VEVENT\r\nEND:VCALENDAR",
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART:20260101T000000\r\nEND:VCALENDAR",
+		"BEGIN:VCALENDAR\r\nno property\r\nEND:VCALENDAR",
+	} {
+		if _, err := Parse(strings.NewReader(input)); err == nil {
+			t.Errorf("Expected %q to be refused", input)
+		}
+	}
+}
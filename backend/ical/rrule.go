@@ -0,0 +1,344 @@
+package ical
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequencies of recurrence rules, rules repeating within a day are not supported.
+const (
+	Daily   = "DAILY"
+	Weekly  = "WEEKLY"
+	Monthly = "MONTHLY"
+	Yearly  = "YEARLY"
+)
+
+var weekdays = map[string]time.Weekday{"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday,
+	"WE": time.Wednesday, "TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday}
+
+// WeekdayNum - weekday of BYDAY, N is the nth (from the end when negative) such day of the month or
+// year, 0 for every one.
+type WeekdayNum struct {
+	N   int
+	Day time.Weekday
+}
+
+// Recurrence - RRULE of RFC 5545 with the parts FREQ, INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY,
+// BYMONTH and WKST.
+type Recurrence struct {
+	Freq       string
+	Interval   int
+	Count      int       // occurrences including the first, 0 for no limit
+	Until      time.Time // last possible start, zero for no limit
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []int
+	WeekStart  time.Weekday
+}
+
+// ParseRecurrence - recurrence of RRULE value rule, "RRULE:" is optional. Rules with parts that
+// are not supported are refused rather than expanded differently.
+func ParseRecurrence(rule string) (*Recurrence, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	r := &Recurrence{Interval: 1, WeekStart: time.Monday}
+	for _, part := range strings.Split(rule, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("ical: invalid RRULE part %q", part)
+		}
+		name, value := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		var err error
+		switch name {
+		case "FREQ":
+			r.Freq = value
+		case "INTERVAL":
+			r.Interval, err = positive(value, 1<<16)
+		case "COUNT":
+			r.Count, err = positive(value, 1<<16)
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(value, 31, true)
+		case "BYMONTH":
+			r.ByMonth, err = parseInts(value, 12, false)
+		case "WKST":
+			day, ok := weekdays[value]
+			if !ok {
+				err = fmt.Errorf("invalid weekday %s", value)
+			}
+			r.WeekStart = day
+		default:
+			err = fmt.Errorf("%s is not supported", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ical: RRULE %s: %v", name, err)
+		}
+	}
+	switch r.Freq {
+	case Daily, Weekly, Monthly, Yearly:
+	case "":
+		return nil, fmt.Errorf("ical: RRULE without FREQ")
+	default:
+		return nil, fmt.Errorf("ical: RRULE FREQ %s is not supported", r.Freq)
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, fmt.Errorf("ical: RRULE with COUNT and UNTIL")
+	}
+	if r.Freq == Weekly && len(r.ByMonthDay) > 0 {
+		return nil, fmt.Errorf("ical: RRULE BYMONTHDAY with FREQ WEEKLY")
+	}
+	for _, day := range r.ByDay {
+		if day.N != 0 && (r.Freq == Daily || r.Freq == Weekly) {
+			return nil, fmt.Errorf("ical: RRULE BYDAY %d%v with FREQ %s", day.N, day.Day, r.Freq)
+		}
+	}
+	return r, nil
+}
+
+func positive(value string, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 || n > max {
+		return 0, fmt.Errorf("invalid number %s", value)
+	}
+	return n, nil
+}
+
+// parseUntil - UNTIL as UTC time, dates include the whole day.
+func parseUntil(value string) (time.Time, error) {
+	if len(value) == len(dateFormat) {
+		t, err := time.Parse(dateFormat, value)
+		return t.Add(24*time.Hour - time.Second), err
+	}
+	return time.Parse(utcFormat, value)
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid weekday %s", item)
+		}
+		day, ok := weekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %s", item)
+		}
+		n := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			if n, err = strconv.Atoi(prefix); err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("invalid weekday %s", item)
+			}
+		}
+		days = append(days, WeekdayNum{N: n, Day: day})
+	}
+	return days, nil
+}
+
+func parseInts(value string, max int, negative bool) ([]int, error) {
+	var list []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n == 0 || n > max || n < -max || (n < 0 && !negative) {
+			return nil, fmt.Errorf("invalid number %s", item)
+		}
+		list = append(list, n)
+	}
+	return list, nil
+}
+
+// String - rule as RRULE value.
+func (r *Recurrence) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(utcFormat))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = weekdayName(day.Day)
+			if day.N != 0 {
+				days[i] = strconv.Itoa(day.N) + days[i]
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByMonth) > 0 {
+		parts = append(parts, "BYMONTH="+joinInts(r.ByMonth))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayName(r.WeekStart))
+	}
+	return strings.Join(parts, ";")
+}
+
+func weekdayName(day time.Weekday) string {
+	return strings.ToUpper(day.String()[:2])
+}
+
+func joinInts(list []int) string {
+	items := make([]string, len(list))
+	for i, n := range list {
+		items[i] = strconv.Itoa(n)
+	}
+	return strings.Join(items, ",")
+}
+
+// Between - starts of the occurrences of the rule for first occurrence start in [from, to), at most
+// limit. Start is always the first occurrence and counts for COUNT. Occurrences keep the time of day
+// of start in its location, so they follow daylight saving time of it.
+func (r *Recurrence) Between(start, from, to time.Time, limit int) []time.Time {
+	var occurrences []time.Time
+	count := 0
+	// emit - counts occurrence t, false when there are no more in range
+	emit := func(t time.Time) bool {
+		if (r.Count > 0 && count >= r.Count) || (!r.Until.IsZero() && t.After(r.Until)) || !t.Before(to) {
+			return false
+		}
+		count++
+		if !t.Before(from) {
+			occurrences = append(occurrences, t)
+		}
+		return len(occurrences) < limit
+	}
+	if !emit(start) {
+		return occurrences
+	}
+
+	year, month, day := start.Date()
+	hour, min, sec := start.Clock()
+	first := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	at := func(date time.Time) time.Time {
+		return time.Date(date.Year(), date.Month(), date.Day(), hour, min, sec, start.Nanosecond(), start.Location())
+	}
+	for n := 0; ; n++ {
+		periodStart, dates := r.period(first, n)
+		if begin := at(periodStart); !begin.Before(to) || (!r.Until.IsZero() && begin.After(r.Until)) {
+			return occurrences
+		}
+		for _, date := range dates {
+			if t := at(date); t.After(start) && !emit(t) {
+				return occurrences
+			}
+		}
+	}
+}
+
+// period - first day and the days of the nth period of the rule for first occurrence on first, in
+// order. Days are dates at midnight UTC.
+func (r *Recurrence) period(first time.Time, n int) (time.Time, []time.Time) {
+	var begin, end time.Time
+	switch r.Freq {
+	case Daily:
+		begin = first.AddDate(0, 0, n*r.Interval)
+		end = begin.AddDate(0, 0, 1)
+	case Weekly:
+		offset := (int(first.Weekday()) - int(r.WeekStart) + 7) % 7
+		begin = first.AddDate(0, 0, 7*n*r.Interval-offset)
+		end = begin.AddDate(0, 0, 7)
+	case Monthly:
+		begin = time.Date(first.Year(), first.Month()+time.Month(n*r.Interval), 1, 0, 0, 0, 0, time.UTC)
+		end = begin.AddDate(0, 1, 0)
+	default:
+		begin = time.Date(first.Year()+n*r.Interval, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end = begin.AddDate(1, 0, 0)
+	}
+	var dates []time.Time
+	for date := begin; date.Before(end); date = date.AddDate(0, 0, 1) {
+		if r.matches(first, date) {
+			dates = append(dates, date)
+		}
+	}
+	return begin, dates
+}
+
+// matches - date is a day of the rule for first occurrence on first.
+func (r *Recurrence) matches(first, date time.Time) bool {
+	if len(r.ByMonth) > 0 && !containsInt(r.ByMonth, int(date.Month())) {
+		return false
+	}
+	monthStart := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, -1)
+	if len(r.ByMonthDay) > 0 && !r.matchesMonthDay(date, monthEnd.Day()) {
+		return false
+	}
+	if len(r.ByDay) > 0 {
+		// Ordinals count within the month, within the year for yearly rules without BYMONTH.
+		scopeStart, scopeEnd := monthStart, monthEnd
+		if r.Freq == Yearly && len(r.ByMonth) == 0 {
+			scopeStart = time.Date(date.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+			scopeEnd = time.Date(date.Year(), time.December, 31, 0, 0, 0, 0, time.UTC)
+		}
+		return r.matchesWeekday(date, scopeStart, scopeEnd)
+	}
+	if len(r.ByMonthDay) > 0 {
+		return true
+	}
+	// Without BYDAY and BYMONTHDAY rules repeat the weekday, day of month or day of year of first.
+	switch r.Freq {
+	case Weekly:
+		return date.Weekday() == first.Weekday()
+	case Monthly:
+		return date.Day() == first.Day()
+	case Yearly:
+		return date.Day() == first.Day() && (len(r.ByMonth) > 0 || date.Month() == first.Month())
+	}
+	return true
+}
+
+func (r *Recurrence) matchesMonthDay(date time.Time, lastDay int) bool {
+	for _, day := range r.ByMonthDay {
+		if day == date.Day() || (day < 0 && lastDay+day+1 == date.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Recurrence) matchesWeekday(date, scopeStart, scopeEnd time.Time) bool {
+	for _, day := range r.ByDay {
+		if day.Day != date.Weekday() {
+			continue
+		}
+		switch {
+		case day.N == 0:
+			return true
+		case day.N > 0 && day.N == daysBetween(scopeStart, date)/7+1:
+			return true
+		case day.N < 0 && -day.N == daysBetween(date, scopeEnd)/7+1:
+			return true
+		}
+	}
+	return false
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func containsInt(list []int, n int) bool {
+	for _, item := range list {
+		if item == n {
+			return true
+		}
+	}
+	return false
+}
+
+// Occurs - t is an occurrence of the rule for first occurrence start.
+func (r *Recurrence) Occurs(start, t time.Time) bool {
+	occurrences := r.Between(start, t, t.Add(time.Nanosecond), 1)
+	return len(occurrences) == 1 && occurrences[0].Equal(t)
+}
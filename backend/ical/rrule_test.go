@@ -0,0 +1,80 @@
+package ical
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecurrenceBetween(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	tests := []struct {
+		rule     string
+		start    time.Time
+		from, to time.Time
+		expected []string // dates in the location of start
+	}{
+		{"FREQ=DAILY;COUNT=3", time.Date(2026, 1, 30, 9, 0, 0, 0, time.UTC), time.Time{}, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{"2026-01-30", "2026-01-31", "2026-02-01"}},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;UNTIL=20260420", time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC), time.Time{}, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{"2026-04-01", "2026-04-13", "2026-04-15"}},
+		{"FREQ=MONTHLY;BYMONTHDAY=31", time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC), time.Time{}, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+			[]string{"2026-01-31", "2026-03-31", "2026-05-31"}},
+		{"FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", time.Date(2026, 1, 30, 9, 0, 0, 0, time.UTC), time.Time{}, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{"2026-01-30", "2026-02-27", "2026-03-27"}},
+		{"FREQ=MONTHLY;BYDAY=2TU", time.Date(2026, 1, 13, 9, 0, 0, 0, time.UTC), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+			[]string{"2026-03-10", "2026-04-14"}},
+		{"FREQ=YEARLY", time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC), time.Time{}, time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{"2024-02-29", "2028-02-29"}},
+		{"FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;COUNT=2", time.Date(2026, 11, 26, 9, 0, 0, 0, time.UTC), time.Time{}, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{"2026-11-26", "2027-11-25"}},
+		{"FREQ=WEEKLY", time.Date(2026, 3, 23, 10, 0, 0, 0, berlin), time.Time{}, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			[]string{"2026-03-23", "2026-03-30"}},
+	}
+	for _, test := range tests {
+		rule, err := ParseRecurrence(test.rule)
+		if err != nil {
+			t.Fatalf("ParseRecurrence(%s) failed: %v", test.rule, err)
+		}
+		occurrences := rule.Between(test.start, test.from, test.to, 100)
+		var dates []string
+		for _, occurrence := range occurrences {
+			if hour, min, _ := occurrence.In(test.start.Location()).Clock(); hour != test.start.Hour() || min != test.start.Minute() {
+				t.Errorf("%s: expected time of day of start, got %s", test.rule, occurrence)
+			}
+			dates = append(dates, occurrence.In(test.start.Location()).Format("2006-01-02"))
+		}
+		if len(dates) != len(test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.rule, test.expected, dates)
+			continue
+		}
+		for i := range dates {
+			if dates[i] != test.expected[i] {
+				t.Errorf("%s: expected %v, got %v", test.rule, test.expected, dates)
+				break
+			}
+		}
+	}
+}
+
+func TestRecurrenceOccurs(t *testing.T) {
+	rule, _ := ParseRecurrence("RRULE:FREQ=WEEKLY;BYDAY=TU,TH")
+	start := time.Date(2026, 6, 2, 18, 30, 0, 0, time.UTC)
+	if !rule.Occurs(start, start) || !rule.Occurs(start, start.AddDate(0, 0, 9)) {
+		t.Errorf("Expected start and a later Thursday to occur")
+	}
+	if rule.Occurs(start, start.AddDate(0, 0, 1)) || rule.Occurs(start, start.Add(time.Hour)) || rule.Occurs(start, start.AddDate(0, 0, -5)) {
+		t.Errorf("Expected other days, times and days before start not to occur")
+	}
+	if rule.String() != "FREQ=WEEKLY;BYDAY=TU,TH" {
+		t.Errorf("Unexpected rule %s", rule.String())
+	}
+}
+
+func TestParseRecurrenceInvalid(t *testing.T) {
+	for _, rule := range []string{"", "FREQ=HOURLY", "INTERVAL=2", "FREQ=DAILY;COUNT=0", "FREQ=DAILY;COUNT=2;UNTIL=20260101",
+		"FREQ=WEEKLY;BYDAY=1MO", "FREQ=WEEKLY;BYMONTHDAY=1", "FREQ=MONTHLY;BYSETPOS=1", "FREQ=MONTHLY;BYMONTHDAY=32", "FREQ=YEARLY;BYMONTH=-1"} {
+		if _, err := ParseRecurrence(rule); err == nil {
+			t.Errorf("Expected %q to be refused", rule)
+		}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"nyota/backend/api"
 	"nyota/backend/logutil"
+	"nyota/backend/store"
+	"os"
 	"strconv"
 )
 
@@ -19,10 +21,34 @@ func init() {
 	if port > 1024 && port <= 65535 {
 		adminPort = ":" + strconv.Itoa(port)
 	}
-	logutil.Printf(nil, "Nyota Backend Service started on port%s", adminPort)
 }
 
 func main() {
+	// Subcommand: main migrate up|down [steps]|status
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		migrate(os.Args[2:])
+		return
+	}
+
+	logutil.Printf(nil, "Nyota Backend Service started on port%s", adminPort)
 	router := api.NewRoute()
 	log.Fatalf("Error starting Nyota Backend Service (%s)", http.ListenAndServe(adminPort, router))
 }
+
+func migrate(args []string) {
+	command, steps := "up", 1
+	if len(args) > 0 {
+		command = args[0]
+	}
+	if len(args) > 1 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			log.Fatalf("Invalid migration steps %q", args[1])
+		}
+		steps = n
+	}
+	if err := store.RunMigrations(command, steps, os.Stdout); err != nil {
+		log.Fatalf("Migration %s failed: %v", command, err)
+	}
+	logutil.Printf(nil, "Migration %s completed", command)
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	v "github.com/go-ozzo/ozzo-validation"
+)
+
+// AdminRole - custom role of tenant users are assigned to, granting a permission (READ, MODIFY or
+// BLOCK) per route group. Built in roles are defined in code and have BuiltIn set.
+type AdminRole struct {
+	TenantID    string            `db:"tenant_id" json:"tenant_id"`
+	Name        string            `db:"name" json:"name"`
+	Description string            `db:"description" json:"description"`
+	Permissions map[string]string `db:"permissions" json:"permissions"`
+	BuiltIn     bool              `db:"-" json:"built_in"`
+	AddedAt     time.Time         `db:"added_at" json:"added_at"`
+	UpdatedAt   time.Time         `db:"updated_at" json:"updated_at"`
+	AddedBy     string            `db:"added_by" json:"added_by"`
+	UpdatedBy   string            `db:"updated_by" json:"updated_by"`
+}
+
+// Audit - Audit message for entity
+func (role *AdminRole) Audit() string {
+	data, _ := json.Marshal(role)
+	return string(data)
+}
+
+// Validate - Validate fields
+func (role *AdminRole) Validate() error {
+	role.Name = strings.TrimSpace(role.Name)
+	return v.ValidateStruct(role,
+		v.Field(&role.Name, v.Required.Error("key_name_required"), v.Length(1, 64).Error("key_name_length")),
+		v.Field(&role.Description, v.Length(0, 255).Error("key_description_length")),
+		v.Field(&role.Permissions, v.Required.Error("key_permissions_required")))
+}
+
+// SetData - Tenant id, roles are always managed in the tenant of logged in user.
+func (role *AdminRole) SetData(id string, tenantID string, userName string) {
+	role.TenantID = tenantID
+}
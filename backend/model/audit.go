@@ -0,0 +1,69 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Audit log actions, from the method of the request. Lock is written for login lockouts.
+const (
+	AuditActionAdd    = "Add"
+	AuditActionUpdate = "Update"
+	AuditActionDelete = "Delete"
+	AuditActionLock   = "Lock"
+)
+
+// AuditLog - entry of the audit trail, written for every successful mutating request.
+type AuditLog struct {
+	ID         int       `db:"id" json:"id"`
+	TenantID   string    `db:"tenant_id" json:"tenant_id"`
+	UserName   string    `db:"user_name" json:"user_name"`
+	Entity     string    `db:"entity" json:"entity"`
+	EntityID   string    `db:"entity_id" json:"entity_id"`
+	Action     string    `db:"action" json:"action"`
+	OldData    AuditData `db:"old_data" json:"old_data"` // entity before the change, empty for adds
+	NewData    AuditData `db:"new_data" json:"new_data"` // entity after the change, empty for deletes
+	RequestID  string    `db:"request_id" json:"request_id"`
+	RemoteAddr string    `db:"remote_addr" json:"remote_addr"`
+	AddedAt    time.Time `db:"added_at" json:"added_at"`
+}
+
+// AuditData - JSON of entity from Context.Audit(), served as JSON object or null when empty.
+type AuditData string
+
+// MarshalJSON - data as raw JSON.
+func (data AuditData) MarshalJSON() ([]byte, error) {
+	if data == "" || !json.Valid([]byte(data)) {
+		return []byte("null"), nil
+	}
+	return []byte(data), nil
+}
+
+// UnmarshalJSON - raw JSON as data, null as empty.
+func (data *AuditData) UnmarshalJSON(raw []byte) error {
+	if string(raw) == "null" {
+		*data = ""
+		return nil
+	}
+	*data = AuditData(raw)
+	return nil
+}
+
+// AuditFilter - conditions of an audit log query, zero values do not filter.
+type AuditFilter struct {
+	UserName string
+	Entity   string
+	EntityID string
+	Action   string
+	From, To time.Time // added_at in [From, To)
+	Page     int       // 1 based
+	PageSize int
+}
+
+// AuditLogPage - page of audit log entries matching a filter, newest first.
+type AuditLogPage struct {
+	Items    []*AuditLog `json:"items"`
+	Total    int         `json:"total"`
+	Page     int         `json:"page"`
+	PageSize int         `json:"page_size"`
+}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	v "github.com/go-ozzo/ozzo-validation"
+)
+
+// attendeeEmail - address with a local part and a domain.
+var attendeeEmail = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// Statuses of attendees, registrations beyond the capacity of the event wait in order of
+// registration until a place is free.
+const (
+	AttendeeRegistered = "registered"
+	AttendeeWaitlisted = "waitlisted"
+)
+
+// Attendee - registration of a person for an event. Ticket is the signed token of the ticket QR
+// code, it is derived from the ids and never stored. Scans counts every check-in of the ticket,
+// including refused duplicates.
+type Attendee struct {
+	ID           int        `db:"id" json:"id"`
+	EventID      int        `db:"event_id" json:"event_id"`
+	TenantID     string     `db:"tenant_id" json:"tenant_id"`
+	Name         string     `db:"name" json:"name"`
+	Email        string     `db:"email" json:"email"`
+	Occurrence   *time.Time `db:"occurrence" json:"occurrence"` // recurrence id, nil for single events
+	RegisteredAt time.Time  `db:"registered_at" json:"registered_at"`
+	RegisteredBy string     `db:"registered_by" json:"registered_by"`
+	Status       string     `db:"status" json:"status"`
+	PromotedAt   *time.Time `db:"promoted_at" json:"promoted_at"` // moved from the waitlist
+	CheckedInAt  *time.Time `db:"checked_in_at" json:"checked_in_at"`
+	CheckedInBy  string     `db:"checked_in_by" json:"checked_in_by"`
+	Scans        int        `db:"scans" json:"scans"`
+	Ticket       string     `db:"-" json:"ticket,omitempty"`
+}
+
+// EventAttendance - live counts of the attendees of an event, waitlisted ones are not registered.
+type EventAttendance struct {
+	Registered int `db:"registered" json:"registered"`
+	CheckedIn  int `db:"checked_in" json:"checked_in"`
+	Waitlisted int `db:"waitlisted" json:"waitlisted"`
+}
+
+// AttendeePromotion - notification of an attendee moved from the waitlist of an event.
+type AttendeePromotion struct {
+	Type     string    `json:"type"`
+	TenantID string    `json:"tenant_id"`
+	EventID  int       `json:"event_id"`
+	Attendee *Attendee `json:"attendee"`
+}
+
+// CheckIn - ticket scanned at the entrance of an event, at the occurrence of recurring events when
+// given.
+type CheckIn struct {
+	Ticket     string     `json:"ticket"`
+	Occurrence *time.Time `json:"occurrence"`
+}
+
+// Audit - Audit message for entity. Ticket is never part of audit data.
+func (attendee *Attendee) Audit() string {
+	auditAttendee := *attendee
+	auditAttendee.Ticket = ""
+	data, _ := json.Marshal(auditAttendee)
+	return string(data)
+}
+
+// Validate - Validate fields
+func (attendee *Attendee) Validate() error {
+	attendee.Name = strings.TrimSpace(attendee.Name)
+	attendee.Email = strings.ToLower(strings.TrimSpace(attendee.Email))
+	return v.ValidateStruct(attendee,
+		v.Field(&attendee.Name, v.Required.Error("key_name_required"), v.Length(1, 255).Error("key_name_length")),
+		v.Field(&attendee.Email, v.Required.Error("key_valid_email"), v.Length(1, 255).Error("key_valid_email"),
+			v.Match(attendeeEmail).Error("key_valid_email")))
+}
+
+// Count - adds attendee to the counts.
+func (attendance *EventAttendance) Count(attendee *Attendee) {
+	if attendee.Status == AttendeeWaitlisted {
+		attendance.Waitlisted++
+		return
+	}
+	attendance.Registered++
+	if attendee.CheckedInAt != nil {
+		attendance.CheckedIn++
+	}
+}
+
+// SetData - Id and tenant id, attendees are always registered in the tenant of logged in user.
+func (attendee *Attendee) SetData(id string, tenantID string, userName string) {
+	attendee.ID, _ = strconv.Atoi(id)
+	attendee.TenantID = tenantID
+}
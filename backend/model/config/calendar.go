@@ -0,0 +1,69 @@
+package config
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CalendarFeed - token of the calendar feed of a user, users have at most one. Only the hash of the
+// secret is stored, token and URL are returned when the feed is created.
+type CalendarFeed struct {
+	ID         string     `db:"id" json:"id"`
+	TenantID   string     `db:"tenant_id" json:"tenant_id"`
+	UserName   string     `db:"user_name" json:"user_name"`
+	Hash       string     `db:"hash" json:"-"`
+	AddedAt    time.Time  `db:"added_at" json:"added_at"`
+	LastUsedAt *time.Time `db:"last_used_at" json:"last_used_at"`
+	Token      string     `db:"-" json:"token,omitempty"`
+	URL        string     `db:"-" json:"url,omitempty"`
+}
+
+// Audit - Audit message for entity. Token and URL are never part of audit data.
+func (feed *CalendarFeed) Audit() string {
+	auditFeed := *feed
+	auditFeed.Token, auditFeed.URL = "", ""
+	data, _ := json.Marshal(auditFeed)
+	return string(data)
+}
+
+// Validate - Validate fields
+func (feed *CalendarFeed) Validate() error {
+	return nil
+}
+
+//SetData - Tenant and user of feed
+func (feed *CalendarFeed) SetData(id string, tenantID string, userName string) {
+	feed.TenantID = tenantID
+	feed.UserName = userName
+}
+
+// EventImport - events created from a calendar file.
+type EventImport struct {
+	Events []*Event `json:"events"`
+}
+
+// Audit - Audit message for entity
+func (events *EventImport) Audit() string {
+	data, _ := json.Marshal(events)
+	return string(data)
+}
+
+// Validate - Validate fields
+func (events *EventImport) Validate() error {
+	return nil
+}
+
+//SetData - not used, events get tenant and user when imported
+func (events *EventImport) SetData(id string, tenantID string, userName string) {
+}
+
+// IDs - comma separated ids of events.
+func (events *EventImport) IDs() string {
+	ids := make([]string, len(events.Events))
+	for i, event := range events.Events {
+		ids[i] = strconv.Itoa(event.ID)
+	}
+	return strings.Join(ids, ",")
+}
@@ -20,6 +20,9 @@ type Cluster struct {
 	CPPMNodes     []*CppmNode `db:"-" json:"cppm_nodes"`
 	AddedAt       time.Time   `db:"added_at" json:"added_at"`
 	UpdatedAt     time.Time   `db:"updated_at" json:"updated_at"`
+	AddedBy       string      `db:"added_by" json:"added_by"`
+	UpdatedBy     string      `db:"updated_by" json:"updated_by"`
+	Version       int         `db:"version" json:"version"`
 	AddedAtEpoc   int64       `db:"-" json:"added_at_epoc"`
 	UpdatedAtEpoc int64       `db:"-" json:"updated_at_epoc"`
 }
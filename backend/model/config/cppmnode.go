@@ -37,6 +37,9 @@ type CppmNode struct {
 	LastReplicationTimestamp time.Time `db:"last_replication_timestamp" json:"last_replication_timestamp"`
 	AddedAt                  time.Time `db:"added_at" json:"added_at"`
 	UpdatedAt                time.Time `db:"updated_at" json:"updated_at"`
+	AddedBy                  string    `db:"added_by" json:"added_by"`
+	UpdatedBy                string    `db:"updated_by" json:"updated_by"`
+	Version                  int       `db:"version" json:"version"`
 	AddedAtEpoc              int64     `db:"-" json:"added_at_epoc"`
 	UpdatedAtEpoc            int64     `db:"-" json:"updated_at_epoc"`
 }
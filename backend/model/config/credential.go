@@ -0,0 +1,13 @@
+package config
+
+import "time"
+
+// ClusterCredential - API key a CPPM cluster signs its callbacks with. Secret is returned
+// only in the response that creates the credential.
+type ClusterCredential struct {
+	KeyID     string    `db:"key_id" json:"key_id"`
+	ClusterID int       `db:"cluster_id" json:"cluster_id"`
+	TenantID  string    `db:"tenant_id" json:"tenant_id"`
+	Secret    string    `db:"secret" json:"secret,omitempty"`
+	AddedAt   time.Time `db:"added_at" json:"added_at"`
+}
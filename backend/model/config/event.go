@@ -2,8 +2,13 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
+	"nyota/backend/ical"
+	"nyota/backend/model"
 	"strconv"
 	"time"
+
+	v "github.com/go-ozzo/ozzo-validation"
 )
 
 // Role - CPPM Role
@@ -12,36 +17,116 @@ type Event struct {
 	Name          string                 `db:"name" json:"event_name"`
 	Description   string                 `db:"description" json:"event_description"`
 	EventDate     time.Time              `db:"event_date" json:"event_date"`
+	TimeZone      string                 `db:"time_zone" json:"time_zone"` // IANA zone occurrences keep the time of day in
+	RRule         string                 `db:"rrule" json:"rrule"`         // RFC 5545 recurrence rule, empty for single events
+	ExDates       []time.Time            `db:"exdates" json:"exdates"`     // starts of occurrences taken out of the rule
+	Capacity      int                    `db:"capacity" json:"capacity"` // attendees per occurrence, 0 for no limit
 	Detail        map[string]interface{} `db:"detail" json:"event_detail"`
 	UserName      string                 `db:"username" json:"username"`
 	TenantID      string                 `db:"tenant_id" json:"tenant_id"`
+	Clusters      []*Cluster             `db:"-" json:"clusters"`
 	AddedAt       time.Time              `db:"added_at" json:"added_at"`
 	UpdatedAt     time.Time              `db:"updated_at" json:"updated_at"`
+	AddedBy       string                 `db:"added_by" json:"added_by"`
+	UpdatedBy     string                 `db:"updated_by" json:"updated_by"`
+	Version       int                    `db:"version" json:"version"`
+	TicketKey     string                 `db:"ticket_key" json:"-"` // signs the tickets of attendees
+	Attendance    *EventAttendance       `db:"-" json:"attendance,omitempty"`
+	Occurrences   []*EventOccurrence     `db:"-" json:"occurrences,omitempty"`
 	AddedAtEpoc   int64                  `db:"-" json:"added_at_epoc"`
 	UpdatedAtEpoc int64                  `db:"-" json:"updated_at_epoc"`
 }
 
+// EventList - page of events with the grid columns they can be filtered and sorted on.
 type EventList struct {
-	Events []*Event `json:"events"`
+	Events    []*Event           `json:"events"`
+	Structure []model.GridColumn `json:"structure"`
+	Total     int                `json:"total"`
+	Page      int                `json:"page"`
+	PageSize  int                `json:"page_size"`
 }
 
-// UserEvent - CPPM Role vs Cluster details
-type UserEvent struct {
+// EventCluster - Event vs Cluster details
+type EventCluster struct {
 	TenantID  string `db:"tenant_id" json:"tenant_id"`
 	ClusterID int    `db:"cluster_id" json:"cluster_id"`
-	RoleID    int    `db:"role_id" json:"role_id"`
+	EventID   int    `db:"event_id" json:"event_id"`
 	CppmID    int    `db:"cppm_id" json:"cppm_id"`
 }
 
+// EventOccurrence - occurrence of an event, the start of it by the rule is its RecurrenceID.
+// Overrides move occurrences to another start or cancel them, they are kept for the occurrences
+// they change only.
+type EventOccurrence struct {
+	EventID      int              `db:"event_id" json:"event_id"`
+	TenantID     string           `db:"tenant_id" json:"tenant_id"`
+	RecurrenceID time.Time        `db:"recurrence_id" json:"recurrence_id"`
+	Start        time.Time        `db:"start_at" json:"start"`
+	Cancelled    bool             `db:"cancelled" json:"cancelled"`
+	UpdatedAt    time.Time        `db:"updated_at" json:"updated_at"`
+	UpdatedBy    string           `db:"updated_by" json:"updated_by"`
+	Overridden   bool             `db:"-" json:"overridden"`
+	Attendance   *EventAttendance `db:"-" json:"attendance,omitempty"`
+}
+
+// Audit - Audit message for entity
+func (occurrence *EventOccurrence) Audit() string {
+	data, _ := json.Marshal(occurrence)
+	return string(data)
+}
+
+// Validate - Validate fields
+func (occurrence *EventOccurrence) Validate() error {
+	return nil
+}
+
+//SetData - Tenant id, event and occurrence are taken from the path
+func (occurrence *EventOccurrence) SetData(id string, tenantID string, userName string) {
+	occurrence.TenantID = tenantID
+}
+
 // Audit - Audit message for entity
 func (event *Event) Audit() string {
 	data, _ := json.Marshal(event)
 	return string(data)
 }
 
-// Validate - Validate fields
+// Validate - Validate fields. Zones default to UTC and rules are kept in canonical form.
 func (event *Event) Validate() error {
-	return nil
+	if event.TimeZone == "" {
+		event.TimeZone = "UTC"
+	}
+	return v.ValidateStruct(event,
+		v.Field(&event.Capacity, v.Min(0).Error("key_capacity_invalid")),
+		v.Field(&event.TimeZone, v.By(func(interface{}) error {
+			if _, err := time.LoadLocation(event.TimeZone); err != nil {
+				return errors.New("key_time_zone_invalid")
+			}
+			return nil
+		})),
+		v.Field(&event.RRule, v.By(func(interface{}) error {
+			if event.RRule == "" {
+				return nil
+			}
+			rule, err := ical.ParseRecurrence(event.RRule)
+			if err != nil {
+				return errors.New("key_rrule_invalid")
+			}
+			if event.EventDate.IsZero() {
+				return errors.New("key_rrule_event_date_required")
+			}
+			event.RRule = rule.String()
+			return nil
+		})))
+}
+
+// Location - zone of event, UTC when it is not known.
+func (event *Event) Location() *time.Location {
+	location, err := time.LoadLocation(event.TimeZone)
+	if err != nil || event.TimeZone == "" {
+		return time.UTC
+	}
+	return location
 }
 
 //SetData - Id, Cluster id and user name
@@ -49,3 +134,13 @@ func (event *Event) SetData(id string, tenantID string, userName string) {
 	event.ID, _ = strconv.Atoi(id)
 	event.TenantID = tenantID
 }
+
+//URL - CPPM API URL
+func (event *Event) URL() string {
+	return "https://localhost/tips/api/event"
+}
+
+//EntityName - CPPM Entity Name
+func (event *Event) EntityName() string {
+	return "Event"
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"time"
+)
+
+// Sync status of outbox events.
+const (
+	SyncPending = "pending" // waiting for dispatch or for the cluster to acknowledge
+	SyncSynced  = "synced"
+	SyncFailed  = "failed" // no acknowledgement after all attempts
+)
+
+// OutboxEvent - config change to be delivered to a CPPM cluster. Written in the same
+// transaction as the change and dispatched per cluster in id order.
+type OutboxEvent struct {
+	ID            int       `db:"id" json:"id"`
+	TenantID      string    `db:"tenant_id" json:"tenant_id"`
+	ClusterID     int       `db:"cluster_id" json:"cluster_id"`
+	ClusterUUID   string    `db:"cluster_uuid" json:"cluster_uuid"`
+	EntityName    string    `db:"entity_name" json:"entity_name"`
+	EntityID      int       `db:"entity_id" json:"entity_id"`
+	Method        string    `db:"method" json:"method"`
+	Payload       string    `db:"payload" json:"-"` // model.Event published to the cluster
+	Status        string    `db:"status" json:"status"`
+	Attempts      int       `db:"attempts" json:"attempts"`
+	LastError     string    `db:"last_error" json:"last_error"`
+	NextAttemptAt time.Time `db:"next_attempt_at" json:"next_attempt_at"`
+	AddedAt       time.Time `db:"added_at" json:"added_at"`
+	UpdatedAt     time.Time `db:"updated_at" json:"updated_at"`
+}
@@ -2,10 +2,17 @@ package config
 
 import (
 	"encoding/json"
+	"nyota/backend/model"
 	"strconv"
 	"time"
 )
 
+// CPPM versions of role shapes.
+const (
+	role67Version = 60700
+	role66Version = 60600
+)
+
 type ExtraParam map[string]interface{}
 
 // Role - CPPM Role
@@ -18,23 +25,21 @@ type Role struct {
 	Clusters      []*Cluster `db:"-" json:"clusters"`
 	AddedAt       time.Time  `db:"added_at" json:"added_at"`
 	UpdatedAt     time.Time  `db:"updated_at" json:"updated_at"`
+	AddedBy       string     `db:"added_by" json:"added_by"`
+	UpdatedBy     string     `db:"updated_by" json:"updated_by"`
+	Version       int        `db:"version" json:"version"` // of the row, not the CPPM version of GetVersion
 	AddedAtEpoc   int64      `db:"-" json:"added_at_epoc"`
 	UpdatedAtEpoc int64      `db:"-" json:"updated_at_epoc"`
 	Extras        ExtraParam `db:"extras" json:"extras"`
 }
 
+// RoleList - page of roles with the grid columns they can be filtered and sorted on.
 type RoleList struct {
-	Roles     []*Role          `json:"roles"`
-	Structure []RoleGridColumn `json:"structure"`
-}
-
-type RoleGridColumn struct {
-	Label       string `json:"label"`
-	Field       string `json:"value"`
-	CanFilter   bool   `json:"can_filter"`
-	CanSort     bool   `json:"can_sort"`
-	CanAddAsTag bool   `json:"add_as_tag"`
-	Width       int    `json:"width"`
+	Roles     []*Role            `json:"roles"`
+	Structure []model.GridColumn `json:"structure"`
+	Total     int                `json:"total"`
+	Page      int                `json:"page"`
+	PageSize  int                `json:"page_size"`
 }
 
 // RoleCluster - CPPM Role vs Cluster details
@@ -72,17 +77,20 @@ func (role *Role) EntityName() string {
 	return "Role"
 }
 
-// Convert - Convert to lower CPPM version Obj
-func (role *Role) Convert() *Role66 {
+// Convert - Convert to lower CPPM version Obj. Extras are not known to CPPM 6.6.
+func (role *Role) Convert() (model.VersionEntity, error) {
+	if len(role.Extras) > 0 {
+		return nil, &model.ConversionError{Entity: role.EntityName(), Field: "extras", Version: role66Version}
+	}
 	prevVersionRole := Role66{}
 	bytes, _ := json.Marshal(role)
 	json.Unmarshal(bytes, &prevVersionRole)
-	return &prevVersionRole
+	return &prevVersionRole, nil
 }
 
 // GetVersion - Returns Obj for CPPM Version
 func (role *Role) GetVersion() int {
-	return 670
+	return role67Version
 }
 
 //Role66 Role for CPPM 6.6
@@ -97,12 +105,12 @@ type Role66 struct {
 	UpdatedAtEpoc int64     `json:"updated_at_epoc"`
 }
 
-// Convert - Convert to lower CPPM version Obj
-func (role *Role66) Convert() *Role66 {
-	return role
+// Convert - Oldest supported version, there is no lower one
+func (role *Role66) Convert() (model.VersionEntity, error) {
+	return nil, &model.ConversionError{Entity: (&Role{}).EntityName(), Version: role.GetVersion() - 1}
 }
 
 // GetVersion - Returns Obj for CPPM Version
 func (role *Role66) GetVersion() int {
-	return 660
+	return role66Version
 }
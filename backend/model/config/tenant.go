@@ -3,15 +3,44 @@ package config
 import (
 	"encoding/json"
 	"strings"
+	"time"
 
 	v "github.com/go-ozzo/ozzo-validation"
 )
 
+// Session lifetimes of tenants that don't set their own, and the longest ones accepted.
+const (
+	DefaultSessionIdleMinutes = 15
+	DefaultSessionMaxMinutes  = 12 * 60
+	MaxSessionIdleMinutes     = 24 * 60
+	MaxSessionMaxMinutes      = 30 * 24 * 60
+)
+
 // Tenant struct
 type Tenant struct {
-	ID          string `db:"id" json:"id"`
-	Name        string `db:"name" json:"name"`
-	Description string `db:"description" json:"description"`
+	ID                 string `db:"id" json:"id"`
+	Name               string `db:"name" json:"name"`
+	Description        string `db:"description" json:"description"`
+	SessionIdleMinutes int    `db:"session_idle_minutes" json:"session_idle_minutes"` // 0 for default
+	SessionMaxMinutes  int    `db:"session_max_minutes" json:"session_max_minutes"`   // 0 for default
+	MFARequired        bool   `db:"mfa_required" json:"mfa_required"`                 // users log in with a second factor
+	Version            int    `db:"version" json:"version"`                           // row version, changed by every update
+}
+
+// SessionIdle - sessions of tenant end when unused for this long.
+func (tenant *Tenant) SessionIdle() time.Duration {
+	if tenant.SessionIdleMinutes == 0 {
+		return DefaultSessionIdleMinutes * time.Minute
+	}
+	return time.Duration(tenant.SessionIdleMinutes) * time.Minute
+}
+
+// SessionMax - sessions of tenant end this long after login, however active.
+func (tenant *Tenant) SessionMax() time.Duration {
+	if tenant.SessionMaxMinutes == 0 {
+		return DefaultSessionMaxMinutes * time.Minute
+	}
+	return time.Duration(tenant.SessionMaxMinutes) * time.Minute
 }
 
 // Audit - Audit message for entity
@@ -27,6 +56,10 @@ func (tenant *Tenant) Validate() error {
 	tenant.Name = strings.TrimSpace(tenant.Name)
 	fieldRules = append(fieldRules, v.Field(&tenant.Name, v.Required.Error("key_name_required"), v.Length(1, 255).Error("key_name_length")))
 	fieldRules = append(fieldRules, v.Field(&tenant.Description, v.Length(0, 255).Error("key_description_length")))
+	fieldRules = append(fieldRules, v.Field(&tenant.SessionIdleMinutes,
+		v.Min(5).Error("key_session_idle_range"), v.Max(MaxSessionIdleMinutes).Error("key_session_idle_range")))
+	fieldRules = append(fieldRules, v.Field(&tenant.SessionMaxMinutes,
+		v.Min(60).Error("key_session_max_range"), v.Max(MaxSessionMaxMinutes).Error("key_session_max_range")))
 	return v.ValidateStruct(tenant, fieldRules...)
 }
 
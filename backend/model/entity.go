@@ -22,6 +22,7 @@ type EventData struct {
 	URI        string      `json:"uri"`
 	Method     string      `json:"method"`
 	Payload    interface{} `json:"payload"`
+	EventID    int         `json:"event_id,omitempty"` // outbox id, echoed back by CPPM in the callback
 }
 
 func (eventData EventData) MarshalBinary() ([]byte, error) {
@@ -76,9 +77,10 @@ type UIFormExtraFieldsDetails struct {
 	Menu        interface{} `json:"menu,omitempty"`
 }
 
+// VersionEntity - entity synced to CPPM. Versions are major*10000 + minor*100 + patch.
 type VersionEntity interface {
-	Convert() VersionEntity // convert object from one version to previous
-	GetVersion() int        // get last updated version
+	Convert() (VersionEntity, error) // convert object from one version to previous, ConversionError when not possible
+	GetVersion() int                 // get last updated version
 }
 
 // DataInfo - Data option
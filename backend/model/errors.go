@@ -0,0 +1,69 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// NotFoundError - requested entity does not exist or is not visible to the tenant of session.
+type NotFoundError struct {
+	Entity string
+	ID     string
+}
+
+func (err *NotFoundError) Error() string {
+	return fmt.Sprintf("%s(%s) not found", err.Entity, err.ID)
+}
+
+// IsNotFound - true when err is a NotFoundError.
+func IsNotFound(err error) bool {
+	_, ok := err.(*NotFoundError)
+	return ok
+}
+
+// VersionMismatchError - entity was changed since the client read it at Version.
+type VersionMismatchError struct {
+	Entity  string
+	ID      string
+	Version int
+}
+
+func (err *VersionMismatchError) Error() string {
+	return fmt.Sprintf("%s(%s) is no longer at version %d", err.Entity, err.ID, err.Version)
+}
+
+// IsVersionMismatch - true when err is a VersionMismatchError.
+func IsVersionMismatch(err error) bool {
+	_, ok := err.(*VersionMismatchError)
+	return ok
+}
+
+// DuplicateCheckInError - ticket of attendee was already checked in At by By.
+type DuplicateCheckInError struct {
+	AttendeeID int
+	At         time.Time
+	By         string
+}
+
+func (err *DuplicateCheckInError) Error() string {
+	return fmt.Sprintf("attendee(%d) already checked in at %s by %s", err.AttendeeID, err.At.Format(time.RFC3339), err.By)
+}
+
+// ConversionError - entity, or Field of it when set, can not be represented on CPPM Version.
+type ConversionError struct {
+	Entity  string
+	Field   string
+	Version int // as returned by VersionEntity.GetVersion
+}
+
+func (err *ConversionError) Error() string {
+	if err.Field == "" {
+		return fmt.Sprintf("%s can not be synced to CPPM %s", err.Entity, err.Release())
+	}
+	return fmt.Sprintf("%s of %s can not be synced to CPPM %s", err.Field, err.Entity, err.Release())
+}
+
+// Release - CPPM release of Version as major.minor.
+func (err *ConversionError) Release() string {
+	return fmt.Sprintf("%d.%d", err.Version/10000, err.Version/100%100)
+}
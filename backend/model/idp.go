@@ -0,0 +1,73 @@
+package model
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	v "github.com/go-ozzo/ozzo-validation"
+)
+
+// IdentityProvider - OpenID Connect provider users of a tenant log in with. Users are created on
+// first login with the role their groups map to.
+type IdentityProvider struct {
+	ID               string            `db:"id" json:"id"`
+	TenantID         string            `db:"tenant_id" json:"tenant_id"`
+	Name             string            `db:"name" json:"name"`
+	Issuer           string            `db:"issuer" json:"issuer"`
+	ClientID         string            `db:"client_id" json:"client_id"`
+	ClientSecret     string            `db:"client_secret" json:"client_secret,omitempty"` // never served
+	Scopes           []string          `db:"scopes" json:"scopes"`                         // requested besides openid
+	UserClaim        string            `db:"user_claim" json:"user_claim"`                 // user name, email by default
+	TenantClaim      string            `db:"tenant_claim" json:"tenant_claim"`             // claim that must equal TenantClaimValue
+	TenantClaimValue string            `db:"tenant_claim_value" json:"tenant_claim_value"` // e.g. the directory id of the tenant
+	GroupsClaim      string            `db:"groups_claim" json:"groups_claim"`             // groups by default
+	GroupRoles       map[string]string `db:"group_roles" json:"group_roles"`               // group to admin role
+	DefaultRole      string            `db:"default_role" json:"default_role"`             // role without matching group, empty refuses login
+	Enabled          bool              `db:"enabled" json:"enabled"`
+	AddedAt          time.Time         `db:"added_at" json:"added_at"`
+	UpdatedAt        time.Time         `db:"updated_at" json:"updated_at"`
+	AddedBy          string            `db:"added_by" json:"added_by"`
+	UpdatedBy        string            `db:"updated_by" json:"updated_by"`
+}
+
+// Audit - Audit message for entity, the client secret is never part of audit data.
+func (idp *IdentityProvider) Audit() string {
+	auditIdp := *idp
+	auditIdp.ClientSecret = ""
+	data, _ := json.Marshal(auditIdp)
+	return string(data)
+}
+
+// Validate - Validate fields, defaults for the user and groups claims.
+func (idp *IdentityProvider) Validate() error {
+	idp.Name = strings.TrimSpace(idp.Name)
+	idp.Issuer = strings.TrimSpace(idp.Issuer)
+	if idp.UserClaim == "" {
+		idp.UserClaim = "email"
+	}
+	if idp.GroupsClaim == "" {
+		idp.GroupsClaim = "groups"
+	}
+	var fieldRules []*v.FieldRules
+	fieldRules = append(fieldRules, v.Field(&idp.Name, v.Required.Error("key_name_required"), v.Length(1, 64).Error("key_name_length")))
+	fieldRules = append(fieldRules, v.Field(&idp.Issuer, v.Required.Error("key_issuer_required"), v.NewStringRule(isIssuerURL, "key_issuer_invalid")))
+	fieldRules = append(fieldRules, v.Field(&idp.ClientID, v.Required.Error("key_client_id_required"), v.Length(1, 255).Error("key_client_id_required")))
+	if idp.TenantClaim != "" {
+		fieldRules = append(fieldRules, v.Field(&idp.TenantClaimValue, v.Required.Error("key_tenant_claim_value_required")))
+	}
+	return v.ValidateStruct(idp, fieldRules...)
+}
+
+// isIssuerURL - issuers are http(s) URLs without query or fragment.
+func isIssuerURL(issuer string) bool {
+	u, err := url.Parse(issuer)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != "" && u.RawQuery == "" && u.Fragment == ""
+}
+
+// SetData - Tenant id, providers are always managed in the tenant of logged in user.
+func (idp *IdentityProvider) SetData(id string, tenantID string, userName string) {
+	idp.ID = id
+	idp.TenantID = tenantID
+}
@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// UserMFA - TOTP second factor of a user. Enrollment starts disabled and is enabled by the first
+// valid code, recovery codes are only stored hashed.
+type UserMFA struct {
+	UserName      string     `db:"user_name" json:"-"`
+	TenantID      string     `db:"tenant_id" json:"-"`
+	Secret        string     `db:"secret" json:"-"`
+	Enabled       bool       `db:"enabled" json:"enabled"`
+	RecoveryCodes []string   `db:"recovery_codes" json:"-"` // hashes of unused recovery codes
+	LastStep      int64      `db:"last_step" json:"-"`      // time step of the last accepted code
+	AddedAt       time.Time  `db:"added_at" json:"added_at"`
+	EnabledAt     *time.Time `db:"enabled_at" json:"enabled_at"`
+}
+
+// MFAStatus - second factor of the logged in user.
+type MFAStatus struct {
+	Enabled           bool `json:"enabled"`
+	Required          bool `json:"required"` // by the tenant, can't be disabled
+	RecoveryCodesLeft int  `json:"recovery_codes_left"`
+}
+
+// MFAEnrollment - secret of a new second factor, as text and as otpauth QR code for authenticator
+// apps. Recovery codes are only shown once, when enrollment completes.
+type MFAEnrollment struct {
+	Secret        string   `json:"secret,omitempty"`
+	URI           string   `json:"uri,omitempty"`
+	QRCode        string   `json:"qr_code,omitempty"` // PNG data URL
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+// MFACode - code of authenticator app, or one of the recovery codes.
+type MFACode struct {
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
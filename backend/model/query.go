@@ -0,0 +1,59 @@
+package model
+
+// Value types of grid columns, filter values are converted to them.
+const (
+	ColumnText   = ""
+	ColumnNumber = "number"
+	ColumnBool   = "boolean"
+	ColumnTime   = "time"
+)
+
+// Filter operators of list queries.
+const (
+	OpEq   = "eq"
+	OpNe   = "ne"
+	OpLt   = "lt"
+	OpLe   = "le"
+	OpGt   = "gt"
+	OpGe   = "ge"
+	OpLike = "like" // case insensitive substring, text columns only
+	OpIn   = "in"   // any of comma separated values
+)
+
+// GridColumn - column of a list grid. Lists can only be filtered and sorted on the columns
+// declared with CanFilter and CanSort.
+type GridColumn struct {
+	Label       string `json:"label"`
+	Field       string `json:"value"`
+	CanFilter   bool   `json:"can_filter"`
+	CanSort     bool   `json:"can_sort"`
+	CanAddAsTag bool   `json:"add_as_tag"`
+	Width       int    `json:"width"`
+	Type        string `json:"type,omitempty"`
+	Column      string `json:"-"` // table column of field, Field when empty
+}
+
+// QueryFilter - condition "<Column> <Op> <Values>" of a list query. Values are of the column type:
+// string, int64, bool or time.Time.
+type QueryFilter struct {
+	Column string
+	Op     string
+	Values []interface{}
+}
+
+// QuerySpec - page, order and conditions of a list query, validated against the grid columns of
+// the listed entity. Rows with equal sort values are ordered by id.
+type QuerySpec struct {
+	Page          int // 1 based
+	PageSize      int
+	Sort          string // table column, id when empty
+	Desc          bool
+	Filters       []QueryFilter
+	Search        string   // case insensitive substring of any of SearchColumns
+	SearchColumns []string // filterable text columns
+}
+
+// Offset - rows before the page.
+func (spec QuerySpec) Offset() int {
+	return (spec.Page - 1) * spec.PageSize
+}
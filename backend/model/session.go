@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// UserSession - login session of a user. The session cookie only carries ID, sessions are revoked
+// by deleting them.
+type UserSession struct {
+	ID         string    `db:"id" json:"id"`
+	TenantID   string    `db:"tenant_id" json:"tenant_id"`
+	UserName   string    `db:"user_name" json:"user_name"`
+	RemoteAddr string    `db:"remote_addr" json:"remote_addr"`
+	UserAgent  string    `db:"user_agent" json:"user_agent"`
+	AddedAt    time.Time `db:"added_at" json:"added_at"`
+	LastSeenAt time.Time `db:"last_seen_at" json:"last_seen_at"`
+	Current    bool      `db:"-" json:"current"` // session of the request
+}
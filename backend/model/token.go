@@ -0,0 +1,53 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	v "github.com/go-ozzo/ozzo-validation"
+)
+
+// Kinds of API tokens.
+const (
+	TokenPersonal = "personal" // acts as UserName, never with more than its current permissions
+	TokenService  = "service"  // acts as service account UserName with Scopes
+)
+
+// APIToken - bearer token of automation clients. Only the hash of its secret is stored, Token is
+// set in the response that creates it. Scopes are permissions per route group like roles have.
+type APIToken struct {
+	ID         string            `db:"id" json:"id"`
+	TenantID   string            `db:"tenant_id" json:"tenant_id"`
+	UserName   string            `db:"user_name" json:"user_name"`
+	Name       string            `db:"name" json:"name"`
+	Kind       string            `db:"kind" json:"kind"`
+	Hash       string            `db:"hash" json:"-"`
+	Scopes     map[string]string `db:"scopes" json:"scopes"`
+	ExpiresAt  time.Time         `db:"expires_at" json:"expires_at"`
+	LastUsedAt *time.Time        `db:"last_used_at" json:"last_used_at"`
+	AddedAt    time.Time         `db:"added_at" json:"added_at"`
+	AddedBy    string            `db:"added_by" json:"added_by"`
+	Token      string            `db:"-" json:"token,omitempty"`
+}
+
+// Audit - Audit message for entity. Token is never part of audit data.
+func (token *APIToken) Audit() string {
+	auditToken := *token
+	auditToken.Token = ""
+	data, _ := json.Marshal(auditToken)
+	return string(data)
+}
+
+// Validate - Validate fields
+func (token *APIToken) Validate() error {
+	token.Name = strings.TrimSpace(token.Name)
+	return v.ValidateStruct(token,
+		v.Field(&token.Name, v.Required.Error("key_name_required"), v.Length(1, 255).Error("key_name_length")),
+		v.Field(&token.Scopes, v.Required.Error("key_permissions_required")))
+}
+
+// SetData - Tenant id, tokens are always created in the tenant of logged in user.
+func (token *APIToken) SetData(id string, tenantID string, userName string) {
+	token.TenantID = tenantID
+}
@@ -1,16 +1,31 @@
 package model
 
-import "github.com/nicksnyder/go-i18n/i18n"
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	v "github.com/go-ozzo/ozzo-validation"
+	"github.com/nicksnyder/go-i18n/i18n"
+)
 
 type UserContext struct {
-	TenantId   string
-	UserName   string
-	Permission map[string]string
+	TenantId     string
+	UserName     string
+	Permission   map[string]string
+	IsSuperAdmin bool   // Super admin is not restricted to its own tenant data
+	ClusterID    int    // Set for requests signed with credentials of a CPPM cluster
+	TokenID      string // Set for requests authenticated with an API token
+	SessionID    string // Set for requests of a login session
 }
 
+// AppError - error of request, served to clients as the JSON error envelope.
 type AppError struct {
-	Type, Message string
-	Code          int
+	Type      string            `json:"type"`
+	Code      int               `json:"code"`             // HTTP status
+	Message   string            `json:"message"`          // translated
+	Fields    map[string]string `json:"fields,omitempty"` // translated error per invalid field
+	RequestID string            `json:"request_id"`
 }
 
 type SessionContext struct {
@@ -18,34 +33,94 @@ type SessionContext struct {
 	Lang      string             // Client language preference
 	TFunc     i18n.TranslateFunc // I18N Translation function based on client language preference
 	Err       *AppError
-	AuditData string
+	AuditData string // Entity after the change of request, from Context.Audit()
+	AuditOld  string // Entity before the change of request, set by update and delete handlers
+	AuditID   string // Id of entity changed by request when the path has none
+	RequestID string // Sent back in X-Request-ID header and error envelope
 }
 
 type UserLogin struct {
 	UserName, Password string
 }
 
+// LoginLockout - failed logins of user in the current window and how long logins are refused.
+type LoginLockout struct {
+	UserName   string `json:"username"`
+	Failures   int64  `json:"failures"`
+	Locked     bool   `json:"locked"`
+	RetryAfter int    `json:"retry_after"` // seconds until next login attempt is accepted
+}
+
 type UserTenantBasicDetails struct {
-	UserName   string            `json:"username"`
-	Role       string            `json:"role"`
-	Permission map[string]string `json:"permission"`
+	UserName      string            `json:"username"`
+	Role          string            `json:"role"`
+	Permission    map[string]string `json:"permission"`
+	MFARequired   bool              `json:"mfa_required,omitempty"`   // login waits for the second factor
+	MFAEnroll     bool              `json:"mfa_enroll,omitempty"`     // second factor must be enrolled first
+	RecoveryCodes []string          `json:"recovery_codes,omitempty"` // of enrollment during login, shown once
 }
 
 type UserTenantDetails struct {
 	UserName             string `db:"username" json:"name"`
-	Password             string `db:"password" json:"password"`
+	Password             string `db:"password" json:"password,omitempty"` // argon2id hash once stored
+	Salt                 string `db:"salt" json:"-"`
 	TenantID             string `db:"tenant_id" json:"tenant_id"`
 	Descrition           string `db:"description" json:"descrption"`
 	UserTenantAttributes `json:"attributes"`
 }
 
 type UserTenantAttributes struct {
-	Role        string            `json:"role"`
-	Permissions map[string]string `json:"permissions"`
+	Role             string            `db:"role" json:"role"`
+	Permissions      map[string]string `db:"permissions" json:"permissions"`
+	IdentityProvider string            `db:"identity_provider" json:"identity_provider"` // set for users of single sign-on, they have no password
 }
 
 type UserTenantDetailsArray []UserTenantDetails
 
+// Audit - Audit message for entity. Password is never part of audit data.
+func (user *UserTenantDetails) Audit() string {
+	auditUser := *user
+	auditUser.Password = ""
+	auditUser.Salt = ""
+	data, _ := json.Marshal(auditUser)
+	return string(data)
+}
+
+// Validate - Validate fields
+func (user *UserTenantDetails) Validate() error {
+	var fieldRules []*v.FieldRules
+	// trim space
+	user.UserName = strings.TrimSpace(user.UserName)
+	fieldRules = append(fieldRules, v.Field(&user.UserName, v.Required.Error("key_username_required"), v.Length(1, 255).Error("key_username_length")))
+	fieldRules = append(fieldRules, v.Field(&user.Password, v.NewStringRule(IsStrongPassword, "key_password_policy")))
+	fieldRules = append(fieldRules, v.Field(&user.Descrition, v.Length(0, 255).Error("key_description_length")))
+	return v.ValidateStruct(user, fieldRules...)
+}
+
+// IsStrongPassword - password policy, 8 to 128 characters with upper case, lower case and digit.
+func IsStrongPassword(password string) bool {
+	if len(password) < 8 || len(password) > 128 {
+		return false
+	}
+	var upper, lower, digit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		}
+	}
+	return upper && lower && digit
+}
+
+// SetData - Tenant id, users are always created in the tenant of logged in user.
+func (user *UserTenantDetails) SetData(id string, tenantID string, userName string) {
+	user.TenantID = tenantID
+}
+
 //Context interface. All the models which needs to be validated will implement this interface
 type Context interface {
 	Validate() error                                     // bool to identify add/edit operation
@@ -0,0 +1,80 @@
+package store
+
+import (
+	"database/sql"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"time"
+
+	gorp "gopkg.in/gorp.v2"
+)
+
+//GetAdminRoles - custom admin roles of the session tenant. Like users, roles always belong to
+//the tenant of session, also for super admin.
+func (store *PgStore) GetAdminRoles(s *model.SessionContext) ([]*model.AdminRole, error) {
+	logutil.Debugf(s, "Store Layer - Get Admin Roles")
+	var roles []*model.AdminRole
+	err := store.DB().Select(&roles, "SELECT * FROM ADMIN_ROLE WHERE TENANT_ID = $1 ORDER BY NAME", s.User.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+//GetAdminRole - custom admin role of the session tenant by name
+func (store *PgStore) GetAdminRole(s *model.SessionContext, name string) (*model.AdminRole, error) {
+	logutil.Debugf(s, "Store Layer - Get Admin Role")
+	var role *model.AdminRole
+	err := store.DB().SelectOne(&role, "SELECT * FROM ADMIN_ROLE WHERE TENANT_ID = $1 AND NAME = $2", s.User.TenantId, name)
+	if err == sql.ErrNoRows {
+		return nil, &model.NotFoundError{Entity: "admin role", ID: name}
+	}
+	return role, err
+}
+
+//UpsertAdminRole - insert or update admin role, users assigned to it get its permissions
+func (store *PgStore) UpsertAdminRole(s *model.SessionContext, role *model.AdminRole) error {
+	logutil.Debugf(s, "Store Layer - Upsert Admin Role")
+	return execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		var existing *model.AdminRole
+		err := tx.SelectOne(&existing, "SELECT * FROM ADMIN_ROLE WHERE TENANT_ID = $1 AND NAME = $2", role.TenantID, role.Name)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		role.UpdatedAt = time.Now()
+		role.UpdatedBy = s.User.UserName
+		if existing == nil {
+			role.AddedAt = role.UpdatedAt
+			role.AddedBy = s.User.UserName
+			err = tx.Insert(role)
+		} else {
+			role.AddedAt = existing.AddedAt
+			role.AddedBy = existing.AddedBy
+			_, err = tx.Update(role)
+		}
+		if err != nil {
+			return err
+		}
+		permissions, err := gorpTypeConverter{}.ToDb(role.Permissions)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("UPDATE USER_TENANT_DETAILS SET PERMISSIONS = $1 WHERE TENANT_ID = $2 AND ROLE = $3",
+			permissions, role.TenantID, role.Name)
+		return err
+	})
+}
+
+//DeleteAdminRole - delete custom admin role of the session tenant
+func (store *PgStore) DeleteAdminRole(s *model.SessionContext, name string) error {
+	logutil.Debugf(s, "Store Layer - Delete Admin Role")
+	res, err := store.DB().Exec("DELETE FROM ADMIN_ROLE WHERE TENANT_ID = $1 AND NAME = $2", s.User.TenantId, name)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err == nil && n == 0 {
+		return &model.NotFoundError{Entity: "admin role", ID: name}
+	}
+	return err
+}
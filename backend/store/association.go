@@ -0,0 +1,185 @@
+package store
+
+import (
+	"fmt"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"strconv"
+
+	"github.com/lib/pq"
+	gorp "gopkg.in/gorp.v2"
+)
+
+// clusterLink - link of an entity to a cluster it is synced to, with the id CPPM gave the entity there.
+type clusterLink struct {
+	TenantID  string
+	ClusterID int
+	CppmID    int
+}
+
+// clusterLinkDiff - clusters of an entity after its links were synced.
+type clusterLinkDiff struct {
+	Mapped   []*config.Cluster // clusters linked now
+	Unmapped []*config.Cluster // clusters whose link was removed
+	CppmIDs  map[int]int       // cluster id -> CPPM id, of the links that existed before
+}
+
+// diffClusterLinks - clusterIDs without duplicates, the ones among them without link in existing, and
+// the existing links to clusters not in clusterIDs.
+func diffClusterLinks(existing []*clusterLink, clusterIDs []int) (desired []int, added []int, removed []*clusterLink) {
+	linked := make(map[int]bool)
+	for _, link := range existing {
+		linked[link.ClusterID] = true
+	}
+	wanted := make(map[int]bool)
+	for _, clusterID := range clusterIDs {
+		if wanted[clusterID] {
+			continue
+		}
+		wanted[clusterID] = true
+		desired = append(desired, clusterID)
+		if !linked[clusterID] {
+			added = append(added, clusterID)
+		}
+	}
+	for _, link := range existing {
+		if !wanted[link.ClusterID] {
+			removed = append(removed, link)
+		}
+	}
+	return desired, added, removed
+}
+
+// linkedClusterIDs - ids of clusters, in the order they were given.
+func linkedClusterIDs(clusters []*config.Cluster) []int {
+	var ids []int
+	for _, cluster := range clusters {
+		ids = append(ids, cluster.ID)
+	}
+	return ids
+}
+
+// clusterLinkTable - many-to-many table linking entities of a tenant to clusters, e.g. ccc_role_cluster
+// with entity column role_id.
+type clusterLinkTable struct {
+	table  string
+	column string
+}
+
+var (
+	roleClusterLinks  = clusterLinkTable{table: "ccc_role_cluster", column: "role_id"}
+	eventClusterLinks = clusterLinkTable{table: "ccc_event_cluster", column: "event_id"}
+)
+
+// links - existing links of entity id, read in transaction tx.
+func (t clusterLinkTable) links(tx *gorp.Transaction, id int) ([]*clusterLink, error) {
+	rows, err := tx.Query(fmt.Sprintf("SELECT tenant_id, cluster_id, cppm_id FROM %s WHERE %s = $1 ORDER BY cluster_id",
+		t.table, t.column), id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var links []*clusterLink
+	for rows.Next() {
+		link := &clusterLink{}
+		if err := rows.Scan(&link.TenantID, &link.ClusterID, &link.CppmID); err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return links, rows.Err()
+}
+
+// sync - links entity id of tenantID to clusterIDs, and only to them, in transaction tx. Clusters
+// linked anew must be visible to the session, NotFoundError otherwise.
+func (t clusterLinkTable) sync(s *model.SessionContext, tx *gorp.Transaction, tenantID string, id int,
+	clusterIDs []int) (*clusterLinkDiff, error) {
+
+	existing, err := t.links(tx, id)
+	if err != nil {
+		logutil.Errorf(s, "read %s links of %d failed: %v", t.table, id, err)
+		return nil, err
+	}
+	desired, added, removed := diffClusterLinks(existing, clusterIDs)
+
+	for _, clusterID := range added {
+		// Clusters of other tenants can not be linked.
+		clusterKey := strconv.Itoa(clusterID)
+		if err := TenantTx(s, tx).Exists("cluster", clusterKey, "SELECT COUNT(*) FROM CCC_CLUSTER WHERE ID = $1", clusterID); err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(fmt.Sprintf("INSERT INTO %s (tenant_id, cluster_id, %s) VALUES ($1, $2, $3)", t.table, t.column),
+			tenantID, clusterID, id); err != nil {
+			logutil.Errorf(s, "insert %s link of %d failed: %v", t.table, id, err)
+			return nil, err
+		}
+	}
+
+	var removedIDs []int
+	var removedKeys []int64
+	for _, link := range removed {
+		removedIDs = append(removedIDs, link.ClusterID)
+		removedKeys = append(removedKeys, int64(link.ClusterID))
+	}
+	if len(removedKeys) > 0 {
+		if _, err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = $1 AND cluster_id = ANY($2)", t.table, t.column),
+			id, pq.Array(removedKeys)); err != nil {
+			logutil.Errorf(s, "delete %s links of %d failed: %v", t.table, id, err)
+			return nil, err
+		}
+	}
+
+	diff := &clusterLinkDiff{CppmIDs: make(map[int]int)}
+	for _, link := range existing {
+		diff.CppmIDs[link.ClusterID] = link.CppmID
+	}
+	if diff.Mapped, err = txClusters(tx, desired); err != nil {
+		return nil, err
+	}
+	if diff.Unmapped, err = txClusters(tx, removedIDs); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// syncClusterLinks - MemStore counterpart of clusterLinkTable.sync for links, keyed by entity id.
+// Caller must hold the write lock.
+func (store *MemStore) syncClusterLinks(s *model.SessionContext, links map[int][]*clusterLink, tenantID string, id int,
+	clusterIDs []int) (*clusterLinkDiff, error) {
+
+	desired, added, removed := diffClusterLinks(links[id], clusterIDs)
+	for _, clusterID := range added {
+		// Clusters of other tenants can not be linked.
+		if cluster, ok := store.clusters[clusterID]; !ok || !visible(s, cluster.TenantID) {
+			return nil, notFound("cluster", clusterID)
+		}
+	}
+
+	diff := &clusterLinkDiff{CppmIDs: make(map[int]int)}
+	current := make(map[int]*clusterLink)
+	for _, link := range links[id] {
+		diff.CppmIDs[link.ClusterID] = link.CppmID
+		current[link.ClusterID] = link
+	}
+	var synced []*clusterLink
+	for _, clusterID := range desired {
+		link, ok := current[clusterID]
+		if !ok {
+			link = &clusterLink{TenantID: tenantID, ClusterID: clusterID}
+		}
+		synced = append(synced, link)
+		diff.Mapped = append(diff.Mapped, copyCluster(store.clusters[clusterID]))
+	}
+	for _, link := range removed {
+		if cluster, ok := store.clusters[link.ClusterID]; ok {
+			diff.Unmapped = append(diff.Unmapped, copyCluster(cluster))
+		}
+	}
+	if len(synced) == 0 {
+		delete(links, id)
+	} else {
+		links[id] = synced
+	}
+	return diff, nil
+}
@@ -0,0 +1,29 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffClusterLinks(t *testing.T) {
+	existing := []*clusterLink{{ClusterID: 1, CppmID: 11}, {ClusterID: 2}, {ClusterID: 3, CppmID: 33}}
+	tests := []struct {
+		clusterIDs              []int
+		desired, added, removed []int
+	}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}, nil, nil},
+		{[]int{3, 4, 4, 1}, []int{3, 4, 1}, []int{4}, []int{2}},
+		{nil, nil, nil, []int{1, 2, 3}},
+	}
+	for _, test := range tests {
+		desired, added, removed := diffClusterLinks(existing, test.clusterIDs)
+		var removedIDs []int
+		for _, link := range removed {
+			removedIDs = append(removedIDs, link.ClusterID)
+		}
+		if !reflect.DeepEqual(desired, test.desired) || !reflect.DeepEqual(added, test.added) || !reflect.DeepEqual(removedIDs, test.removed) {
+			t.Errorf("diffClusterLinks(%v) = %v, %v, %v, expected %v, %v, %v", test.clusterIDs, desired, added, removedIDs,
+				test.desired, test.added, test.removed)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package store
+
+import (
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"strconv"
+	"time"
+
+	gorp "gopkg.in/gorp.v2"
+)
+
+//GetEventAttendees - attendees of event in order of registration
+func (store *PgStore) GetEventAttendees(s *model.SessionContext, eventID string) ([]*config.Attendee, error) {
+	logutil.Debugf(s, "Store Layer - Get Event Attendees")
+	var attendees []*config.Attendee
+	err := store.Tenant(s).Select(&attendees, "SELECT * FROM EVENT_ATTENDEE WHERE EVENT_ID = $1 ORDER BY ID", eventID)
+	if err != nil {
+		return nil, err
+	}
+	return attendees, nil
+}
+
+//GetEventAttendee - attendee of event
+func (store *PgStore) GetEventAttendee(s *model.SessionContext, eventID, id string) (*config.Attendee, error) {
+	logutil.Debugf(s, "Store Layer - Get Event Attendee")
+	var attendee *config.Attendee
+	err := store.Tenant(s).SelectOne(&attendee, "attendee", id, "SELECT * FROM EVENT_ATTENDEE WHERE ID = $1 AND EVENT_ID = $2", id, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return attendee, nil
+}
+
+// eventAttendanceQuery - counts of attendees of event $1 with status $2 registered and $3 waitlisted.
+// Statuses are counted with CASE, a WHERE inside the select list would be taken for the one the tenant
+// scope is added to.
+const eventAttendanceQuery = `SELECT COALESCE(SUM(CASE WHEN STATUS = $2 THEN 1 ELSE 0 END), 0) AS REGISTERED,
+	COUNT(CHECKED_IN_AT) AS CHECKED_IN, COALESCE(SUM(CASE WHEN STATUS = $3 THEN 1 ELSE 0 END), 0) AS WAITLISTED
+	FROM EVENT_ATTENDEE WHERE EVENT_ID = $1`
+
+//GetEventAttendance - number of registered, checked in and waitlisted attendees of event
+func (store *PgStore) GetEventAttendance(s *model.SessionContext, eventID string) (*config.EventAttendance, error) {
+	logutil.Debugf(s, "Store Layer - Get Event Attendance")
+	var counts []*config.EventAttendance
+	err := store.Tenant(s).Select(&counts, eventAttendanceQuery, eventID, config.AttendeeRegistered, config.AttendeeWaitlisted)
+	if err != nil {
+		return nil, err
+	}
+	if len(counts) == 0 {
+		return &config.EventAttendance{}, nil
+	}
+	return counts[0], nil
+}
+
+//AddEventAttendee - register attendee for an event of the session tenant, emails are unique per
+//occurrence of the event. Attendees beyond the capacity of the occurrence are waitlisted.
+func (store *PgStore) AddEventAttendee(s *model.SessionContext, attendee *config.Attendee) error {
+	logutil.Debugf(s, "Store Layer - Add Event Attendee")
+	return execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		event, err := lockEvent(s, tx, strconv.Itoa(attendee.EventID))
+		if err != nil {
+			return err
+		}
+		attendee.Status = config.AttendeeRegistered
+		if event.Capacity > 0 {
+			registered, err := registeredAttendees(tx, event.ID, attendee.Occurrence)
+			if err != nil {
+				return err
+			}
+			if registered >= event.Capacity {
+				attendee.Status = config.AttendeeWaitlisted
+			}
+		}
+		attendee.RegisteredAt = time.Now()
+		attendee.RegisteredBy = s.User.UserName
+		attendee.CheckedInAt, attendee.CheckedInBy, attendee.Scans, attendee.PromotedAt = nil, "", 0, nil
+		return tx.Insert(attendee)
+	})
+}
+
+//DeleteEventAttendee - cancel registration, the ticket of attendee is no longer accepted. The place
+//goes to the first waitlisted attendee of the occurrence, promoted attendees are returned.
+func (store *PgStore) DeleteEventAttendee(s *model.SessionContext, eventID, id string) ([]*config.Attendee, error) {
+	logutil.Debugf(s, "Store Layer - Delete Event Attendee")
+	var promoted []*config.Attendee
+	err := execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		event, err := lockEvent(s, tx, eventID)
+		if err != nil {
+			return err
+		}
+		var attendee *config.Attendee
+		if err := TenantTx(s, tx).SelectOne(&attendee, "attendee", id,
+			"SELECT * FROM EVENT_ATTENDEE WHERE ID = $1 AND EVENT_ID = $2", id, eventID); err != nil {
+			return err
+		}
+		if _, err := tx.Exec("DELETE FROM EVENT_ATTENDEE WHERE ID = $1", attendee.ID); err != nil {
+			return err
+		}
+		if attendee.Status == config.AttendeeRegistered {
+			promoted, err = promoteWaitlisted(tx, event, attendee.Occurrence, time.Now())
+		}
+		return err
+	})
+	return promoted, err
+}
+
+//PromoteEventAttendees - move waitlisted attendees of event to free places of their occurrence, after
+//the capacity was raised. Promoted attendees are returned.
+func (store *PgStore) PromoteEventAttendees(s *model.SessionContext, eventID string) ([]*config.Attendee, error) {
+	logutil.Debugf(s, "Store Layer - Promote Event Attendees")
+	var promoted []*config.Attendee
+	err := execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		event, err := lockEvent(s, tx, eventID)
+		if err != nil {
+			return err
+		}
+		var waitlisted []*config.Attendee
+		if _, err := tx.Select(&waitlisted, "SELECT * FROM EVENT_ATTENDEE WHERE EVENT_ID = $1 AND STATUS = $2 ORDER BY ID",
+			event.ID, config.AttendeeWaitlisted); err != nil {
+			return err
+		}
+		now := time.Now()
+		for i, attendee := range waitlisted {
+			if firstOfOccurrence(waitlisted[:i], attendee.Occurrence) {
+				attendees, err := promoteWaitlisted(tx, event, attendee.Occurrence, now)
+				if err != nil {
+					return err
+				}
+				promoted = append(promoted, attendees...)
+			}
+		}
+		return nil
+	})
+	return promoted, err
+}
+
+// lockEvent - event of the session tenant, locked until tx ends. Registrations and cancellations of
+// an event wait for each other, so that places are never given twice.
+func lockEvent(s *model.SessionContext, tx *gorp.Transaction, eventID string) (*config.Event, error) {
+	var event *config.Event
+	if err := TenantTx(s, tx).SelectOne(&event, "event", eventID, "SELECT * FROM EVENTS WHERE ID = $1 FOR UPDATE", eventID); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// registeredAttendees - number of registered attendees of occurrence of event.
+func registeredAttendees(tx *gorp.Transaction, eventID int, occurrence *time.Time) (int, error) {
+	count, err := tx.SelectInt("SELECT COUNT(*) FROM EVENT_ATTENDEE WHERE EVENT_ID = $1 AND OCCURRENCE IS NOT DISTINCT FROM $2 AND STATUS = $3",
+		eventID, occurrence, config.AttendeeRegistered)
+	return int(count), err
+}
+
+// promoteWaitlisted - registers waitlisted attendees of occurrence of the locked event in order of
+// registration, as many as there are free places.
+func promoteWaitlisted(tx *gorp.Transaction, event *config.Event, occurrence *time.Time, at time.Time) ([]*config.Attendee, error) {
+	query := "SELECT * FROM EVENT_ATTENDEE WHERE EVENT_ID = $1 AND OCCURRENCE IS NOT DISTINCT FROM $2 AND STATUS = $3 ORDER BY ID"
+	if event.Capacity > 0 {
+		registered, err := registeredAttendees(tx, event.ID, occurrence)
+		if err != nil || registered >= event.Capacity {
+			return nil, err
+		}
+		query += " LIMIT " + strconv.Itoa(event.Capacity-registered)
+	}
+	var promoted []*config.Attendee
+	if _, err := tx.Select(&promoted, query, event.ID, occurrence, config.AttendeeWaitlisted); err != nil {
+		return nil, err
+	}
+	for _, attendee := range promoted {
+		attendee.Status, attendee.PromotedAt = config.AttendeeRegistered, &at
+		if _, err := tx.Exec("UPDATE EVENT_ATTENDEE SET STATUS = $1, PROMOTED_AT = $2 WHERE ID = $3",
+			attendee.Status, attendee.PromotedAt, attendee.ID); err != nil {
+			return nil, err
+		}
+	}
+	return promoted, nil
+}
+
+// sameOccurrence - both are the same occurrence or nil.
+func sameOccurrence(a, b *time.Time) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && a.Equal(*b))
+}
+
+// firstOfOccurrence - none of attendees is of occurrence.
+func firstOfOccurrence(attendees []*config.Attendee, occurrence *time.Time) bool {
+	for _, attendee := range attendees {
+		if sameOccurrence(attendee.Occurrence, occurrence) {
+			return false
+		}
+	}
+	return true
+}
+
+//CheckInEventAttendee - record check-in of attendee at by session user. Tickets checked in before are
+//counted as scan and refused with DuplicateCheckInError, the attendee is returned in both cases.
+func (store *PgStore) CheckInEventAttendee(s *model.SessionContext, eventID, id string, at time.Time) (*config.Attendee, error) {
+	logutil.Debugf(s, "Store Layer - Check In Event Attendee")
+	var attendee *config.Attendee
+	duplicate := false
+	err := execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		// Locked, so that scans at two entrances at once check in only one of them.
+		if err := TenantTx(s, tx).SelectOne(&attendee, "attendee", id,
+			"SELECT * FROM EVENT_ATTENDEE WHERE ID = $1 AND EVENT_ID = $2 FOR UPDATE", id, eventID); err != nil {
+			return err
+		}
+		duplicate = checkIn(s, attendee, at)
+		_, err := tx.Exec("UPDATE EVENT_ATTENDEE SET SCANS = $1, CHECKED_IN_AT = $2, CHECKED_IN_BY = $3 WHERE ID = $4",
+			attendee.Scans, attendee.CheckedInAt, attendee.CheckedInBy, attendee.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if duplicate {
+		return attendee, &model.DuplicateCheckInError{AttendeeID: attendee.ID, At: *attendee.CheckedInAt, By: attendee.CheckedInBy}
+	}
+	return attendee, nil
+}
+
+// checkIn - counts the scan of the ticket of attendee at and checks it in by session user, true when
+// it was checked in before.
+func checkIn(s *model.SessionContext, attendee *config.Attendee, at time.Time) bool {
+	attendee.Scans++
+	if attendee.CheckedInAt != nil {
+		return true
+	}
+	attendee.CheckedInAt, attendee.CheckedInBy = &at, s.User.UserName
+	return false
+}
@@ -0,0 +1,80 @@
+package store
+
+import (
+	"fmt"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"strings"
+)
+
+// Page size of audit log queries without one, and the largest accepted.
+const (
+	DefaultAuditPageSize = 50
+	MaxAuditPageSize     = 10000
+)
+
+//AddAuditLog - appends entry to the audit log
+func (store *PgStore) AddAuditLog(entry *model.AuditLog) error {
+	return store.DB().Insert(entry)
+}
+
+//GetAuditLogs - page of audit log entries of tenant matching filter, newest first
+func (store *PgStore) GetAuditLogs(s *model.SessionContext, filter model.AuditFilter) (*model.AuditLogPage, error) {
+	logutil.Debugf(s, "Store Layer - Get Audit Logs")
+	filter = pageAuditFilter(filter)
+	var conds []string
+	var args []interface{}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.UserName != "" {
+		add("USER_NAME = $%d", filter.UserName)
+	}
+	if filter.Entity != "" {
+		add("ENTITY = $%d", filter.Entity)
+	}
+	if filter.EntityID != "" {
+		add("ENTITY_ID = $%d", filter.EntityID)
+	}
+	if filter.Action != "" {
+		add("ACTION = $%d", filter.Action)
+	}
+	if !filter.From.IsZero() {
+		add("ADDED_AT >= $%d", filter.From)
+	}
+	if !filter.To.IsZero() {
+		add("ADDED_AT < $%d", filter.To)
+	}
+	where := "TRUE"
+	if len(conds) > 0 {
+		where = strings.Join(conds, " AND ")
+	}
+
+	tenantDB := store.Tenant(s)
+	var total int
+	if err := tenantDB.SelectOne(&total, "audit log", "", "SELECT COUNT(*) FROM AUDIT_LOG WHERE "+where, args...); err != nil {
+		return nil, err
+	}
+	page := &model.AuditLogPage{Items: []*model.AuditLog{}, Total: total, Page: filter.Page, PageSize: filter.PageSize}
+	args = append(args, filter.PageSize, (filter.Page-1)*filter.PageSize)
+	query := fmt.Sprintf("SELECT * FROM AUDIT_LOG WHERE %s ORDER BY ID DESC LIMIT $%d OFFSET $%d", where, len(args)-1, len(args))
+	if err := tenantDB.Select(&page.Items, query, args...); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// pageAuditFilter - filter with page and page size defaulted and bounded.
+func pageAuditFilter(filter model.AuditFilter) model.AuditFilter {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = DefaultAuditPageSize
+	}
+	if filter.PageSize > MaxAuditPageSize {
+		filter.PageSize = MaxAuditPageSize
+	}
+	return filter
+}
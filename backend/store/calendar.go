@@ -0,0 +1,69 @@
+package store
+
+import (
+	"database/sql"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"time"
+
+	gorp "gopkg.in/gorp.v2"
+)
+
+//GetCalendarFeed - calendar feed of session user, hash is not returned
+func (store *PgStore) GetCalendarFeed(s *model.SessionContext) (*config.CalendarFeed, error) {
+	logutil.Debugf(s, "Store Layer - Get Calendar Feed")
+	var feed *config.CalendarFeed
+	err := store.DB().SelectOne(&feed, "SELECT * FROM CALENDAR_FEED WHERE TENANT_ID = $1 AND USER_NAME = $2",
+		s.User.TenantId, s.User.UserName)
+	if err == sql.ErrNoRows {
+		return nil, &model.NotFoundError{Entity: "calendar feed", ID: s.User.UserName}
+	}
+	if err != nil {
+		return nil, err
+	}
+	feed.Hash = ""
+	return feed, nil
+}
+
+//GetCalendarFeedByID - feed with hash for authenticating feed requests
+func (store *PgStore) GetCalendarFeedByID(id string) (*config.CalendarFeed, error) {
+	var feed *config.CalendarFeed
+	err := store.DB().SelectOne(&feed, "SELECT * FROM CALENDAR_FEED WHERE ID = $1", id)
+	if err == sql.ErrNoRows {
+		return nil, &model.NotFoundError{Entity: "calendar feed", ID: id}
+	}
+	return feed, err
+}
+
+//ReplaceCalendarFeed - feed of session user, replaces the previous one
+func (store *PgStore) ReplaceCalendarFeed(s *model.SessionContext, feed *config.CalendarFeed) error {
+	logutil.Debugf(s, "Store Layer - Replace Calendar Feed")
+	feed.TenantID, feed.UserName = s.User.TenantId, s.User.UserName
+	feed.AddedAt = time.Now()
+	return execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		if _, err := tx.Exec("DELETE FROM CALENDAR_FEED WHERE TENANT_ID = $1 AND USER_NAME = $2", feed.TenantID, feed.UserName); err != nil {
+			return err
+		}
+		return tx.Insert(feed)
+	})
+}
+
+//DeleteCalendarFeed - revoke calendar feed of session user
+func (store *PgStore) DeleteCalendarFeed(s *model.SessionContext) error {
+	logutil.Debugf(s, "Store Layer - Delete Calendar Feed")
+	result, err := store.DB().Exec("DELETE FROM CALENDAR_FEED WHERE TENANT_ID = $1 AND USER_NAME = $2", s.User.TenantId, s.User.UserName)
+	if err != nil {
+		return err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return &model.NotFoundError{Entity: "calendar feed", ID: s.User.UserName}
+	}
+	return nil
+}
+
+//TouchCalendarFeed - records use of feed
+func (store *PgStore) TouchCalendarFeed(id string, usedAt time.Time) error {
+	_, err := store.DB().Exec("UPDATE CALENDAR_FEED SET LAST_USED_AT = $1 WHERE ID = $2", usedAt, id)
+	return err
+}
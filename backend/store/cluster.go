@@ -4,57 +4,86 @@ import (
 	"nyota/backend/logutil"
 	"nyota/backend/model"
 	"nyota/backend/model/config"
+	"strconv"
 	"time"
+
+	gorp "gopkg.in/gorp.v2"
 )
 
-func (store *Store) GetClusters(s *model.SessionContext) ([]*config.Cluster, error) {
+func (store *PgStore) GetClusters(s *model.SessionContext, spec model.QuerySpec) ([]*config.Cluster, int, error) {
 
 	logutil.Debugf(s, "Store Layer - Get All Clusters")
 	var clusters []*config.Cluster
-	err := store.DB().Select(&clusters, "Select * from CCC_Cluster where tenant_id=$1", s.User.TenantId)
+	total, err := selectPage(store.DB(), &clusters, "CCC_Cluster", "tenant_id=$1", []interface{}{s.User.TenantId}, spec)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return clusters, nil
+	return clusters, total, nil
 }
 
-func (store *Store) GetClusterById(s *model.SessionContext, id string) (*config.Cluster, error) {
+func (store *PgStore) GetClusterById(s *model.SessionContext, id string) (*config.Cluster, error) {
 
 	logutil.Debugf(s, "Store Layer - Get Cluster By Id")
 	var cluster *config.Cluster
-	err := store.DB().SelectOne(&cluster, "Select * from CCC_Cluster where id=$1", id)
+	err := store.Tenant(s).SelectOne(&cluster, "cluster", id, "Select * from CCC_Cluster where id=$1", id)
 	if err != nil {
 		return nil, err
 	}
 	return cluster, nil
 }
 
-func (store *Store) UpsertCluster(s *model.SessionContext, data *config.Cluster) (*config.Cluster, error) {
+func (store *PgStore) UpsertCluster(s *model.SessionContext, data *config.Cluster) (*config.Cluster, error) {
 
 	logutil.Debugf(s, "Store Layer - Upsert Cluster")
+	data.UpdatedBy = s.User.UserName
 	if data.ID == 0 {
 		data.AddedAt = time.Now()
 		data.UpdatedAt = data.AddedAt
+		data.AddedBy = data.UpdatedBy
+		data.Version = 0
 		err := store.DB().Insert(data)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		data.UpdatedAt = time.Now()
-		_, err := store.DB().Update(data)
+		existing, err := store.GetClusterById(s, strconv.Itoa(data.ID))
 		if err != nil {
 			return nil, err
 		}
+		data.AddedAt, data.AddedBy = existing.AddedAt, existing.AddedBy
+		data.UpdatedAt = time.Now()
+		if data.Version == 0 {
+			data.Version = existing.Version
+		}
+		_, err = store.DB().Update(data)
+		if err != nil {
+			return nil, versionError("cluster", strconv.Itoa(data.ID), err)
+		}
 	}
 	return data, nil
 }
 
-func (store *Store) DeleteClusterById(s *model.SessionContext, id string) error {
+func (store *PgStore) DeleteClusterById(s *model.SessionContext, id string) error {
 
 	logutil.Debugf(s, "Store Layer - Delete Cluster By Id")
-	_, err := store.DB().Exec("Delete from CCC_Cluster where id=$1", id)
-	if err != nil {
-		return err
-	}
-	return nil
+	return execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		tenantTx := TenantTx(s, tx)
+		if err := tenantTx.Exists("cluster", id, "Select count(*) from CCC_Cluster where id=$1", id); err != nil {
+			return err
+		}
+		// Nodes, role and event mappings have no meaning without the cluster.
+		if _, err := tx.Exec("Delete from CCC_CPPM_Node where cluster_id=$1", id); err != nil {
+			logutil.Errorf(s, "Deletion failed for CPPM nodes of cluster.")
+			return err
+		}
+		if _, err := tx.Exec("Delete from CCC_Role_Cluster where cluster_id=$1", id); err != nil {
+			logutil.Errorf(s, "Deletion failed in mapping table of Role Cluster.")
+			return err
+		}
+		if _, err := tx.Exec("Delete from CCC_Event_Cluster where cluster_id=$1", id); err != nil {
+			logutil.Errorf(s, "Deletion failed in mapping table of Event Cluster.")
+			return err
+		}
+		return tenantTx.Exec("cluster", id, "Delete from CCC_Cluster where id=$1", id)
+	})
 }
@@ -4,69 +4,105 @@ import (
 	"nyota/backend/logutil"
 	"nyota/backend/model"
 	"nyota/backend/model/config"
+	"strconv"
 	"time"
+
+	gorp "gopkg.in/gorp.v2"
 )
 
-func (store *Store) GetCPPMNodes(s *model.SessionContext) ([]*config.CppmNode, error) {
+func (store *PgStore) GetCPPMNodes(s *model.SessionContext, spec model.QuerySpec) ([]*config.CppmNode, int, error) {
 	logutil.Debugf(s, "Store Layer - Get All CPPM Nodes")
 	var cppmNodes []*config.CppmNode
-	err := store.DB().Select(&cppmNodes, "SELECT * FROM CCC_CPPM_NODE WHERE TENANT_ID = $1", s.User.TenantId)
+	total, err := selectPage(store.DB(), &cppmNodes, "CCC_CPPM_NODE", "TENANT_ID = $1", []interface{}{s.User.TenantId}, spec)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return cppmNodes, nil
+	return cppmNodes, total, nil
 }
 
-func (store *Store) GetCPPMNodesForCluster(s *model.SessionContext, clusterId string) ([]*config.CppmNode, error) {
+func (store *PgStore) GetCPPMNodesForCluster(s *model.SessionContext, clusterId string) ([]*config.CppmNode, error) {
 	logutil.Debugf(s, "Store Layer - Get All CPPM Nodes By Cluster ID")
 	var cppmNodes []*config.CppmNode
-	err := store.DB().Select(&cppmNodes, "SELECT * FROM CCC_CPPM_NODE WHERE CLUSTER_ID = $1", clusterId)
+	err := store.DB().Select(&cppmNodes, "SELECT * FROM CCC_CPPM_NODE WHERE CLUSTER_ID = $1 AND TENANT_ID = $2", clusterId, s.User.TenantId)
 	if err != nil {
 		return nil, err
 	}
 	return cppmNodes, nil
 }
 
-func (store *Store) GetCPPMNodeById(s *model.SessionContext, id string) (*config.CppmNode, error) {
+func (store *PgStore) GetCPPMNodeById(s *model.SessionContext, id string) (*config.CppmNode, error) {
 	logutil.Debugf(s, "Store Layer - Get CPPM Node By Id")
 	var cppmNode *config.CppmNode
-	err := store.DB().SelectOne(&cppmNode, "SELECT * FROM CCC_CPPM_NODE WHERE ID = $1", id)
+	err := store.Tenant(s).SelectOne(&cppmNode, "cppm node", id, "SELECT * FROM CCC_CPPM_NODE WHERE ID = $1", id)
 	if err != nil {
 		return nil, err
 	}
 	return cppmNode, nil
 }
 
-func (store *Store) UpsertCPPMNode(s *model.SessionContext, data *config.CppmNode) (*config.CppmNode, error) {
+func (store *PgStore) UpsertCPPMNode(s *model.SessionContext, data *config.CppmNode) (*config.CppmNode, error) {
 	logutil.Debugf(s, "Store Layer - Upsert CPPM Node")
 
+	data.UpdatedBy = s.User.UserName
 	if data.ID == 0 {
 		data.AddedAt = time.Now()
 		data.UpdatedAt = data.AddedAt
+		data.AddedBy = data.UpdatedBy
+		data.Version = 0
 		err := store.DB().Insert(data)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		data.UpdatedAt = time.Now()
-		_, err := store.DB().Update(data)
+		existing, err := store.GetCPPMNodeById(s, strconv.Itoa(data.ID))
 		if err != nil {
 			return nil, err
 		}
+		data.AddedAt, data.AddedBy = existing.AddedAt, existing.AddedBy
+		data.UpdatedAt = time.Now()
+		if data.Version == 0 {
+			data.Version = existing.Version
+		}
+		_, err = store.DB().Update(data)
+		if err != nil {
+			return nil, versionError("cppm node", strconv.Itoa(data.ID), err)
+		}
 	}
 
 	return data, nil
 }
 
-func (store *Store) UpsertCPPMNodeEvent(s *model.SessionContext, data *config.CppmNode) error {
-	logutil.Debugf(s, "Store Layer - Upsert CPPM Node")
+//UpsertCPPMNodeEvents - insert or update nodes reported by CPPM, matched on server uuid. Nodes are
+//stored together or not at all.
+func (store *PgStore) UpsertCPPMNodeEvents(s *model.SessionContext, nodes []*config.CppmNode) error {
+	logutil.Debugf(s, "Store Layer - Upsert CPPM Nodes")
+	return execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		for _, data := range nodes {
+			if err := upsertCPPMNodeEvent(s, tx, data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func upsertCPPMNodeEvent(s *model.SessionContext, tx *gorp.Transaction, data *config.CppmNode) error {
 	var cppmNode *config.CppmNode
-	store.DB().SelectOne(&cppmNode, "SELECT * FROM CCC_CPPM_NODE WHERE SERVER_UUID = $1", data.ServerUUID)
+	err := TenantTx(s, tx).SelectOne(&cppmNode, "cppm node", data.ServerUUID, "SELECT * FROM CCC_CPPM_NODE WHERE SERVER_UUID = $1 AND CLUSTER_ID = $2",
+		data.ServerUUID, data.ClusterID)
+	if err != nil && !model.IsNotFound(err) {
+		logutil.Errorf(s, "error in CPPM Node lookup:%v", err)
+		return err
+	}
 
+	data.UpdatedBy = s.User.UserName
 	if nil != cppmNode {
 		data.ID = cppmNode.ID
+		data.AddedAt, data.AddedBy = cppmNode.AddedAt, cppmNode.AddedBy
 		data.UpdatedAt = time.Now()
-		_, err := store.DB().Update(data)
+		// Reports of CPPM replace the node whatever version it is at.
+		data.Version = cppmNode.Version
+		_, err := tx.Update(data)
 		if err != nil {
 			logutil.Errorf(s, "error in cluster update:%v", err)
 			return err
@@ -74,7 +110,9 @@ func (store *Store) UpsertCPPMNodeEvent(s *model.SessionContext, data *config.Cp
 	} else {
 		data.AddedAt = time.Now()
 		data.UpdatedAt = data.AddedAt
-		err := store.DB().Insert(data)
+		data.AddedBy = data.UpdatedBy
+		data.Version = 0
+		err := tx.Insert(data)
 		if err != nil {
 			logutil.Errorf(s, "error in CPPM Node insert:%v", err)
 			return err
@@ -83,35 +121,15 @@ func (store *Store) UpsertCPPMNodeEvent(s *model.SessionContext, data *config.Cp
 	return nil
 }
 
-func (store *Store) DeleteCPPMNode(s *model.SessionContext, id string) error {
+func (store *PgStore) DeleteCPPMNode(s *model.SessionContext, id string) error {
 	logutil.Debugf(s, "Store Layer - Delete CPPM Node By Id")
-	_, err := store.DB().Exec("DELETE FROM CCC_CPPM_NODE WHERE ID = $1", id)
-	if err != nil {
-		return err
-	}
-	return nil
+	return store.Tenant(s).Exec("cppm node", id, "DELETE FROM CCC_CPPM_NODE WHERE ID = $1", id)
 }
 
 //GetClusterByUUID - fetches cluster based on uuid
-func (store *Store) GetClusterByUUID(s *model.SessionContext, uuid string) *config.Cluster {
+func (store *PgStore) GetClusterByUUID(s *model.SessionContext, uuid string) *config.Cluster {
 	var cluster *config.Cluster
 	store.DB().SelectOne(&cluster, "SELECT * FROM CCC_CLUSTER WHERE UUID = $1", uuid)
 	logutil.Debugf(s, "cluster object :%v", cluster)
 	return cluster
 }
-
-//CreateAndFetchCluster = create a new one and send it back
-func (store *Store) CreateAndFetchCluster(s *model.SessionContext, event model.Event) *config.Cluster {
-	cluster := &config.Cluster{}
-	cluster.UUID = event.UUID
-	cluster.Name = event.UUID
-	cluster.CppmVersion = event.CPPMVersion
-	cluster.TenantID = event.TenantID
-	cluster.AddedAt = time.Now()
-	cluster.UpdatedAt = cluster.AddedAt
-	err := store.DB().Insert(cluster)
-	if err != nil {
-		logutil.Errorf(s, "error in cluster insert:%v", err)
-	}
-	return cluster
-}
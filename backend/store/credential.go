@@ -0,0 +1,69 @@
+package store
+
+import (
+	"database/sql"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"strconv"
+	"time"
+
+	gorp "gopkg.in/gorp.v2"
+)
+
+//GetClusterCredentials - credentials of cluster, secrets are not returned
+func (store *PgStore) GetClusterCredentials(s *model.SessionContext, clusterID string) ([]*config.ClusterCredential, error) {
+	logutil.Debugf(s, "Store Layer - Get Cluster Credentials")
+	var credentials []*config.ClusterCredential
+	err := store.Tenant(s).Select(&credentials, "SELECT * FROM CCC_CLUSTER_CREDENTIAL WHERE CLUSTER_ID = $1 ORDER BY ADDED_AT", clusterID)
+	if err != nil {
+		return nil, err
+	}
+	for _, credential := range credentials {
+		credential.Secret = ""
+	}
+	return credentials, nil
+}
+
+//AddClusterCredential - insert credential for a cluster of the session tenant
+func (store *PgStore) AddClusterCredential(s *model.SessionContext, credential *config.ClusterCredential) error {
+	logutil.Debugf(s, "Store Layer - Add Cluster Credential")
+	return execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		clusterID := strconv.Itoa(credential.ClusterID)
+		if err := TenantTx(s, tx).Exists("cluster", clusterID, "SELECT COUNT(*) FROM CCC_CLUSTER WHERE ID = $1", clusterID); err != nil {
+			return err
+		}
+		credential.AddedAt = time.Now()
+		return tx.Insert(credential)
+	})
+}
+
+//DeleteClusterCredential - revoke credential of cluster
+func (store *PgStore) DeleteClusterCredential(s *model.SessionContext, clusterID, keyID string) error {
+	logutil.Debugf(s, "Store Layer - Delete Cluster Credential")
+	return store.Tenant(s).Exec("cluster credential", keyID, "DELETE FROM CCC_CLUSTER_CREDENTIAL WHERE KEY_ID = $1 AND CLUSTER_ID = $2", keyID, clusterID)
+}
+
+//GetClusterCredentialByKey - credential with secret for verifying signed requests
+func (store *PgStore) GetClusterCredentialByKey(keyID string) (*config.ClusterCredential, error) {
+	var credential *config.ClusterCredential
+	err := store.DB().SelectOne(&credential, "SELECT * FROM CCC_CLUSTER_CREDENTIAL WHERE KEY_ID = $1", keyID)
+	if err == sql.ErrNoRows {
+		return nil, &model.NotFoundError{Entity: "cluster credential", ID: keyID}
+	}
+	return credential, err
+}
+
+//UseNonce - records nonce of key, false when it was already used within window
+func (store *PgStore) UseNonce(keyID, nonce string, seenAt time.Time, window time.Duration) (bool, error) {
+	if _, err := store.DB().Exec("DELETE FROM CCC_EVENT_NONCE WHERE SEEN_AT < $1", seenAt.Add(-window)); err != nil {
+		return false, err
+	}
+	res, err := store.DB().Exec("INSERT INTO CCC_EVENT_NONCE (KEY_ID, NONCE, SEEN_AT) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+		keyID, nonce, seenAt)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
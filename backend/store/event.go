@@ -1,27 +1,23 @@
 package store
 
 import (
-	"image"
+	"nyota/backend/auth"
 	"nyota/backend/logutil"
 	"nyota/backend/model"
 	"nyota/backend/model/config"
 	"strconv"
 	"time"
 
-	"os"
-
-	qrcode "github.com/skip2/go-qrcode"
-
 	gorp "gopkg.in/gorp.v2"
 )
 
-//GetAllEvents - get all events with Event details
-func (store *Store) GetAllEvents(s *model.SessionContext) ([]*config.Event, error) {
+//GetAllEvents - page of events of user matching spec, with the number of all matching events
+func (store *PgStore) GetAllEvents(s *model.SessionContext, spec model.QuerySpec) ([]*config.Event, int, error) {
 	logutil.Debugf(s, "Store Layer - Get All Events")
 	var events []*config.Event
-	err := store.DB().Select(&events, "Select * from Events where username=$1", s.User.UserName)
+	total, err := selectPage(store.DB(), &events, "Events", "username=$1", []interface{}{s.User.UserName}, spec)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// for _, event := range events {
@@ -34,61 +30,75 @@ func (store *Store) GetAllEvents(s *model.SessionContext) ([]*config.Event, erro
 	// 	}
 	// 	event.Clusters = clusters
 	// }
-	return events, nil
+	return events, total, nil
 }
 
 //GetEventByID - get event based on id
-func (store *Store) GetEventByID(s *model.SessionContext, id string) (*config.Event, error) {
+func (store *PgStore) GetEventByID(s *model.SessionContext, id string) (*config.Event, error) {
 	logutil.Debugf(s, "Store Layer - Get Event By Id")
 	var event *config.Event
-	err := store.DB().SelectOne(&event, "Select * from Events where id=$1", id)
+	err := store.Tenant(s).SelectOne(&event, "event", id, "Select * from Events where id=$1", id)
 	if err != nil {
 		return nil, err
 	}
 
-	return event, nil
-}
-
-//GetEventQRByID - get event qr based on id
-func (store *Store) GetEventQrByID(s *model.SessionContext, id string) (*image.Image, error) {
-	logutil.Debugf(s, "Store Layer - Get Event Qr By Id")
-
-	infile, err := os.Open("./qr/" + id + "-.png")
+	var clusters []*config.Cluster
+	err = store.DB().Select(&clusters, `SELECT cluster.* FROM ccc_cluster cluster
+		JOIN ccc_event_cluster event_cluster on cluster.id = event_cluster.cluster_id
+		WHERE event_cluster.event_id = $1 and event_cluster.tenant_id = $2`, event.ID, event.TenantID)
 	if err != nil {
 		return nil, err
 	}
-	defer infile.Close()
+	event.Clusters = clusters
 
-	src, _, err := image.Decode(infile)
-	if err != nil {
-		return nil, err
-	}
-
-	return &src, nil
+	return event, nil
 }
 
 //UpsertEvent - insert or update event
-func (store *Store) UpsertEvent(s *model.SessionContext, event *config.Event) error {
+func (store *PgStore) UpsertEvent(s *model.SessionContext, event *config.Event) error {
 	logutil.Debugf(s, "Store Layer - Upsert All Events")
 	err := execTx(s, store.DB(), func(tx *gorp.Transaction) (err error) {
 
 		// upsert segment
+		event.UpdatedBy = s.User.UserName
 		if event.ID == 0 {
 			event.AddedAt = time.Now()
 			event.UpdatedAt = event.AddedAt
-			err = tx.Insert(event)
-			if err == nil {
-				err = qrcode.WriteFile("http://google.com/search?q="+strconv.Itoa(event.ID), qrcode.Medium, 256, "./qr/"+strconv.Itoa(event.ID)+"-.png")
+			event.AddedBy = event.UpdatedBy
+			event.Version = 0
+			if event.TicketKey, err = auth.RandomToken(32); err != nil {
+				return err
 			}
+			err = tx.Insert(event)
 		} else {
+			id := strconv.Itoa(event.ID)
+			var existing *config.Event
+			if err = TenantTx(s, tx).SelectOne(&existing, "event", id, "select * from events where id=$1", id); err != nil {
+				return err
+			}
+			event.AddedAt, event.AddedBy = existing.AddedAt, existing.AddedBy
+			event.TicketKey = existing.TicketKey
 			event.UpdatedAt = time.Now()
+			if event.Version == 0 {
+				event.Version = existing.Version
+			}
 			_, err = tx.Update(event)
+			err = versionError("event", id, err)
 		}
 
 		if err != nil {
 			logutil.Errorf(s, "upsert event:(%s) failed: %v", event.Name, err)
 			return err
 		}
+
+		// Sync events are committed with the change, the dispatcher delivers them to the clusters.
+		diff, err := eventClusterLinks.sync(s, tx, event.TenantID, event.ID, linkedClusterIDs(event.Clusters))
+		if err != nil {
+			return err
+		}
+		if err = insertOutboxEvents(s, tx, upsertSyncEvents(event, event.TenantID, event.ID, diff)); err != nil {
+			return err
+		}
 		logutil.Debugf(s, "Upsert Event Successful")
 		return nil
 	})
@@ -96,23 +106,33 @@ func (store *Store) UpsertEvent(s *model.SessionContext, event *config.Event) er
 	return err
 }
 
-func (store *Store) DeleteEvent(s *model.SessionContext, id string) error {
+func (store *PgStore) DeleteEvent(s *model.SessionContext, id string) error {
 	logutil.Debugf(s, "Store Layer - Delete Event By Id")
-	_, errDelEventCluster := store.DB().Exec("DELETE FROM CCC_ROLE_CLUSTER WHERE ROLE_ID = $1 and TENANT_ID = $2", id, s.User.TenantId)
-	if errDelEventCluster != nil {
-		logutil.Debugf(s, "Deletion failed in mapping table of Event Cluster.")
-		return errDelEventCluster
-	}
-	_, errDelEvent := store.DB().Exec("DELETE FROM CCC_ROLE WHERE ID = $1", id)
-	if errDelEvent != nil {
-		logutil.Errorf(s, "Event deletion failed.")
-		return errDelEvent
-	}
-	return nil
+	return execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		tenantTx := TenantTx(s, tx)
+		var event *config.Event
+		if err := tenantTx.SelectOne(&event, "event", id, "SELECT * FROM EVENTS WHERE ID = $1", id); err != nil {
+			return err
+		}
+
+		// Clusters which have the event get a delete event.
+		diff, err := eventClusterLinks.sync(s, tx, event.TenantID, event.ID, nil)
+		if err != nil {
+			return err
+		}
+		if err := insertOutboxEvents(s, tx, upsertSyncEvents(event, event.TenantID, event.ID, diff)); err != nil {
+			return err
+		}
+		if err := tenantTx.Exec("event", id, "DELETE FROM EVENTS WHERE ID = $1", id); err != nil {
+			logutil.Errorf(s, "Event deletion failed.")
+			return err
+		}
+		return nil
+	})
 }
 
 /*
-func (store *Store) GetEventCluster(eventID int, clusterID int, tenantID string) *config.EventCluster {
+func (store *PgStore) GetEventCluster(eventID int, clusterID int, tenantID string) *config.EventCluster {
 	var eventCluster *config.EventCluster
 	err := store.DB().Select(&eventCluster, "SELECT * from ccc_event_cluster WHERE event_id = $1 and cluster_id=$2 and tenant_id = $3", eventID, clusterID, tenantID)
 	if err != nil {
@@ -122,7 +142,7 @@ func (store *Store) GetEventCluster(eventID int, clusterID int, tenantID string)
 }
 */
 
-func (store *Store) GetEventClusterCPPMID(eventID int, clusterID int, tenantID string) int {
+func (store *PgStore) GetEventClusterCPPMID(eventID int, clusterID int, tenantID string) int {
 	rows, err := store.DB().Query(`SELECT cppm_id from ccc_event_cluster WHERE event_id = $1 and cluster_id=$2 and tenant_id = $3`, eventID, clusterID, tenantID)
 	if err != nil {
 		return 0
@@ -135,10 +155,15 @@ func (store *Store) GetEventClusterCPPMID(eventID int, clusterID int, tenantID s
 	return cppmID
 }
 
-func (store *Store) UpdateEventWithCPPMID(s *model.SessionContext, eventID int, uuid string, cppmID int) {
-	res, err := store.DB().Exec("UPDATE CCC_ROLE_CLUSTER SET CPPM_ID=$1 WHERE ROLE_ID = $2 AND CLUSTER_ID = (SELECT ID FROM CCC_CLUSTER WHERE UUID=$3)", cppmID, eventID, uuid)
+//UpdateEventWithCPPMID - record CPPM id of event for cluster uuid, NotFoundError when event is not mapped to cluster
+func (store *PgStore) UpdateEventWithCPPMID(s *model.SessionContext, eventID int, uuid string, cppmID int) error {
+	var cluster *config.Cluster
+	err := store.Tenant(s).SelectOne(&cluster, "cluster", uuid, "SELECT * FROM CCC_CLUSTER WHERE UUID = $1", uuid)
+	if nil == err {
+		err = store.Tenant(s).Exec("event", strconv.Itoa(eventID), "UPDATE CCC_EVENT_CLUSTER SET CPPM_ID=$1 WHERE EVENT_ID = $2 AND CLUSTER_ID = $3", cppmID, eventID, cluster.ID)
+	}
 	if nil != err {
-		logutil.Errorf(s, "CPPM ID updation failed in event cluster association table")
+		logutil.Errorf(s, "CPPM ID updation failed in event cluster association table: %v", err)
 	}
-	logutil.Debugf(s, "Result - %v", res)
+	return err
 }
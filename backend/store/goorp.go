@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"nyota/backend/model/config"
+	"time"
 
 	"github.com/lib/pq"
 
@@ -21,7 +22,7 @@ func (tc gorpTypeConverter) ToDb(val interface{}) (interface{}, error) {
 	case PgStringArray:
 		return pq.Array(val), nil
 
-	case []string,
+	case []string, []time.Time,
 		config.ExtraParam, map[string]interface{}, map[string]string:
 
 		js, err := json.Marshal(t)
@@ -55,7 +56,7 @@ func (tc gorpTypeConverter) FromDb(target interface{}) (gorp.CustomScanner, bool
 		}
 		return gorp.CustomScanner{Holder: new(string), Target: target, Binder: binder}, true
 
-	case *[]string,
+	case *[]string, *[]time.Time,
 		*config.ExtraParam, *map[string]interface{}, *map[string]string:
 		binder := func(holder, target interface{}) error {
 			js, ok := holder.(*string)
@@ -0,0 +1,62 @@
+package store
+
+import (
+	"database/sql"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"time"
+
+	gorp "gopkg.in/gorp.v2"
+)
+
+//GetIdentityProviders - identity providers of tenant
+func (store *PgStore) GetIdentityProviders(s *model.SessionContext) ([]*model.IdentityProvider, error) {
+	logutil.Debugf(s, "Store Layer - Get Identity Providers")
+	var idps []*model.IdentityProvider
+	if err := store.Tenant(s).Select(&idps, "SELECT * FROM IDENTITY_PROVIDER ORDER BY NAME"); err != nil {
+		return nil, err
+	}
+	return idps, nil
+}
+
+//GetIdentityProviderByID - identity provider for single sign-on of any tenant
+func (store *PgStore) GetIdentityProviderByID(id string) (*model.IdentityProvider, error) {
+	var idp *model.IdentityProvider
+	err := store.DB().SelectOne(&idp, "SELECT * FROM IDENTITY_PROVIDER WHERE ID = $1", id)
+	if err == sql.ErrNoRows {
+		return nil, &model.NotFoundError{Entity: "identity provider", ID: id}
+	}
+	return idp, err
+}
+
+//UpsertIdentityProvider - insert or update identity provider of tenant
+func (store *PgStore) UpsertIdentityProvider(s *model.SessionContext, idp *model.IdentityProvider) error {
+	logutil.Debugf(s, "Store Layer - Upsert Identity Provider")
+	return execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		var existing *model.IdentityProvider
+		err := tx.SelectOne(&existing, "SELECT * FROM IDENTITY_PROVIDER WHERE ID = $1", idp.ID)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		idp.UpdatedAt = time.Now()
+		idp.UpdatedBy = s.User.UserName
+		if existing == nil {
+			idp.AddedAt = idp.UpdatedAt
+			idp.AddedBy = s.User.UserName
+			return tx.Insert(idp)
+		}
+		if existing.TenantID != idp.TenantID {
+			return &model.NotFoundError{Entity: "identity provider", ID: idp.ID}
+		}
+		idp.AddedAt = existing.AddedAt
+		idp.AddedBy = existing.AddedBy
+		_, err = tx.Update(idp)
+		return err
+	})
+}
+
+//DeleteIdentityProvider - remove identity provider of tenant, its users stay until deleted
+func (store *PgStore) DeleteIdentityProvider(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Store Layer - Delete Identity Provider")
+	return store.Tenant(s).Exec("identity provider", id, "DELETE FROM IDENTITY_PROVIDER WHERE ID = $1", id)
+}
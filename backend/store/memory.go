@@ -0,0 +1,1628 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"nyota/backend/auth"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// MemStore implements Store in memory. It mirrors the queries of PgStore and is meant for
+// hermetic tests and local runs without postgres. Data is lost on restart.
+type MemStore struct {
+	mu sync.RWMutex
+
+	lastID        int
+	tenants       map[string]*config.Tenant
+	clusters      map[int]*config.Cluster
+	cppmNodes     map[int]*config.CppmNode
+	roles         map[int]*config.Role
+	roleClusters  map[int][]*clusterLink // role id -> mappings
+	events        map[int]*config.Event
+	eventClusters map[int][]*clusterLink // event id -> mappings
+	attendees     map[int]*config.Attendee
+	occurrences   map[string]*config.EventOccurrence // event id + "/" + recurrence id -> override
+	users         map[string]*model.UserTenantDetails
+	credentials   map[string]*config.ClusterCredential
+	nonces        map[string]time.Time // key id + nonce -> seen at
+	outbox        map[int]*config.OutboxEvent
+	auditLogs     []*model.AuditLog
+	adminRoles    map[string]*model.AdminRole // tenant id + "/" + name -> role
+	apiTokens     map[string]*model.APIToken
+	calendarFeeds map[string]*config.CalendarFeed
+	idps          map[string]*model.IdentityProvider
+	sessions      map[string]*model.UserSession
+	mfa           map[string]*model.UserMFA
+}
+
+var (
+	_ Store = (*PgStore)(nil)
+	_ Store = (*MemStore)(nil)
+)
+
+// NewMemStore - empty in memory store.
+func NewMemStore() *MemStore {
+	return &MemStore{
+		tenants:       make(map[string]*config.Tenant),
+		clusters:      make(map[int]*config.Cluster),
+		cppmNodes:     make(map[int]*config.CppmNode),
+		roles:         make(map[int]*config.Role),
+		roleClusters:  make(map[int][]*clusterLink),
+		events:        make(map[int]*config.Event),
+		eventClusters: make(map[int][]*clusterLink),
+		attendees:     make(map[int]*config.Attendee),
+		occurrences:   make(map[string]*config.EventOccurrence),
+		users:         make(map[string]*model.UserTenantDetails),
+		credentials:   make(map[string]*config.ClusterCredential),
+		nonces:        make(map[string]time.Time),
+		outbox:        make(map[int]*config.OutboxEvent),
+		adminRoles:    make(map[string]*model.AdminRole),
+		apiTokens:     make(map[string]*model.APIToken),
+		calendarFeeds: make(map[string]*config.CalendarFeed),
+		idps:          make(map[string]*model.IdentityProvider),
+		sessions:      make(map[string]*model.UserSession),
+		mfa:           make(map[string]*model.UserMFA),
+	}
+}
+
+// nextID - serial id generator shared by all entities, caller must hold the write lock.
+func (store *MemStore) nextID() int {
+	store.lastID++
+	return store.lastID
+}
+
+// visible - row of tenantID can be accessed by session, mirrors TenantDB scoping.
+func visible(s *model.SessionContext, tenantID string) bool {
+	return s.User.IsSuperAdmin || tenantID == s.User.TenantId
+}
+
+func notFound(entity string, id interface{}) error {
+	return &model.NotFoundError{Entity: entity, ID: fmt.Sprint(id)}
+}
+
+// uniqueViolation - error postgres gives for rows clashing with constraint, reported as conflict.
+func uniqueViolation(constraint string) error {
+	return &pq.Error{Code: "23505", Constraint: constraint}
+}
+
+// nextVersion - version of a row at current after an update based on expected, any version when 0.
+// VersionMismatchError when expected is outdated, as gorp version columns do for PgStore.
+func nextVersion(entity string, id interface{}, expected, current int) (int, error) {
+	if expected != 0 && expected != current {
+		return 0, &model.VersionMismatchError{Entity: entity, ID: fmt.Sprint(id), Version: expected}
+	}
+	return current + 1, nil
+}
+
+func memID(id string) int {
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+//GetAllRoles - page of roles of tenant matching spec, with the number of all matching roles
+func (store *MemStore) GetAllRoles(s *model.SessionContext, spec model.QuerySpec) ([]*config.Role, int, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get All Roles")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var roles []*config.Role
+	for _, id := range sortedKeys(store.roles) {
+		if role := store.roles[id]; role.TenantID == s.User.TenantId {
+			roles = append(roles, copyRole(role))
+		}
+	}
+	page, total := pageRows(roles, spec)
+	return page.([]*config.Role), total, nil
+}
+
+//GetRoleByID - get role with its clusters
+func (store *MemStore) GetRoleByID(s *model.SessionContext, id string) (*config.Role, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Role By Id")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	role, ok := store.roles[memID(id)]
+	if !ok || !visible(s, role.TenantID) {
+		return nil, notFound("role", id)
+	}
+	data := copyRole(role)
+	for _, link := range store.roleClusters[role.ID] {
+		if cluster, ok := store.clusters[link.ClusterID]; ok {
+			data.Clusters = append(data.Clusters, copyCluster(cluster))
+		}
+	}
+	return data, nil
+}
+
+//UpsertRole - insert or update role and sync its cluster mappings
+func (store *MemStore) UpsertRole(s *model.SessionContext, role *config.Role) error {
+	logutil.Debugf(s, "Mem Store Layer - Upsert Role")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	role.UpdatedBy = s.User.UserName
+	if role.ID == 0 {
+		role.ID = store.nextID()
+		role.AddedAt = time.Now()
+		role.UpdatedAt = role.AddedAt
+		role.AddedBy = role.UpdatedBy
+		role.Version = 1
+	} else {
+		existing, ok := store.roles[role.ID]
+		if !ok || !visible(s, existing.TenantID) {
+			return notFound("role", role.ID)
+		}
+		version, err := nextVersion("role", role.ID, role.Version, existing.Version)
+		if err != nil {
+			return err
+		}
+		role.AddedAt, role.AddedBy = existing.AddedAt, existing.AddedBy
+		role.UpdatedAt = time.Now()
+		role.Version = version
+	}
+	diff, err := store.syncClusterLinks(s, store.roleClusters, role.TenantID, role.ID, linkedClusterIDs(role.Clusters))
+	if err != nil {
+		return err
+	}
+	store.roles[role.ID] = copyRole(role)
+	store.addOutboxEvents(upsertSyncEvents(role, role.TenantID, role.ID, diff))
+	return nil
+}
+
+//DeleteRole - delete role and its cluster mappings
+func (store *MemStore) DeleteRole(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete Role By Id")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	role, ok := store.roles[memID(id)]
+	if !ok || !visible(s, role.TenantID) {
+		return notFound("role", id)
+	}
+	diff, _ := store.syncClusterLinks(s, store.roleClusters, role.TenantID, role.ID, nil)
+	store.addOutboxEvents(upsertSyncEvents(role, role.TenantID, role.ID, diff))
+	delete(store.roles, memID(id))
+	return nil
+}
+
+//GetRoleClusterCPPMID - CPPM id of role in cluster, 0 if not yet synced
+func (store *MemStore) GetRoleClusterCPPMID(roleID int, clusterID int, tenantID string) int {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	for _, link := range store.roleClusters[roleID] {
+		if link.ClusterID == clusterID && link.TenantID == tenantID {
+			return link.CppmID
+		}
+	}
+	return 0
+}
+
+//UpdateRoleWithCPPMID - record CPPM id of role for cluster uuid, NotFoundError when role is not mapped to cluster
+func (store *MemStore) UpdateRoleWithCPPMID(s *model.SessionContext, roleID int, uuid string, cppmID int) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if !store.updateCPPMID(s, store.roleClusters[roleID], uuid, cppmID) {
+		return notFound("role", roleID)
+	}
+	return nil
+}
+
+// updateCPPMID - records cppmID on the link of links to cluster uuid, false when there is none visible.
+func (store *MemStore) updateCPPMID(s *model.SessionContext, links []*clusterLink, uuid string, cppmID int) bool {
+	updated := false
+	for _, link := range links {
+		if cluster, ok := store.clusters[link.ClusterID]; ok && cluster.UUID == uuid && visible(s, link.TenantID) {
+			link.CppmID = cppmID
+			updated = true
+		}
+	}
+	return updated
+}
+
+//GetAllEvents - page of events of user matching spec, with the number of all matching events
+func (store *MemStore) GetAllEvents(s *model.SessionContext, spec model.QuerySpec) ([]*config.Event, int, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get All Events")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var events []*config.Event
+	for _, id := range sortedKeys(store.events) {
+		if event := store.events[id]; event.UserName == s.User.UserName {
+			data := *event
+			events = append(events, &data)
+		}
+	}
+	page, total := pageRows(events, spec)
+	return page.([]*config.Event), total, nil
+}
+
+//GetEventByID - get event based on id
+func (store *MemStore) GetEventByID(s *model.SessionContext, id string) (*config.Event, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Event By Id")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	event, ok := store.events[memID(id)]
+	if !ok || !visible(s, event.TenantID) {
+		return nil, notFound("event", id)
+	}
+	data := *event
+	for _, link := range store.eventClusters[event.ID] {
+		if cluster, ok := store.clusters[link.ClusterID]; ok {
+			data.Clusters = append(data.Clusters, copyCluster(cluster))
+		}
+	}
+	return &data, nil
+}
+
+//UpsertEvent - insert or update event
+func (store *MemStore) UpsertEvent(s *model.SessionContext, event *config.Event) error {
+	logutil.Debugf(s, "Mem Store Layer - Upsert Event")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	event.UpdatedBy = s.User.UserName
+	if event.ID == 0 {
+		event.ID = store.nextID()
+		event.AddedAt = time.Now()
+		event.UpdatedAt = event.AddedAt
+		event.AddedBy = event.UpdatedBy
+		event.Version = 1
+		key, err := auth.RandomToken(32)
+		if err != nil {
+			return err
+		}
+		event.TicketKey = key
+	} else {
+		existing, ok := store.events[event.ID]
+		if !ok || !visible(s, existing.TenantID) {
+			return notFound("event", event.ID)
+		}
+		version, err := nextVersion("event", event.ID, event.Version, existing.Version)
+		if err != nil {
+			return err
+		}
+		event.AddedAt, event.AddedBy = existing.AddedAt, existing.AddedBy
+		event.TicketKey = existing.TicketKey
+		event.UpdatedAt = time.Now()
+		event.Version = version
+	}
+	diff, err := store.syncClusterLinks(s, store.eventClusters, event.TenantID, event.ID, linkedClusterIDs(event.Clusters))
+	if err != nil {
+		return err
+	}
+	data := *event
+	data.Clusters = nil
+	store.events[event.ID] = &data
+	store.addOutboxEvents(upsertSyncEvents(event, event.TenantID, event.ID, diff))
+	return nil
+}
+
+//DeleteEvent - delete event
+func (store *MemStore) DeleteEvent(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete Event By Id")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	event, ok := store.events[memID(id)]
+	if !ok || !visible(s, event.TenantID) {
+		return notFound("event", id)
+	}
+	diff, _ := store.syncClusterLinks(s, store.eventClusters, event.TenantID, event.ID, nil)
+	store.addOutboxEvents(upsertSyncEvents(event, event.TenantID, event.ID, diff))
+	delete(store.events, memID(id))
+	for attendeeID, attendee := range store.attendees {
+		if attendee.EventID == event.ID {
+			delete(store.attendees, attendeeID)
+		}
+	}
+	for key, occurrence := range store.occurrences {
+		if occurrence.EventID == event.ID {
+			delete(store.occurrences, key)
+		}
+	}
+	return nil
+}
+
+func occurrenceKey(eventID int, recurrenceID time.Time) string {
+	return strconv.Itoa(eventID) + "/" + strconv.FormatInt(recurrenceID.UnixNano(), 10)
+}
+
+//GetEventOccurrences - overrides of occurrences of event in order of their recurrence id
+func (store *MemStore) GetEventOccurrences(s *model.SessionContext, eventID string) ([]*config.EventOccurrence, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Event Occurrences")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var occurrences []*config.EventOccurrence
+	for _, occurrence := range store.occurrences {
+		if occurrence.EventID == memID(eventID) && visible(s, occurrence.TenantID) {
+			data := *occurrence
+			occurrences = append(occurrences, &data)
+		}
+	}
+	sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].RecurrenceID.Before(occurrences[j].RecurrenceID) })
+	return occurrences, nil
+}
+
+//UpsertEventOccurrence - insert or replace override of an occurrence of an event of the session tenant
+func (store *MemStore) UpsertEventOccurrence(s *model.SessionContext, occurrence *config.EventOccurrence) error {
+	logutil.Debugf(s, "Mem Store Layer - Upsert Event Occurrence")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if event, ok := store.events[occurrence.EventID]; !ok || !visible(s, event.TenantID) {
+		return notFound("event", occurrence.EventID)
+	}
+	occurrence.UpdatedAt = time.Now()
+	occurrence.UpdatedBy = s.User.UserName
+	data := *occurrence
+	data.Overridden, data.Attendance = false, nil
+	store.occurrences[occurrenceKey(occurrence.EventID, occurrence.RecurrenceID)] = &data
+	return nil
+}
+
+//DeleteEventOccurrence - remove override, the occurrence is as the rule gives it again
+func (store *MemStore) DeleteEventOccurrence(s *model.SessionContext, eventID string, recurrenceID time.Time) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete Event Occurrence")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	key := occurrenceKey(memID(eventID), recurrenceID)
+	if occurrence, ok := store.occurrences[key]; !ok || !visible(s, occurrence.TenantID) {
+		return notFound("occurrence", recurrenceID.Format(time.RFC3339))
+	}
+	delete(store.occurrences, key)
+	return nil
+}
+
+//UpdateEventWithCPPMID - record CPPM id of event for cluster uuid, NotFoundError when event is not mapped to cluster
+func (store *MemStore) UpdateEventWithCPPMID(s *model.SessionContext, eventID int, uuid string, cppmID int) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if !store.updateCPPMID(s, store.eventClusters[eventID], uuid, cppmID) {
+		return notFound("event", eventID)
+	}
+	return nil
+}
+
+//GetEventAttendees - attendees of event in order of registration
+func (store *MemStore) GetEventAttendees(s *model.SessionContext, eventID string) ([]*config.Attendee, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Event Attendees")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var attendees []*config.Attendee
+	for _, id := range sortedKeys(store.attendees) {
+		if attendee := store.attendees[id]; attendee.EventID == memID(eventID) && visible(s, attendee.TenantID) {
+			data := *attendee
+			attendees = append(attendees, &data)
+		}
+	}
+	return attendees, nil
+}
+
+//GetEventAttendee - attendee of event
+func (store *MemStore) GetEventAttendee(s *model.SessionContext, eventID, id string) (*config.Attendee, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Event Attendee")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	attendee, ok := store.attendees[memID(id)]
+	if !ok || attendee.EventID != memID(eventID) || !visible(s, attendee.TenantID) {
+		return nil, notFound("attendee", id)
+	}
+	data := *attendee
+	return &data, nil
+}
+
+//GetEventAttendance - number of registered, checked in and waitlisted attendees of event
+func (store *MemStore) GetEventAttendance(s *model.SessionContext, eventID string) (*config.EventAttendance, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Event Attendance")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	attendance := &config.EventAttendance{}
+	for _, attendee := range store.attendees {
+		if attendee.EventID == memID(eventID) && visible(s, attendee.TenantID) {
+			attendance.Count(attendee)
+		}
+	}
+	return attendance, nil
+}
+
+//AddEventAttendee - register attendee for an event of the session tenant, emails are unique per
+//occurrence of the event. Attendees beyond the capacity of the occurrence are waitlisted.
+func (store *MemStore) AddEventAttendee(s *model.SessionContext, attendee *config.Attendee) error {
+	logutil.Debugf(s, "Mem Store Layer - Add Event Attendee")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	event, ok := store.events[attendee.EventID]
+	if !ok || !visible(s, event.TenantID) {
+		return notFound("event", attendee.EventID)
+	}
+	for _, existing := range store.attendees {
+		if existing.EventID == attendee.EventID && existing.Email == attendee.Email && sameOccurrence(existing.Occurrence, attendee.Occurrence) {
+			return uniqueViolation("event_attendee_occurrence_email_key")
+		}
+	}
+	attendee.Status = config.AttendeeRegistered
+	if event.Capacity > 0 && len(store.occurrenceAttendees(event.ID, attendee.Occurrence, config.AttendeeRegistered)) >= event.Capacity {
+		attendee.Status = config.AttendeeWaitlisted
+	}
+	attendee.ID = store.nextID()
+	attendee.RegisteredAt = time.Now()
+	attendee.RegisteredBy = s.User.UserName
+	attendee.CheckedInAt, attendee.CheckedInBy, attendee.Scans, attendee.PromotedAt = nil, "", 0, nil
+	data := *attendee
+	store.attendees[attendee.ID] = &data
+	return nil
+}
+
+//DeleteEventAttendee - cancel registration, the ticket of attendee is no longer accepted. The place
+//goes to the first waitlisted attendee of the occurrence, promoted attendees are returned.
+func (store *MemStore) DeleteEventAttendee(s *model.SessionContext, eventID, id string) ([]*config.Attendee, error) {
+	logutil.Debugf(s, "Mem Store Layer - Delete Event Attendee")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	attendee, ok := store.attendees[memID(id)]
+	if !ok || attendee.EventID != memID(eventID) || !visible(s, attendee.TenantID) {
+		return nil, notFound("attendee", id)
+	}
+	delete(store.attendees, attendee.ID)
+	if attendee.Status != config.AttendeeRegistered {
+		return nil, nil
+	}
+	return store.promoteWaitlisted(store.events[attendee.EventID], attendee.Occurrence, time.Now()), nil
+}
+
+//PromoteEventAttendees - move waitlisted attendees of event to free places of their occurrence, after
+//the capacity was raised. Promoted attendees are returned.
+func (store *MemStore) PromoteEventAttendees(s *model.SessionContext, eventID string) ([]*config.Attendee, error) {
+	logutil.Debugf(s, "Mem Store Layer - Promote Event Attendees")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	event, ok := store.events[memID(eventID)]
+	if !ok || !visible(s, event.TenantID) {
+		return nil, notFound("event", eventID)
+	}
+	var waitlisted []*config.Attendee
+	for _, id := range sortedKeys(store.attendees) {
+		if attendee := store.attendees[id]; attendee.EventID == event.ID && attendee.Status == config.AttendeeWaitlisted {
+			waitlisted = append(waitlisted, attendee)
+		}
+	}
+	var promoted []*config.Attendee
+	now := time.Now()
+	for i, attendee := range waitlisted {
+		if firstOfOccurrence(waitlisted[:i], attendee.Occurrence) {
+			promoted = append(promoted, store.promoteWaitlisted(event, attendee.Occurrence, now)...)
+		}
+	}
+	return promoted, nil
+}
+
+// occurrenceAttendees - attendees of occurrence of event with status, in order of registration.
+func (store *MemStore) occurrenceAttendees(eventID int, occurrence *time.Time, status string) []*config.Attendee {
+	var attendees []*config.Attendee
+	for _, id := range sortedKeys(store.attendees) {
+		attendee := store.attendees[id]
+		if attendee.EventID == eventID && sameOccurrence(attendee.Occurrence, occurrence) && attendee.Status == status {
+			attendees = append(attendees, attendee)
+		}
+	}
+	return attendees
+}
+
+// promoteWaitlisted - registers waitlisted attendees of occurrence of event in order of registration,
+// as many as there are free places. Copies of the promoted attendees are returned.
+func (store *MemStore) promoteWaitlisted(event *config.Event, occurrence *time.Time, at time.Time) []*config.Attendee {
+	waitlisted := store.occurrenceAttendees(event.ID, occurrence, config.AttendeeWaitlisted)
+	if event.Capacity > 0 {
+		free := event.Capacity - len(store.occurrenceAttendees(event.ID, occurrence, config.AttendeeRegistered))
+		if free <= 0 {
+			return nil
+		}
+		if free < len(waitlisted) {
+			waitlisted = waitlisted[:free]
+		}
+	}
+	var promoted []*config.Attendee
+	for _, attendee := range waitlisted {
+		attendee.Status, attendee.PromotedAt = config.AttendeeRegistered, &at
+		data := *attendee
+		promoted = append(promoted, &data)
+	}
+	return promoted
+}
+
+//CheckInEventAttendee - record check-in of attendee at by session user, DuplicateCheckInError when
+//it was checked in before
+func (store *MemStore) CheckInEventAttendee(s *model.SessionContext, eventID, id string, at time.Time) (*config.Attendee, error) {
+	logutil.Debugf(s, "Mem Store Layer - Check In Event Attendee")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	attendee, ok := store.attendees[memID(id)]
+	if !ok || attendee.EventID != memID(eventID) || !visible(s, attendee.TenantID) {
+		return nil, notFound("attendee", id)
+	}
+	duplicate := checkIn(s, attendee, at)
+	data := *attendee
+	if duplicate {
+		return &data, &model.DuplicateCheckInError{AttendeeID: attendee.ID, At: *attendee.CheckedInAt, By: attendee.CheckedInBy}
+	}
+	return &data, nil
+}
+
+//GetClusters - page of clusters of tenant matching spec, with the number of all matching clusters
+func (store *MemStore) GetClusters(s *model.SessionContext, spec model.QuerySpec) ([]*config.Cluster, int, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get All Clusters")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var clusters []*config.Cluster
+	for _, id := range sortedKeys(store.clusters) {
+		if cluster := store.clusters[id]; cluster.TenantID == s.User.TenantId {
+			clusters = append(clusters, copyCluster(cluster))
+		}
+	}
+	page, total := pageRows(clusters, spec)
+	return page.([]*config.Cluster), total, nil
+}
+
+//GetClusterById - get cluster based on id
+func (store *MemStore) GetClusterById(s *model.SessionContext, id string) (*config.Cluster, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Cluster By Id")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	cluster, ok := store.clusters[memID(id)]
+	if !ok || !visible(s, cluster.TenantID) {
+		return nil, notFound("cluster", id)
+	}
+	return copyCluster(cluster), nil
+}
+
+//GetClusterByUUID - fetches cluster based on uuid
+func (store *MemStore) GetClusterByUUID(s *model.SessionContext, uuid string) *config.Cluster {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	for _, cluster := range store.clusters {
+		if cluster.UUID == uuid {
+			return copyCluster(cluster)
+		}
+	}
+	return nil
+}
+
+//UpsertCluster - insert or update cluster
+func (store *MemStore) UpsertCluster(s *model.SessionContext, data *config.Cluster) (*config.Cluster, error) {
+	logutil.Debugf(s, "Mem Store Layer - Upsert Cluster")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	data.UpdatedBy = s.User.UserName
+	if data.ID == 0 {
+		data.ID = store.nextID()
+		data.AddedAt = time.Now()
+		data.UpdatedAt = data.AddedAt
+		data.AddedBy = data.UpdatedBy
+		data.Version = 1
+	} else {
+		existing, ok := store.clusters[data.ID]
+		if !ok || !visible(s, existing.TenantID) {
+			return nil, notFound("cluster", data.ID)
+		}
+		version, err := nextVersion("cluster", data.ID, data.Version, existing.Version)
+		if err != nil {
+			return nil, err
+		}
+		data.AddedAt, data.AddedBy = existing.AddedAt, existing.AddedBy
+		data.UpdatedAt = time.Now()
+		data.Version = version
+	}
+	store.clusters[data.ID] = copyCluster(data)
+	return data, nil
+}
+
+//DeleteClusterById - delete cluster with its nodes and role mappings
+func (store *MemStore) DeleteClusterById(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete Cluster By Id")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	clusterID := memID(id)
+	if cluster, ok := store.clusters[clusterID]; !ok || !visible(s, cluster.TenantID) {
+		return notFound("cluster", id)
+	}
+	delete(store.clusters, clusterID)
+	for nodeID, node := range store.cppmNodes {
+		if node.ClusterID == clusterID {
+			delete(store.cppmNodes, nodeID)
+		}
+	}
+	for keyID, credential := range store.credentials {
+		if credential.ClusterID == clusterID {
+			delete(store.credentials, keyID)
+		}
+	}
+	for eventID, event := range store.outbox {
+		if event.ClusterID == clusterID {
+			delete(store.outbox, eventID)
+		}
+	}
+	for _, links := range []map[int][]*clusterLink{store.roleClusters, store.eventClusters} {
+		for id, mappings := range links {
+			var remaining []*clusterLink
+			for _, link := range mappings {
+				if link.ClusterID != clusterID {
+					remaining = append(remaining, link)
+				}
+			}
+			links[id] = remaining
+		}
+	}
+	return nil
+}
+
+//GetCPPMNodes - page of nodes of tenant matching spec, with the number of all matching nodes
+func (store *MemStore) GetCPPMNodes(s *model.SessionContext, spec model.QuerySpec) ([]*config.CppmNode, int, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get All CPPM Nodes")
+	page, total := pageRows(store.filterCPPMNodes(func(node *config.CppmNode) bool {
+		return node.TenantID == s.User.TenantId
+	}), spec)
+	return page.([]*config.CppmNode), total, nil
+}
+
+//GetCPPMNodesForCluster - get all nodes of a cluster
+func (store *MemStore) GetCPPMNodesForCluster(s *model.SessionContext, clusterId string) ([]*config.CppmNode, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get All CPPM Nodes By Cluster ID")
+	clusterID := memID(clusterId)
+	return store.filterCPPMNodes(func(node *config.CppmNode) bool {
+		return node.ClusterID == clusterID && node.TenantID == s.User.TenantId
+	}), nil
+}
+
+func (store *MemStore) filterCPPMNodes(match func(*config.CppmNode) bool) []*config.CppmNode {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var cppmNodes []*config.CppmNode
+	for _, id := range sortedKeys(store.cppmNodes) {
+		if node := store.cppmNodes[id]; match(node) {
+			data := *node
+			cppmNodes = append(cppmNodes, &data)
+		}
+	}
+	return cppmNodes
+}
+
+//GetCPPMNodeById - get node based on id
+func (store *MemStore) GetCPPMNodeById(s *model.SessionContext, id string) (*config.CppmNode, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get CPPM Node By Id")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	node, ok := store.cppmNodes[memID(id)]
+	if !ok || !visible(s, node.TenantID) {
+		return nil, notFound("cppm node", id)
+	}
+	data := *node
+	return &data, nil
+}
+
+//UpsertCPPMNode - insert or update node
+func (store *MemStore) UpsertCPPMNode(s *model.SessionContext, data *config.CppmNode) (*config.CppmNode, error) {
+	logutil.Debugf(s, "Mem Store Layer - Upsert CPPM Node")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	data.UpdatedBy = s.User.UserName
+	if data.ID == 0 {
+		data.ID = store.nextID()
+		data.AddedAt = time.Now()
+		data.UpdatedAt = data.AddedAt
+		data.AddedBy = data.UpdatedBy
+		data.Version = 1
+	} else {
+		existing, ok := store.cppmNodes[data.ID]
+		if !ok || !visible(s, existing.TenantID) {
+			return nil, notFound("cppm node", data.ID)
+		}
+		version, err := nextVersion("cppm node", data.ID, data.Version, existing.Version)
+		if err != nil {
+			return nil, err
+		}
+		data.AddedAt, data.AddedBy = existing.AddedAt, existing.AddedBy
+		data.UpdatedAt = time.Now()
+		data.Version = version
+	}
+	node := *data
+	store.cppmNodes[data.ID] = &node
+	return data, nil
+}
+
+//UpsertCPPMNodeEvents - insert or update nodes reported by CPPM, matched on server uuid
+func (store *MemStore) UpsertCPPMNodeEvents(s *model.SessionContext, nodes []*config.CppmNode) error {
+	for _, data := range nodes {
+		store.mu.RLock()
+		for _, node := range store.cppmNodes {
+			if node.ServerUUID == data.ServerUUID && node.ClusterID == data.ClusterID && visible(s, node.TenantID) {
+				// Reports of CPPM replace the node whatever version it is at.
+				data.ID, data.Version = node.ID, node.Version
+			}
+		}
+		store.mu.RUnlock()
+		if _, err := store.UpsertCPPMNode(s, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//DeleteCPPMNode - delete node
+func (store *MemStore) DeleteCPPMNode(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete CPPM Node By Id")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if node, ok := store.cppmNodes[memID(id)]; !ok || !visible(s, node.TenantID) {
+		return notFound("cppm node", id)
+	}
+	delete(store.cppmNodes, memID(id))
+	return nil
+}
+
+//GetClusterCredentials - credentials of cluster, secrets are not returned
+func (store *MemStore) GetClusterCredentials(s *model.SessionContext, clusterID string) ([]*config.ClusterCredential, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Cluster Credentials")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var credentials []*config.ClusterCredential
+	for _, credential := range store.credentials {
+		if credential.ClusterID == memID(clusterID) && visible(s, credential.TenantID) {
+			data := *credential
+			data.Secret = ""
+			credentials = append(credentials, &data)
+		}
+	}
+	sort.Slice(credentials, func(i, j int) bool { return credentials[i].AddedAt.Before(credentials[j].AddedAt) })
+	return credentials, nil
+}
+
+//AddClusterCredential - insert credential for a cluster of the session tenant
+func (store *MemStore) AddClusterCredential(s *model.SessionContext, credential *config.ClusterCredential) error {
+	logutil.Debugf(s, "Mem Store Layer - Add Cluster Credential")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if cluster, ok := store.clusters[credential.ClusterID]; !ok || !visible(s, cluster.TenantID) {
+		return notFound("cluster", credential.ClusterID)
+	}
+	credential.AddedAt = time.Now()
+	data := *credential
+	store.credentials[credential.KeyID] = &data
+	return nil
+}
+
+//DeleteClusterCredential - revoke credential of cluster
+func (store *MemStore) DeleteClusterCredential(s *model.SessionContext, clusterID, keyID string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete Cluster Credential")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	credential, ok := store.credentials[keyID]
+	if !ok || credential.ClusterID != memID(clusterID) || !visible(s, credential.TenantID) {
+		return notFound("cluster credential", keyID)
+	}
+	delete(store.credentials, keyID)
+	return nil
+}
+
+//GetClusterCredentialByKey - credential with secret for verifying signed requests
+func (store *MemStore) GetClusterCredentialByKey(keyID string) (*config.ClusterCredential, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	credential, ok := store.credentials[keyID]
+	if !ok {
+		return nil, notFound("cluster credential", keyID)
+	}
+	data := *credential
+	return &data, nil
+}
+
+//UseNonce - records nonce of key, false when it was already used within window
+func (store *MemStore) UseNonce(keyID, nonce string, seenAt time.Time, window time.Duration) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for key, at := range store.nonces {
+		if at.Before(seenAt.Add(-window)) {
+			delete(store.nonces, key)
+		}
+	}
+	key := keyID + "\x00" + nonce
+	if _, ok := store.nonces[key]; ok {
+		return false, nil
+	}
+	store.nonces[key] = seenAt
+	return true, nil
+}
+
+// addOutboxEvents - caller must hold the write lock.
+func (store *MemStore) addOutboxEvents(events []*config.OutboxEvent) {
+	for _, event := range events {
+		event.ID = store.nextID()
+		store.outbox[event.ID] = event
+	}
+}
+
+//GetDueOutboxEvents - oldest pending event of every cluster when it is due
+func (store *MemStore) GetDueOutboxEvents(now time.Time, limit int) ([]*config.OutboxEvent, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var events []*config.OutboxEvent
+	seen := make(map[int]bool)
+	for _, id := range sortedKeys(store.outbox) {
+		event := store.outbox[id]
+		if event.Status != config.SyncPending || seen[event.ClusterID] {
+			continue
+		}
+		seen[event.ClusterID] = true
+		if !event.NextAttemptAt.After(now) && len(events) < limit {
+			data := *event
+			events = append(events, &data)
+		}
+	}
+	return events, nil
+}
+
+//UpdateOutboxEvent - saves dispatch state of event unless it changed since read with prevAttempts
+func (store *MemStore) UpdateOutboxEvent(event *config.OutboxEvent, prevAttempts int) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	existing, ok := store.outbox[event.ID]
+	if !ok || existing.Attempts != prevAttempts || existing.Status != config.SyncPending {
+		return false, nil
+	}
+	existing.Status = event.Status
+	existing.Attempts = event.Attempts
+	existing.LastError = event.LastError
+	existing.NextAttemptAt = event.NextAttemptAt
+	existing.UpdatedAt = event.UpdatedAt
+	return true, nil
+}
+
+//AckOutboxEvent - marks event (or oldest unsynced event of entity when id is 0) of cluster synced
+func (store *MemStore) AckOutboxEvent(s *model.SessionContext, clusterID, eventID int, entityName string, entityID int) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for _, id := range sortedKeys(store.outbox) {
+		event := store.outbox[id]
+		if event.ClusterID != clusterID || event.TenantID != s.User.TenantId || event.Status == config.SyncSynced {
+			continue
+		}
+		if event.ID == eventID || (eventID == 0 && event.EntityName == entityName && event.EntityID == entityID) {
+			event.Status = config.SyncSynced
+			event.LastError = ""
+			event.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return notFound("sync event", eventID)
+}
+
+//GetRoleSyncStatus - latest event of role for every cluster
+func (store *MemStore) GetRoleSyncStatus(s *model.SessionContext, roleID string) ([]*config.OutboxEvent, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	if role, ok := store.roles[memID(roleID)]; !ok || !visible(s, role.TenantID) {
+		return nil, notFound("role", roleID)
+	}
+	entityName := (&config.Role{}).EntityName()
+	return store.latestOutboxEvents(s, func(event *config.OutboxEvent) (string, bool) {
+		return strconv.Itoa(event.ClusterID), event.EntityName == entityName && event.EntityID == memID(roleID)
+	}), nil
+}
+
+//GetClusterSyncStatus - latest event of every entity sent to cluster
+func (store *MemStore) GetClusterSyncStatus(s *model.SessionContext, clusterID string) ([]*config.OutboxEvent, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	if cluster, ok := store.clusters[memID(clusterID)]; !ok || !visible(s, cluster.TenantID) {
+		return nil, notFound("cluster", clusterID)
+	}
+	return store.latestOutboxEvents(s, func(event *config.OutboxEvent) (string, bool) {
+		return event.EntityName + "/" + strconv.Itoa(event.EntityID), event.ClusterID == memID(clusterID)
+	}), nil
+}
+
+// latestOutboxEvents - newest visible matching event per key, in order of first event of key.
+func (store *MemStore) latestOutboxEvents(s *model.SessionContext, match func(*config.OutboxEvent) (string, bool)) []*config.OutboxEvent {
+	var events []*config.OutboxEvent
+	index := make(map[string]int)
+	for _, id := range sortedKeys(store.outbox) {
+		event := store.outbox[id]
+		key, ok := match(event)
+		if !ok || !visible(s, event.TenantID) {
+			continue
+		}
+		data := *event
+		if i, ok := index[key]; ok {
+			events[i] = &data
+		} else {
+			index[key] = len(events)
+			events = append(events, &data)
+		}
+	}
+	return events
+}
+
+//AddAuditLog - appends entry to the audit log
+func (store *MemStore) AddAuditLog(entry *model.AuditLog) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	entry.ID = store.nextID()
+	data := *entry
+	store.auditLogs = append(store.auditLogs, &data)
+	return nil
+}
+
+//GetAuditLogs - page of audit log entries of tenant matching filter, newest first
+func (store *MemStore) GetAuditLogs(s *model.SessionContext, filter model.AuditFilter) (*model.AuditLogPage, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Audit Logs")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	filter = pageAuditFilter(filter)
+	page := &model.AuditLogPage{Items: []*model.AuditLog{}, Page: filter.Page, PageSize: filter.PageSize}
+	offset := (filter.Page - 1) * filter.PageSize
+	for i := len(store.auditLogs) - 1; i >= 0; i-- {
+		entry := store.auditLogs[i]
+		if !visible(s, entry.TenantID) || !matchAuditFilter(entry, filter) {
+			continue
+		}
+		if page.Total >= offset && len(page.Items) < filter.PageSize {
+			data := *entry
+			page.Items = append(page.Items, &data)
+		}
+		page.Total++
+	}
+	return page, nil
+}
+
+func matchAuditFilter(entry *model.AuditLog, filter model.AuditFilter) bool {
+	return (filter.UserName == "" || entry.UserName == filter.UserName) &&
+		(filter.Entity == "" || entry.Entity == filter.Entity) &&
+		(filter.EntityID == "" || entry.EntityID == filter.EntityID) &&
+		(filter.Action == "" || entry.Action == filter.Action) &&
+		(filter.From.IsZero() || !entry.AddedAt.Before(filter.From)) &&
+		(filter.To.IsZero() || entry.AddedAt.Before(filter.To))
+}
+
+//GetTenants - get all tenants
+func (store *MemStore) GetTenants(s *model.SessionContext) ([]*config.Tenant, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get All Tenants")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var ids []string
+	for id := range store.tenants {
+		if visible(s, id) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	var tenants []*config.Tenant
+	for _, id := range ids {
+		data := *store.tenants[id]
+		tenants = append(tenants, &data)
+	}
+	return tenants, nil
+}
+
+//GetTenantById - get tenant based on id
+func (store *MemStore) GetTenantById(s *model.SessionContext, id string) (*config.Tenant, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Tenant By Id")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	tenant, ok := store.tenants[id]
+	if !ok || !visible(s, id) {
+		return nil, notFound("tenant", id)
+	}
+	data := *tenant
+	return &data, nil
+}
+
+//UpsertTenant - insert or update tenant
+func (store *MemStore) UpsertTenant(s *model.SessionContext, data *config.Tenant) (*config.Tenant, error) {
+	logutil.Debugf(s, "Mem Store Layer - Upsert Tenant")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if data.ID == "" {
+		data.ID = fmt.Sprintf("%d", time.Now().UnixNano())
+		data.Version = 1
+	} else {
+		existing, ok := store.tenants[data.ID]
+		if !ok || !visible(s, data.ID) {
+			return nil, notFound("tenant", data.ID)
+		}
+		version, err := nextVersion("tenant", data.ID, data.Version, existing.Version)
+		if err != nil {
+			return nil, err
+		}
+		data.Version = version
+	}
+	tenant := *data
+	store.tenants[data.ID] = &tenant
+	return data, nil
+}
+
+//DeleteTenantById - delete tenant
+func (store *MemStore) DeleteTenantById(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete Tenant By Id")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if _, ok := store.tenants[id]; !ok || !visible(s, id) {
+		return notFound("tenant", id)
+	}
+	delete(store.tenants, id)
+	return nil
+}
+
+//GetAllUsers - get all users of tenant
+func (store *MemStore) GetAllUsers(s *model.SessionContext) ([]*model.UserTenantDetails, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get All Users")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var names []string
+	for name, user := range store.users {
+		if user.TenantID == s.User.TenantId {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var users []*model.UserTenantDetails
+	for _, name := range names {
+		data := *store.users[name]
+		users = append(users, &data)
+	}
+	return users, nil
+}
+
+//GetUserByName - get user based on username
+func (store *MemStore) GetUserByName(s *model.SessionContext, username string) (*model.UserTenantDetails, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get User By UserName")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	user, ok := store.users[username]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	data := *user
+	return &data, nil
+}
+
+//UpsertUser - insert or update user
+func (store *MemStore) UpsertUser(s *model.SessionContext, user *model.UserTenantDetails) error {
+	logutil.Debugf(s, "Mem Store Layer - Upsert User")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	data := *user
+	store.users[user.UserName] = &data
+	return nil
+}
+
+//UpdateUserPassword - replace password hash and salt of user
+func (store *MemStore) UpdateUserPassword(s *model.SessionContext, username, hash, salt string) error {
+	logutil.Debugf(s, "Mem Store Layer - Update User Password")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if user, ok := store.users[username]; ok {
+		user.Password = hash
+		user.Salt = salt
+	}
+	return nil
+}
+
+//BootstrapAdmin - creates tenant and first admin user unless the user already exists
+func (store *MemStore) BootstrapAdmin(s *model.SessionContext, tenant *config.Tenant, user *model.UserTenantDetails) (bool, error) {
+	logutil.Debugf(s, "Mem Store Layer - Bootstrap Admin")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if _, ok := store.tenants[tenant.ID]; !ok {
+		data := *tenant
+		data.Version = 1
+		store.tenants[tenant.ID] = &data
+	}
+	if _, ok := store.users[user.UserName]; ok {
+		return false, nil
+	}
+	data := *user
+	store.users[user.UserName] = &data
+	return true, nil
+}
+
+//DeleteUser - delete user of the session tenant
+func (store *MemStore) DeleteUser(s *model.SessionContext, username string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete User By UserName")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if user, ok := store.users[username]; !ok || !visible(s, user.TenantID) {
+		return notFound("user", username)
+	}
+	delete(store.users, username)
+	delete(store.mfa, username)
+	return nil
+}
+
+//GetAdminRoles - custom admin roles of the session tenant
+func (store *MemStore) GetAdminRoles(s *model.SessionContext) ([]*model.AdminRole, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Admin Roles")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var keys []string
+	for key, role := range store.adminRoles {
+		if role.TenantID == s.User.TenantId {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var roles []*model.AdminRole
+	for _, key := range keys {
+		roles = append(roles, copyAdminRole(store.adminRoles[key]))
+	}
+	return roles, nil
+}
+
+//GetAdminRole - custom admin role of the session tenant by name
+func (store *MemStore) GetAdminRole(s *model.SessionContext, name string) (*model.AdminRole, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Admin Role")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	role, ok := store.adminRoles[s.User.TenantId+"/"+name]
+	if !ok {
+		return nil, notFound("admin role", name)
+	}
+	return copyAdminRole(role), nil
+}
+
+//UpsertAdminRole - insert or update admin role, users assigned to it get its permissions
+func (store *MemStore) UpsertAdminRole(s *model.SessionContext, role *model.AdminRole) error {
+	logutil.Debugf(s, "Mem Store Layer - Upsert Admin Role")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	key := role.TenantID + "/" + role.Name
+	role.UpdatedAt = time.Now()
+	role.UpdatedBy = s.User.UserName
+	if existing, ok := store.adminRoles[key]; ok {
+		role.AddedAt = existing.AddedAt
+		role.AddedBy = existing.AddedBy
+	} else {
+		role.AddedAt = role.UpdatedAt
+		role.AddedBy = s.User.UserName
+	}
+	store.adminRoles[key] = copyAdminRole(role)
+	for _, user := range store.users {
+		if user.TenantID == role.TenantID && user.Role == role.Name {
+			user.Permissions = copyAdminRole(role).Permissions
+		}
+	}
+	return nil
+}
+
+//DeleteAdminRole - delete custom admin role of the session tenant
+func (store *MemStore) DeleteAdminRole(s *model.SessionContext, name string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete Admin Role")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	key := s.User.TenantId + "/" + name
+	if _, ok := store.adminRoles[key]; !ok {
+		return notFound("admin role", name)
+	}
+	delete(store.adminRoles, key)
+	return nil
+}
+
+//GetAPITokens - API tokens of tenant, hashes are not returned
+func (store *MemStore) GetAPITokens(s *model.SessionContext) ([]*model.APIToken, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get API Tokens")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var tokens []*model.APIToken
+	for _, token := range store.apiTokens {
+		if visible(s, token.TenantID) {
+			data := *token
+			data.Hash = ""
+			tokens = append(tokens, &data)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].AddedAt.Before(tokens[j].AddedAt) })
+	return tokens, nil
+}
+
+//GetAPITokenByID - token with hash for authenticating bearer requests
+func (store *MemStore) GetAPITokenByID(id string) (*model.APIToken, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	token, ok := store.apiTokens[id]
+	if !ok {
+		return nil, notFound("api token", id)
+	}
+	data := *token
+	return &data, nil
+}
+
+//AddAPIToken - insert token for the session tenant
+func (store *MemStore) AddAPIToken(s *model.SessionContext, token *model.APIToken) error {
+	logutil.Debugf(s, "Mem Store Layer - Add API Token")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	token.AddedAt = time.Now()
+	token.AddedBy = s.User.UserName
+	data := *token
+	data.Token = ""
+	store.apiTokens[token.ID] = &data
+	return nil
+}
+
+//DeleteAPIToken - revoke token of tenant
+func (store *MemStore) DeleteAPIToken(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete API Token")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if token, ok := store.apiTokens[id]; !ok || !visible(s, token.TenantID) {
+		return notFound("api token", id)
+	}
+	delete(store.apiTokens, id)
+	return nil
+}
+
+//TouchAPIToken - records use of token
+func (store *MemStore) TouchAPIToken(id string, usedAt time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if token, ok := store.apiTokens[id]; ok {
+		token.LastUsedAt = &usedAt
+	}
+	return nil
+}
+
+//GetCalendarFeed - calendar feed of session user
+func (store *MemStore) GetCalendarFeed(s *model.SessionContext) (*config.CalendarFeed, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Calendar Feed")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	for _, feed := range store.calendarFeeds {
+		if feed.TenantID == s.User.TenantId && feed.UserName == s.User.UserName {
+			data := *feed
+			data.Hash = ""
+			return &data, nil
+		}
+	}
+	return nil, notFound("calendar feed", s.User.UserName)
+}
+
+//GetCalendarFeedByID - feed with hash for authenticating feed requests
+func (store *MemStore) GetCalendarFeedByID(id string) (*config.CalendarFeed, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	feed, ok := store.calendarFeeds[id]
+	if !ok {
+		return nil, notFound("calendar feed", id)
+	}
+	data := *feed
+	return &data, nil
+}
+
+//ReplaceCalendarFeed - feed of session user, replaces the previous one
+func (store *MemStore) ReplaceCalendarFeed(s *model.SessionContext, feed *config.CalendarFeed) error {
+	logutil.Debugf(s, "Mem Store Layer - Replace Calendar Feed")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.deleteCalendarFeed(s)
+	feed.TenantID, feed.UserName = s.User.TenantId, s.User.UserName
+	feed.AddedAt = time.Now()
+	data := *feed
+	data.Token, data.URL = "", ""
+	store.calendarFeeds[feed.ID] = &data
+	return nil
+}
+
+//DeleteCalendarFeed - revoke calendar feed of session user
+func (store *MemStore) DeleteCalendarFeed(s *model.SessionContext) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete Calendar Feed")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if !store.deleteCalendarFeed(s) {
+		return notFound("calendar feed", s.User.UserName)
+	}
+	return nil
+}
+
+// deleteCalendarFeed - removes feed of session user, false when there is none. Caller must hold the
+// write lock.
+func (store *MemStore) deleteCalendarFeed(s *model.SessionContext) bool {
+	for id, feed := range store.calendarFeeds {
+		if feed.TenantID == s.User.TenantId && feed.UserName == s.User.UserName {
+			delete(store.calendarFeeds, id)
+			return true
+		}
+	}
+	return false
+}
+
+//TouchCalendarFeed - records use of feed
+func (store *MemStore) TouchCalendarFeed(id string, usedAt time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if feed, ok := store.calendarFeeds[id]; ok {
+		feed.LastUsedAt = &usedAt
+	}
+	return nil
+}
+
+//GetUserSessions - sessions of tenant, only those of userName unless empty
+func (store *MemStore) GetUserSessions(s *model.SessionContext, userName string) ([]*model.UserSession, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get User Sessions")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var sessions []*model.UserSession
+	for _, session := range store.sessions {
+		if visible(s, session.TenantID) && (userName == "" || session.UserName == userName) {
+			data := *session
+			sessions = append(sessions, &data)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt) })
+	return sessions, nil
+}
+
+//GetUserSessionByID - session for validating requests
+func (store *MemStore) GetUserSessionByID(id string) (*model.UserSession, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	session, ok := store.sessions[id]
+	if !ok {
+		return nil, notFound("session", id)
+	}
+	data := *session
+	return &data, nil
+}
+
+//AddUserSession - insert session at login
+func (store *MemStore) AddUserSession(session *model.UserSession) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	data := *session
+	store.sessions[session.ID] = &data
+	return nil
+}
+
+//TouchUserSession - records use of session
+func (store *MemStore) TouchUserSession(id string, seenAt time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if session, ok := store.sessions[id]; ok {
+		session.LastSeenAt = seenAt
+	}
+	return nil
+}
+
+//DeleteUserSession - revoke session of tenant
+func (store *MemStore) DeleteUserSession(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete User Session")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if session, ok := store.sessions[id]; !ok || !visible(s, session.TenantID) {
+		return notFound("session", id)
+	}
+	delete(store.sessions, id)
+	return nil
+}
+
+//DeleteUserSessions - revoke all sessions of user but exceptID
+func (store *MemStore) DeleteUserSessions(s *model.SessionContext, userName, exceptID string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete User Sessions")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for id, session := range store.sessions {
+		if session.TenantID == s.User.TenantId && session.UserName == userName && id != exceptID {
+			delete(store.sessions, id)
+		}
+	}
+	return nil
+}
+
+//DeleteExpiredSessions - removes sessions unused since seenBefore or started before addedBefore
+func (store *MemStore) DeleteExpiredSessions(seenBefore, addedBefore time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for id, session := range store.sessions {
+		if session.LastSeenAt.Before(seenBefore) || session.AddedAt.Before(addedBefore) {
+			delete(store.sessions, id)
+		}
+	}
+	return nil
+}
+
+//GetIdentityProviders - identity providers of tenant
+func (store *MemStore) GetIdentityProviders(s *model.SessionContext) ([]*model.IdentityProvider, error) {
+	logutil.Debugf(s, "Mem Store Layer - Get Identity Providers")
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var idps []*model.IdentityProvider
+	for _, idp := range store.idps {
+		if visible(s, idp.TenantID) {
+			idps = append(idps, copyIdentityProvider(idp))
+		}
+	}
+	sort.Slice(idps, func(i, j int) bool { return idps[i].Name < idps[j].Name })
+	return idps, nil
+}
+
+//GetIdentityProviderByID - identity provider for single sign-on of any tenant
+func (store *MemStore) GetIdentityProviderByID(id string) (*model.IdentityProvider, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	idp, ok := store.idps[id]
+	if !ok {
+		return nil, notFound("identity provider", id)
+	}
+	return copyIdentityProvider(idp), nil
+}
+
+//UpsertIdentityProvider - insert or update identity provider of tenant
+func (store *MemStore) UpsertIdentityProvider(s *model.SessionContext, idp *model.IdentityProvider) error {
+	logutil.Debugf(s, "Mem Store Layer - Upsert Identity Provider")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	idp.UpdatedAt = time.Now()
+	idp.UpdatedBy = s.User.UserName
+	if existing, ok := store.idps[idp.ID]; ok {
+		if existing.TenantID != idp.TenantID {
+			return notFound("identity provider", idp.ID)
+		}
+		idp.AddedAt = existing.AddedAt
+		idp.AddedBy = existing.AddedBy
+	} else {
+		idp.AddedAt = idp.UpdatedAt
+		idp.AddedBy = s.User.UserName
+	}
+	store.idps[idp.ID] = copyIdentityProvider(idp)
+	return nil
+}
+
+//DeleteIdentityProvider - remove identity provider of tenant, its users stay until deleted
+func (store *MemStore) DeleteIdentityProvider(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete Identity Provider")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if idp, ok := store.idps[id]; !ok || !visible(s, idp.TenantID) {
+		return notFound("identity provider", id)
+	}
+	delete(store.idps, id)
+	return nil
+}
+
+func copyIdentityProvider(idp *model.IdentityProvider) *model.IdentityProvider {
+	data := *idp
+	data.Scopes = append([]string(nil), idp.Scopes...)
+	data.GroupRoles = make(map[string]string)
+	for group, role := range idp.GroupRoles {
+		data.GroupRoles[group] = role
+	}
+	return &data
+}
+
+func copyAdminRole(role *model.AdminRole) *model.AdminRole {
+	data := *role
+	data.Permissions = make(map[string]string)
+	for group, permission := range role.Permissions {
+		data.Permissions[group] = permission
+	}
+	return &data
+}
+
+func copyRole(role *config.Role) *config.Role {
+	data := *role
+	data.Clusters = nil
+	return &data
+}
+
+func copyCluster(cluster *config.Cluster) *config.Cluster {
+	data := *cluster
+	data.CPPMNodes = nil
+	return &data
+}
+
+// sortedKeys - ids of a memory table in insertion order.
+func sortedKeys(table interface{}) []int {
+	var ids []int
+	switch t := table.(type) {
+	case map[int]*config.Role:
+		for id := range t {
+			ids = append(ids, id)
+		}
+	case map[int]*config.Cluster:
+		for id := range t {
+			ids = append(ids, id)
+		}
+	case map[int]*config.CppmNode:
+		for id := range t {
+			ids = append(ids, id)
+		}
+	case map[int]*config.Event:
+		for id := range t {
+			ids = append(ids, id)
+		}
+	case map[int]*config.Attendee:
+		for id := range t {
+			ids = append(ids, id)
+		}
+	case map[int]*config.OutboxEvent:
+		for id := range t {
+			ids = append(ids, id)
+		}
+	case map[int]*config.RoleCluster:
+		for id := range t {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+//GetUserMFA - second factor of user, at login before the session tenant is known
+func (store *MemStore) GetUserMFA(userName string) (*model.UserMFA, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	mfa, ok := store.mfa[userName]
+	if !ok {
+		return nil, notFound("mfa", userName)
+	}
+	return copyUserMFA(mfa), nil
+}
+
+//UpsertUserMFA - insert or replace second factor of user
+func (store *MemStore) UpsertUserMFA(mfa *model.UserMFA) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.mfa[mfa.UserName] = copyUserMFA(mfa)
+	return nil
+}
+
+//UseUserMFAStep - records step as the last accepted TOTP code of user, NotFoundError when that or a
+//later code was used already
+func (store *MemStore) UseUserMFAStep(userName string, step int64) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	mfa, ok := store.mfa[userName]
+	if !ok || mfa.LastStep >= step {
+		return notFound("mfa code", userName)
+	}
+	mfa.LastStep = step
+	return nil
+}
+
+//UseUserMFARecoveryCode - removes recovery code hash of user, NotFoundError when it was used already
+func (store *MemStore) UseUserMFARecoveryCode(userName, hash string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	mfa, ok := store.mfa[userName]
+	if !ok {
+		return notFound("mfa", userName)
+	}
+	left, ok := removeRecoveryCode(mfa.RecoveryCodes, hash)
+	if !ok {
+		return notFound("mfa code", userName)
+	}
+	mfa.RecoveryCodes = left
+	return nil
+}
+
+//DeleteUserMFA - remove second factor of user of tenant
+func (store *MemStore) DeleteUserMFA(s *model.SessionContext, userName string) error {
+	logutil.Debugf(s, "Mem Store Layer - Delete User MFA")
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if mfa, ok := store.mfa[userName]; !ok || !visible(s, mfa.TenantID) {
+		return notFound("mfa", userName)
+	}
+	delete(store.mfa, userName)
+	return nil
+}
+
+func copyUserMFA(mfa *model.UserMFA) *model.UserMFA {
+	data := *mfa
+	data.RecoveryCodes = append([]string(nil), mfa.RecoveryCodes...)
+	if mfa.EnabledAt != nil {
+		enabledAt := *mfa.EnabledAt
+		data.EnabledAt = &enabledAt
+	}
+	return &data
+}
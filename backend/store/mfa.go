@@ -0,0 +1,84 @@
+package store
+
+import (
+	"database/sql"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+
+	gorp "gopkg.in/gorp.v2"
+)
+
+//GetUserMFA - second factor of user, at login before the session tenant is known
+func (store *PgStore) GetUserMFA(userName string) (*model.UserMFA, error) {
+	var mfa *model.UserMFA
+	err := store.DB().SelectOne(&mfa, "SELECT * FROM USER_MFA WHERE USER_NAME = $1", userName)
+	if err == sql.ErrNoRows {
+		return nil, &model.NotFoundError{Entity: "mfa", ID: userName}
+	}
+	return mfa, err
+}
+
+//UpsertUserMFA - insert or replace second factor of user
+func (store *PgStore) UpsertUserMFA(mfa *model.UserMFA) error {
+	return execTx(nil, store.DB(), func(tx *gorp.Transaction) error {
+		count, err := tx.SelectInt("SELECT count(*) FROM USER_MFA WHERE USER_NAME = $1", mfa.UserName)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return tx.Insert(mfa)
+		}
+		_, err = tx.Update(mfa)
+		return err
+	})
+}
+
+//UseUserMFAStep - records step as the last accepted TOTP code of user, NotFoundError when that or a
+//later code was used already
+func (store *PgStore) UseUserMFAStep(userName string, step int64) error {
+	res, err := store.DB().Exec("UPDATE USER_MFA SET LAST_STEP = $1 WHERE USER_NAME = $2 AND LAST_STEP < $1", step, userName)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return &model.NotFoundError{Entity: "mfa code", ID: userName}
+	}
+	return err
+}
+
+//UseUserMFARecoveryCode - removes recovery code hash of user, NotFoundError when it was used already
+func (store *PgStore) UseUserMFARecoveryCode(userName, hash string) error {
+	return execTx(nil, store.DB(), func(tx *gorp.Transaction) error {
+		var mfa *model.UserMFA
+		err := tx.SelectOne(&mfa, "SELECT * FROM USER_MFA WHERE USER_NAME = $1 FOR UPDATE", userName)
+		if err == sql.ErrNoRows {
+			return &model.NotFoundError{Entity: "mfa", ID: userName}
+		}
+		if err != nil {
+			return err
+		}
+		left, ok := removeRecoveryCode(mfa.RecoveryCodes, hash)
+		if !ok {
+			return &model.NotFoundError{Entity: "mfa code", ID: userName}
+		}
+		mfa.RecoveryCodes = left
+		_, err = tx.Update(mfa)
+		return err
+	})
+}
+
+// removeRecoveryCode - codes without hash, false when it isn't one of them.
+func removeRecoveryCode(codes []string, hash string) ([]string, bool) {
+	for i, code := range codes {
+		if code == hash {
+			return append(codes[:i:i], codes[i+1:]...), true
+		}
+	}
+	return codes, false
+}
+
+//DeleteUserMFA - remove second factor of user of tenant
+func (store *PgStore) DeleteUserMFA(s *model.SessionContext, userName string) error {
+	logutil.Debugf(s, "Store Layer - Delete User MFA")
+	return store.Tenant(s).Exec("mfa", userName, "DELETE FROM USER_MFA WHERE USER_NAME = $1", userName)
+}
@@ -0,0 +1,232 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"nyota/backend/logutil"
+	"sort"
+	"time"
+)
+
+// migrationLockID is the postgres advisory lock key held while migrations run, so that
+// several backend instances starting together apply each migration only once.
+const migrationLockID = 7463110028
+
+const createMigrationTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    INTEGER PRIMARY KEY,
+	name       TEXT NOT NULL,
+	checksum   TEXT NOT NULL,
+	applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`
+
+// Migration - a single versioned schema change with its rollback.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Checksum - sha256 of the up script, used to detect edits of already applied migrations.
+func (m Migration) Checksum() string {
+	sum := sha256.Sum256([]byte(m.Up))
+	return hex.EncodeToString(sum[:])
+}
+
+// MigrationStatus - state of a migration in database.
+type MigrationStatus struct {
+	Version   int        `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+}
+
+// Migrator - applies and reverts ordered migrations on a postgres database.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// NewMigrator - migrator for all nyota schema migrations.
+func NewMigrator(db *sql.DB) *Migrator {
+	return newMigrator(db, migrations)
+}
+
+func newMigrator(db *sql.DB, list []Migration) *Migrator {
+	sorted := make([]Migration, len(list))
+	copy(sorted, list)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	return &Migrator{db: db, migrations: sorted}
+}
+
+// validateMigrations - versions must be positive and unique and every migration needs both scripts.
+func validateMigrations(list []Migration) error {
+	seen := make(map[int]bool)
+	for _, m := range list {
+		if m.Version <= 0 {
+			return fmt.Errorf("migration %q has invalid version %d", m.Name, m.Version)
+		}
+		if seen[m.Version] {
+			return fmt.Errorf("migration version %d is duplicated", m.Version)
+		}
+		if m.Up == "" || m.Down == "" {
+			return fmt.Errorf("migration %d(%s) needs up and down scripts", m.Version, m.Name)
+		}
+		seen[m.Version] = true
+	}
+	return nil
+}
+
+// Up - applies all pending migrations in version order.
+func (m *Migrator) Up() error {
+	return m.withLock(func(conn *sql.Conn) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			logutil.Printf(nil, "Applying migration %d - %s", migration.Version, migration.Name)
+			err := m.inTx(conn, func(tx *sql.Tx) error {
+				if _, err := tx.Exec(migration.Up); err != nil {
+					return err
+				}
+				_, err := tx.Exec("INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)",
+					migration.Version, migration.Name, migration.Checksum())
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("migration %d(%s) failed: %v", migration.Version, migration.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Down - reverts last 'steps' applied migrations.
+func (m *Migrator) Down(steps int) error {
+	return m.withLock(func(conn *sql.Conn) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && steps > 0; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+			logutil.Printf(nil, "Reverting migration %d - %s", migration.Version, migration.Name)
+			err := m.inTx(conn, func(tx *sql.Tx) error {
+				if _, err := tx.Exec(migration.Down); err != nil {
+					return err
+				}
+				_, err := tx.Exec("DELETE FROM schema_migrations WHERE version = $1", migration.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("revert of migration %d(%s) failed: %v", migration.Version, migration.Name, err)
+			}
+			steps--
+		}
+		return nil
+	})
+}
+
+// Status - lists all known migrations and whether they are applied.
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	var status []MigrationStatus
+	err := m.withLock(func(conn *sql.Conn) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			st := MigrationStatus{Version: migration.Version, Name: migration.Name}
+			if at, ok := applied[migration.Version]; ok {
+				appliedAt := at
+				st.Applied = true
+				st.AppliedAt = &appliedAt
+			}
+			status = append(status, st)
+		}
+		return nil
+	})
+	return status, err
+}
+
+// applied - applied migrations with their time. Fails when an applied migration was modified
+// or is unknown to this binary, starting on such a schema could corrupt data.
+func (m *Migrator) applied(conn *sql.Conn) (map[int]time.Time, error) {
+	ctx := context.Background()
+	if _, err := conn.ExecContext(ctx, createMigrationTable); err != nil {
+		return nil, err
+	}
+	rows, err := conn.QueryContext(ctx, "SELECT version, checksum, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	known := make(map[int]Migration)
+	for _, migration := range m.migrations {
+		known[migration.Version] = migration
+	}
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var (
+			version   int
+			checksum  string
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&version, &checksum, &appliedAt); err != nil {
+			return nil, err
+		}
+		migration, ok := known[version]
+		if !ok {
+			return nil, fmt.Errorf("database has migration %d which is unknown to this version", version)
+		}
+		if migration.Checksum() != checksum {
+			return nil, fmt.Errorf("checksum mismatch for applied migration %d(%s)", version, migration.Name)
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// withLock - runs f on a dedicated connection holding the migration advisory lock.
+func (m *Migrator) withLock(f func(*sql.Conn) error) error {
+	if err := validateMigrations(m.migrations); err != nil {
+		return err
+	}
+	ctx := context.Background()
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		return err
+	}
+	defer conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", migrationLockID)
+
+	return f(conn)
+}
+
+func (m *Migrator) inTx(conn *sql.Conn, f func(*sql.Tx) error) error {
+	tx, err := conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,279 @@
+package store
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMigrationsAreValid(t *testing.T) {
+	if err := validateMigrations(migrations); err != nil {
+		t.Fatalf("Invalid migrations: %v", err)
+	}
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version <= migrations[i-1].Version {
+			t.Errorf("Migration %d must be declared after %d", migrations[i-1].Version, migrations[i].Version)
+		}
+	}
+	// Keys and secrets must come from gen_random_bytes, random() is predictable.
+	for _, m := range migrations {
+		if strings.Contains(strings.ToLower(m.Up), "random()") {
+			t.Errorf("Migration %d(%s) uses random()", m.Version, m.Name)
+		}
+	}
+}
+
+func TestValidateMigrations(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    []Migration
+		isValid bool
+	}{
+		{"ok", []Migration{{Version: 1, Up: "a", Down: "b"}, {Version: 2, Up: "c", Down: "d"}}, true},
+		{"duplicate", []Migration{{Version: 1, Up: "a", Down: "b"}, {Version: 1, Up: "c", Down: "d"}}, false},
+		{"zero version", []Migration{{Version: 0, Up: "a", Down: "b"}}, false},
+		{"missing down", []Migration{{Version: 1, Up: "a"}}, false},
+	}
+	for _, test := range tests {
+		err := validateMigrations(test.list)
+		if test.isValid != (err == nil) {
+			t.Errorf("%s: expected valid=%v, error: %v", test.name, test.isValid, err)
+		}
+	}
+}
+
+func TestMigrationOrderAndChecksum(t *testing.T) {
+	m := newMigrator(nil, []Migration{{Version: 3, Up: "c"}, {Version: 1, Up: "a"}, {Version: 2, Up: "b"}})
+	for i, migration := range m.migrations {
+		if migration.Version != i+1 {
+			t.Errorf("Expected version %d at %d, got %d", i+1, i, migration.Version)
+		}
+	}
+
+	a := Migration{Version: 1, Up: "CREATE TABLE a()"}
+	b := Migration{Version: 1, Up: "CREATE TABLE b()"}
+	if a.Checksum() == b.Checksum() {
+		t.Errorf("Checksum must change when up script changes")
+	}
+	if a.Checksum() != (Migration{Version: 1, Up: "CREATE TABLE a()"}).Checksum() {
+		t.Errorf("Checksum must be stable")
+	}
+}
+
+func TestMigratorUpAndDown(t *testing.T) {
+	db, fake := openFakeMigrationDB(t)
+	list := []Migration{
+		{Version: 1, Name: "one", Up: "CREATE TABLE one()", Down: "DROP TABLE one"},
+		{Version: 2, Name: "two", Up: "CREATE TABLE two()", Down: "DROP TABLE two"},
+	}
+	if err := newMigrator(db, list).Up(); err != nil {
+		t.Fatalf("Up failed: %v", err)
+	}
+	if err := newMigrator(db, list).Up(); err != nil {
+		t.Fatalf("Second up failed: %v", err)
+	}
+	if scripts := fake.scripts(); strings.Join(scripts, ";") != "CREATE TABLE one();CREATE TABLE two()" {
+		t.Errorf("Expected each migration applied once, got %v", scripts)
+	}
+	if fake.applied[1] != list[0].Checksum() || fake.applied[2] != list[1].Checksum() {
+		t.Errorf("Expected checksums recorded, got %v", fake.applied)
+	}
+	if !fake.lockedDuring["CREATE TABLE one()"] || fake.locked {
+		t.Errorf("Expected migrations to run under advisory lock and lock released")
+	}
+
+	status, err := newMigrator(db, list).Status()
+	if err != nil || len(status) != 2 || !status[0].Applied || !status[1].Applied {
+		t.Errorf("Expected both migrations applied, got %+v %v", status, err)
+	}
+	if err := newMigrator(db, list).Down(1); err != nil {
+		t.Fatalf("Down failed: %v", err)
+	}
+	if scripts := fake.scripts(); len(fake.applied) != 1 || scripts[len(scripts)-1] != "DROP TABLE two" {
+		t.Errorf("Expected last migration reverted, got %v %v", fake.applied, fake.scripts())
+	}
+}
+
+func TestMigratorChecksumMismatch(t *testing.T) {
+	db, fake := openFakeMigrationDB(t)
+	list := []Migration{{Version: 1, Name: "one", Up: "CREATE TABLE one()", Down: "DROP TABLE one"}}
+	if err := newMigrator(db, list).Up(); err != nil {
+		t.Fatalf("Up failed: %v", err)
+	}
+
+	list[0].Up = "CREATE TABLE one(id INT)"
+	list = append(list, Migration{Version: 2, Name: "two", Up: "CREATE TABLE two()", Down: "DROP TABLE two"})
+	if err := newMigrator(db, list).Up(); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("Expected checksum mismatch, got %v", err)
+	}
+	if err := newMigrator(db, list[1:]).Up(); err == nil || !strings.Contains(err.Error(), "unknown") {
+		t.Errorf("Expected unknown applied migration error, got %v", err)
+	}
+	if len(fake.scripts()) != 1 || fake.locked {
+		t.Errorf("Expected no migration applied and lock released, got %v", fake.scripts())
+	}
+}
+
+func TestMigratorFailedMigration(t *testing.T) {
+	db, fake := openFakeMigrationDB(t)
+	list := []Migration{
+		{Version: 1, Name: "one", Up: "CREATE TABLE one()", Down: "DROP TABLE one"},
+		{Version: 2, Name: "broken", Up: "FAIL", Down: "DROP TABLE two"},
+		{Version: 3, Name: "three", Up: "CREATE TABLE three()", Down: "DROP TABLE three"},
+	}
+	if err := newMigrator(db, list).Up(); err == nil || !strings.Contains(err.Error(), "migration 2(broken) failed") {
+		t.Errorf("Expected migration 2 to fail, got %v", err)
+	}
+	if _, ok := fake.applied[2]; ok || len(fake.applied) != 1 || fake.rollbacks != 1 || fake.locked {
+		t.Errorf("Expected failed migration rolled back and lock released, applied %v rollbacks %d", fake.applied, fake.rollbacks)
+	}
+
+	// Another instance holds the lock, nothing is applied.
+	fake.locked = true
+	if err := newMigrator(db, list[:1]).Up(); err == nil || len(fake.scripts()) != 1 {
+		t.Errorf("Expected migrations to need advisory lock, got %v %v", err, fake.scripts())
+	}
+}
+
+// fakeMigrationDB - in memory stand in for the statements the migrator runs on postgres.
+type fakeMigrationDB struct {
+	sync.Mutex
+	applied      map[int]string
+	log          []string
+	locked       bool
+	lockedDuring map[string]bool
+	rollbacks    int
+}
+
+var (
+	fakeMigrationDBs  = make(map[string]*fakeMigrationDB)
+	fakeMigrationOnce sync.Once
+)
+
+func openFakeMigrationDB(t *testing.T) (*sql.DB, *fakeMigrationDB) {
+	fakeMigrationOnce.Do(func() { sql.Register("fakemigration", fakeMigrationDriver{}) })
+	fake := &fakeMigrationDB{applied: make(map[int]string), lockedDuring: make(map[string]bool)}
+	fakeMigrationDBs[t.Name()] = fake
+	db, err := sql.Open("fakemigration", t.Name())
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, fake
+}
+
+// scripts - migration scripts run in order.
+func (db *fakeMigrationDB) scripts() []string {
+	db.Lock()
+	defer db.Unlock()
+	return append([]string(nil), db.log...)
+}
+
+func (db *fakeMigrationDB) exec(query string, args []driver.Value) error {
+	db.Lock()
+	defer db.Unlock()
+	switch {
+	case strings.HasPrefix(query, "SELECT pg_advisory_lock"):
+		if db.locked {
+			return errors.New("advisory lock is already held")
+		}
+		db.locked = true
+	case strings.HasPrefix(query, "SELECT pg_advisory_unlock"):
+		db.locked = false
+	case query == createMigrationTable:
+	case strings.HasPrefix(query, "INSERT INTO schema_migrations"):
+		db.applied[int(args[0].(int64))] = args[2].(string)
+	case strings.HasPrefix(query, "DELETE FROM schema_migrations"):
+		delete(db.applied, int(args[0].(int64)))
+	case query == "FAIL":
+		return errors.New("syntax error")
+	default:
+		db.log = append(db.log, query)
+		db.lockedDuring[query] = db.locked
+	}
+	return nil
+}
+
+func (db *fakeMigrationDB) rows() [][]driver.Value {
+	db.Lock()
+	defer db.Unlock()
+	var rows [][]driver.Value
+	for version, checksum := range db.applied {
+		rows = append(rows, []driver.Value{int64(version), checksum, time.Now()})
+	}
+	return rows
+}
+
+type fakeMigrationDriver struct{}
+
+func (fakeMigrationDriver) Open(name string) (driver.Conn, error) {
+	return &fakeMigrationConn{db: fakeMigrationDBs[name]}, nil
+}
+
+type fakeMigrationConn struct {
+	db *fakeMigrationDB
+}
+
+func (c *fakeMigrationConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeMigrationStmt{db: c.db, query: query}, nil
+}
+
+func (c *fakeMigrationConn) Close() error { return nil }
+
+func (c *fakeMigrationConn) Begin() (driver.Tx, error) { return &fakeMigrationTx{db: c.db}, nil }
+
+type fakeMigrationTx struct {
+	db *fakeMigrationDB
+}
+
+func (tx *fakeMigrationTx) Commit() error { return nil }
+
+func (tx *fakeMigrationTx) Rollback() error {
+	tx.db.Lock()
+	defer tx.db.Unlock()
+	tx.db.rollbacks++
+	return nil
+}
+
+type fakeMigrationStmt struct {
+	db    *fakeMigrationDB
+	query string
+}
+
+func (s *fakeMigrationStmt) Close() error  { return nil }
+func (s *fakeMigrationStmt) NumInput() int { return -1 }
+
+func (s *fakeMigrationStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if err := s.db.exec(s.query, args); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeMigrationStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if !strings.HasPrefix(s.query, "SELECT version, checksum, applied_at FROM schema_migrations") {
+		return nil, errors.New("unexpected query " + s.query)
+	}
+	return &fakeMigrationRows{rows: s.db.rows()}, nil
+}
+
+type fakeMigrationRows struct {
+	rows [][]driver.Value
+}
+
+func (r *fakeMigrationRows) Columns() []string { return []string{"version", "checksum", "applied_at"} }
+func (r *fakeMigrationRows) Close() error      { return nil }
+
+func (r *fakeMigrationRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
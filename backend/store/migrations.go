@@ -0,0 +1,539 @@
+package store
+
+// migrations - ordered schema changes of nyota database. Never edit a migration once it is
+// released, checksum of applied migrations is verified on every start. Add a new one instead.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "users and events",
+		Up: `
+CREATE TABLE IF NOT EXISTS user_tenant_details (
+	username    TEXT PRIMARY KEY,
+	password    TEXT NOT NULL DEFAULT '',
+	tenant_id   TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT ''
+);
+
+-- Tables created earlier by gorp have case sensitive attribute columns.
+DO $$
+BEGIN
+	IF EXISTS (SELECT 1 FROM information_schema.columns
+		WHERE table_name = 'user_tenant_details' AND column_name = 'Role') THEN
+		ALTER TABLE user_tenant_details RENAME COLUMN "Role" TO role;
+		ALTER TABLE user_tenant_details RENAME COLUMN "Permissions" TO permissions;
+	END IF;
+END $$;
+
+ALTER TABLE user_tenant_details ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT '';
+ALTER TABLE user_tenant_details ADD COLUMN IF NOT EXISTS permissions TEXT NOT NULL DEFAULT '{}';
+DROP TABLE IF EXISTS user_tenant_attributes;
+
+CREATE TABLE IF NOT EXISTS events (
+	id          SERIAL PRIMARY KEY,
+	name        TEXT NOT NULL DEFAULT '',
+	description TEXT NOT NULL DEFAULT '',
+	event_date  TIMESTAMPTZ,
+	detail      TEXT NOT NULL DEFAULT '{}',
+	username    TEXT NOT NULL DEFAULT '',
+	tenant_id   TEXT NOT NULL DEFAULT '',
+	added_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);`,
+		Down: `
+DROP TABLE IF EXISTS events;
+DROP TABLE IF EXISTS user_tenant_details;`,
+	},
+	{
+		Version: 2,
+		Name:    "ccc tables",
+		Up: `
+CREATE TABLE IF NOT EXISTS ccc_tenant (
+	id          TEXT PRIMARY KEY,
+	name        TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS ccc_cluster (
+	id           SERIAL PRIMARY KEY,
+	uuid         TEXT NOT NULL,
+	name         TEXT NOT NULL,
+	description  TEXT NOT NULL DEFAULT '',
+	cppm_version TEXT NOT NULL DEFAULT '',
+	tenant_id    TEXT NOT NULL,
+	added_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS ccc_cppm_node (
+	id                         SERIAL PRIMARY KEY,
+	tenant_id                  TEXT NOT NULL,
+	cluster_id                 INTEGER NOT NULL,
+	is_standby                 BOOLEAN NOT NULL DEFAULT false,
+	cppm_version               TEXT NOT NULL DEFAULT '',
+	server_uuid                TEXT NOT NULL DEFAULT '',
+	server_dns_name            TEXT NOT NULL DEFAULT '',
+	fqdn                       TEXT NOT NULL DEFAULT '',
+	server_ip                  TEXT NOT NULL DEFAULT '',
+	management_ip              TEXT NOT NULL DEFAULT '',
+	ipv6_server_ip             TEXT NOT NULL DEFAULT '',
+	ipv6_management_ip         TEXT NOT NULL DEFAULT '',
+	is_master                  BOOLEAN NOT NULL DEFAULT false,
+	provider_uuid              TEXT NOT NULL DEFAULT '',
+	domain_id                  INTEGER NOT NULL DEFAULT 0,
+	is_profiler_enabled        BOOLEAN NOT NULL DEFAULT false,
+	is_insight_enabled         BOOLEAN NOT NULL DEFAULT false,
+	is_insight_master          BOOLEAN NOT NULL DEFAULT false,
+	is_perfmas_enabled         BOOLEAN NOT NULL DEFAULT false,
+	is_cloud_tunnel_enabled    BOOLEAN NOT NULL DEFAULT false,
+	is_ingress_events_enabled  BOOLEAN NOT NULL DEFAULT false,
+	dhcp_span_intf             TEXT NOT NULL DEFAULT '',
+	replication_status         TEXT NOT NULL DEFAULT '',
+	last_replication_timestamp TIMESTAMPTZ,
+	added_at                   TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at                 TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS ccc_role (
+	id          SERIAL PRIMARY KEY,
+	name        TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	tenant_id   TEXT NOT NULL,
+	permit_id   INTEGER NOT NULL DEFAULT 0,
+	extras      TEXT NOT NULL DEFAULT '{}',
+	added_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS ccc_role_cluster (
+	tenant_id  TEXT NOT NULL,
+	cluster_id INTEGER NOT NULL,
+	role_id    INTEGER NOT NULL,
+	cppm_id    INTEGER NOT NULL DEFAULT 0,
+	PRIMARY KEY (role_id, cluster_id)
+);
+
+CREATE TABLE IF NOT EXISTS ccc_event_cluster (
+	tenant_id  TEXT NOT NULL,
+	cluster_id INTEGER NOT NULL,
+	event_id   INTEGER NOT NULL,
+	cppm_id    INTEGER NOT NULL DEFAULT 0,
+	PRIMARY KEY (event_id, cluster_id)
+);`,
+		Down: `
+DROP TABLE IF EXISTS ccc_event_cluster;
+DROP TABLE IF EXISTS ccc_role_cluster;
+DROP TABLE IF EXISTS ccc_role;
+DROP TABLE IF EXISTS ccc_cppm_node;
+DROP TABLE IF EXISTS ccc_cluster;
+DROP TABLE IF EXISTS ccc_tenant;`,
+	},
+	{
+		Version: 3,
+		Name:    "ccc indexes and foreign keys",
+		Up: `
+-- Every tenant referenced by existing data must exist before tenant keys are enforced.
+INSERT INTO ccc_tenant (id, name)
+	SELECT DISTINCT tenant_id, tenant_id FROM (
+		SELECT tenant_id FROM ccc_cluster
+		UNION SELECT tenant_id FROM ccc_cppm_node
+		UNION SELECT tenant_id FROM ccc_role
+		UNION SELECT tenant_id FROM user_tenant_details
+	) referenced
+	ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE ccc_cluster ADD CONSTRAINT ccc_cluster_tenant_fk
+	FOREIGN KEY (tenant_id) REFERENCES ccc_tenant (id);
+ALTER TABLE ccc_cppm_node ADD CONSTRAINT ccc_cppm_node_tenant_fk
+	FOREIGN KEY (tenant_id) REFERENCES ccc_tenant (id);
+ALTER TABLE ccc_cppm_node ADD CONSTRAINT ccc_cppm_node_cluster_fk
+	FOREIGN KEY (cluster_id) REFERENCES ccc_cluster (id) ON DELETE CASCADE;
+ALTER TABLE ccc_role ADD CONSTRAINT ccc_role_tenant_fk
+	FOREIGN KEY (tenant_id) REFERENCES ccc_tenant (id);
+ALTER TABLE ccc_role_cluster ADD CONSTRAINT ccc_role_cluster_role_fk
+	FOREIGN KEY (role_id) REFERENCES ccc_role (id) ON DELETE CASCADE;
+ALTER TABLE ccc_role_cluster ADD CONSTRAINT ccc_role_cluster_cluster_fk
+	FOREIGN KEY (cluster_id) REFERENCES ccc_cluster (id) ON DELETE CASCADE;
+ALTER TABLE ccc_event_cluster ADD CONSTRAINT ccc_event_cluster_event_fk
+	FOREIGN KEY (event_id) REFERENCES events (id) ON DELETE CASCADE;
+ALTER TABLE ccc_event_cluster ADD CONSTRAINT ccc_event_cluster_cluster_fk
+	FOREIGN KEY (cluster_id) REFERENCES ccc_cluster (id) ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX ccc_cluster_uuid_idx ON ccc_cluster (uuid);
+CREATE INDEX ccc_cluster_tenant_idx ON ccc_cluster (tenant_id);
+CREATE INDEX ccc_cppm_node_tenant_idx ON ccc_cppm_node (tenant_id);
+CREATE INDEX ccc_cppm_node_cluster_idx ON ccc_cppm_node (cluster_id);
+CREATE INDEX ccc_cppm_node_server_uuid_idx ON ccc_cppm_node (server_uuid);
+CREATE INDEX ccc_role_tenant_idx ON ccc_role (tenant_id);
+CREATE INDEX ccc_role_cluster_tenant_idx ON ccc_role_cluster (tenant_id);
+CREATE INDEX ccc_role_cluster_cluster_idx ON ccc_role_cluster (cluster_id);
+CREATE INDEX ccc_event_cluster_tenant_idx ON ccc_event_cluster (tenant_id);
+CREATE INDEX ccc_event_cluster_cluster_idx ON ccc_event_cluster (cluster_id);
+CREATE INDEX events_tenant_idx ON events (tenant_id);
+CREATE INDEX events_username_idx ON events (username);
+CREATE INDEX user_tenant_details_tenant_idx ON user_tenant_details (tenant_id);`,
+		Down: `
+DROP INDEX IF EXISTS user_tenant_details_tenant_idx;
+DROP INDEX IF EXISTS events_username_idx;
+DROP INDEX IF EXISTS events_tenant_idx;
+DROP INDEX IF EXISTS ccc_event_cluster_cluster_idx;
+DROP INDEX IF EXISTS ccc_event_cluster_tenant_idx;
+DROP INDEX IF EXISTS ccc_role_cluster_cluster_idx;
+DROP INDEX IF EXISTS ccc_role_cluster_tenant_idx;
+DROP INDEX IF EXISTS ccc_role_tenant_idx;
+DROP INDEX IF EXISTS ccc_cppm_node_server_uuid_idx;
+DROP INDEX IF EXISTS ccc_cppm_node_cluster_idx;
+DROP INDEX IF EXISTS ccc_cppm_node_tenant_idx;
+DROP INDEX IF EXISTS ccc_cluster_tenant_idx;
+DROP INDEX IF EXISTS ccc_cluster_uuid_idx;
+
+ALTER TABLE ccc_event_cluster DROP CONSTRAINT IF EXISTS ccc_event_cluster_cluster_fk;
+ALTER TABLE ccc_event_cluster DROP CONSTRAINT IF EXISTS ccc_event_cluster_event_fk;
+ALTER TABLE ccc_role_cluster DROP CONSTRAINT IF EXISTS ccc_role_cluster_cluster_fk;
+ALTER TABLE ccc_role_cluster DROP CONSTRAINT IF EXISTS ccc_role_cluster_role_fk;
+ALTER TABLE ccc_role DROP CONSTRAINT IF EXISTS ccc_role_tenant_fk;
+ALTER TABLE ccc_cppm_node DROP CONSTRAINT IF EXISTS ccc_cppm_node_cluster_fk;
+ALTER TABLE ccc_cppm_node DROP CONSTRAINT IF EXISTS ccc_cppm_node_tenant_fk;
+ALTER TABLE ccc_cluster DROP CONSTRAINT IF EXISTS ccc_cluster_tenant_fk;`,
+	},
+	{
+		Version: 4,
+		Name:    "user password salt",
+		Up: `
+-- Rows without salt hold legacy plaintext passwords, they are rehashed on next login.
+ALTER TABLE user_tenant_details ADD COLUMN salt TEXT NOT NULL DEFAULT '';`,
+		Down: `
+ALTER TABLE user_tenant_details DROP COLUMN IF EXISTS salt;`,
+	},
+	{
+		Version: 5,
+		Name:    "cluster credentials",
+		Up: `
+CREATE TABLE ccc_cluster_credential (
+	key_id     TEXT PRIMARY KEY,
+	cluster_id INTEGER NOT NULL REFERENCES ccc_cluster (id) ON DELETE CASCADE,
+	tenant_id  TEXT NOT NULL REFERENCES ccc_tenant (id),
+	secret     TEXT NOT NULL,
+	added_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX ccc_cluster_credential_cluster_idx ON ccc_cluster_credential (cluster_id);
+
+-- Nonces of signed requests seen within the replay window.
+CREATE TABLE ccc_event_nonce (
+	key_id  TEXT NOT NULL,
+	nonce   TEXT NOT NULL,
+	seen_at TIMESTAMPTZ NOT NULL,
+	PRIMARY KEY (key_id, nonce)
+);
+CREATE INDEX ccc_event_nonce_seen_idx ON ccc_event_nonce (seen_at);`,
+		Down: `
+DROP TABLE IF EXISTS ccc_event_nonce;
+DROP TABLE IF EXISTS ccc_cluster_credential;`,
+	},
+	{
+		Version: 6,
+		Name:    "sync outbox",
+		Up: `
+-- Events for CPPM clusters, written with the config change and kept after the entity is deleted.
+CREATE TABLE ccc_sync_outbox (
+	id              SERIAL PRIMARY KEY,
+	tenant_id       TEXT NOT NULL REFERENCES ccc_tenant (id),
+	cluster_id      INTEGER NOT NULL REFERENCES ccc_cluster (id) ON DELETE CASCADE,
+	cluster_uuid    TEXT NOT NULL,
+	entity_name     TEXT NOT NULL,
+	entity_id       INTEGER NOT NULL,
+	method          TEXT NOT NULL,
+	payload         TEXT NOT NULL,
+	status          TEXT NOT NULL DEFAULT 'pending',
+	attempts        INTEGER NOT NULL DEFAULT 0,
+	last_error      TEXT NOT NULL DEFAULT '',
+	next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	added_at        TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX ccc_sync_outbox_pending_idx ON ccc_sync_outbox (cluster_id, id) WHERE status = 'pending';
+CREATE INDEX ccc_sync_outbox_entity_idx ON ccc_sync_outbox (entity_name, entity_id);`,
+		Down: `
+DROP TABLE IF EXISTS ccc_sync_outbox;`,
+	},
+	{
+		Version: 7,
+		Name:    "audit log",
+		Up: `
+-- Kept when tenant, user or entity are deleted, rows are never updated.
+CREATE TABLE audit_log (
+	id          SERIAL PRIMARY KEY,
+	tenant_id   TEXT NOT NULL,
+	user_name   TEXT NOT NULL,
+	entity      TEXT NOT NULL,
+	entity_id   TEXT NOT NULL DEFAULT '',
+	action      TEXT NOT NULL,
+	old_data    TEXT NOT NULL DEFAULT '',
+	new_data    TEXT NOT NULL DEFAULT '',
+	request_id  TEXT NOT NULL DEFAULT '',
+	remote_addr TEXT NOT NULL DEFAULT '',
+	added_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX audit_log_tenant_idx ON audit_log (tenant_id, added_at);
+CREATE INDEX audit_log_entity_idx ON audit_log (entity, entity_id);
+
+ALTER TABLE ccc_cluster ADD COLUMN added_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE ccc_cluster ADD COLUMN updated_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE ccc_cppm_node ADD COLUMN added_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE ccc_cppm_node ADD COLUMN updated_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE ccc_role ADD COLUMN added_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE ccc_role ADD COLUMN updated_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE events ADD COLUMN added_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE events ADD COLUMN updated_by TEXT NOT NULL DEFAULT '';`,
+		Down: `
+ALTER TABLE events DROP COLUMN IF EXISTS updated_by;
+ALTER TABLE events DROP COLUMN IF EXISTS added_by;
+ALTER TABLE ccc_role DROP COLUMN IF EXISTS updated_by;
+ALTER TABLE ccc_role DROP COLUMN IF EXISTS added_by;
+ALTER TABLE ccc_cppm_node DROP COLUMN IF EXISTS updated_by;
+ALTER TABLE ccc_cppm_node DROP COLUMN IF EXISTS added_by;
+ALTER TABLE ccc_cluster DROP COLUMN IF EXISTS updated_by;
+ALTER TABLE ccc_cluster DROP COLUMN IF EXISTS added_by;
+DROP TABLE IF EXISTS audit_log;`,
+	},
+	{
+		Version: 8,
+		Name:    "admin roles",
+		Up: `
+-- Custom roles of a tenant, built in roles (ADMIN, ANALYST, SUPER-ADMIN) are defined in code.
+-- Permissions is a JSON object of route group to READ, MODIFY or BLOCK.
+CREATE TABLE admin_role (
+	tenant_id   TEXT NOT NULL REFERENCES ccc_tenant (id) ON DELETE CASCADE,
+	name        TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	permissions TEXT NOT NULL DEFAULT '{}',
+	added_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+	added_by    TEXT NOT NULL DEFAULT '',
+	updated_by  TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (tenant_id, name)
+);
+CREATE INDEX user_tenant_details_role_idx ON user_tenant_details (tenant_id, role);`,
+		Down: `
+DROP INDEX IF EXISTS user_tenant_details_role_idx;
+DROP TABLE IF EXISTS admin_role;`,
+	},
+	{
+		Version: 9,
+		Name:    "api tokens",
+		Up: `
+-- Bearer tokens, hash is the sha256 of the secret part. Revoked tokens are deleted.
+CREATE TABLE api_token (
+	id           TEXT PRIMARY KEY,
+	tenant_id    TEXT NOT NULL REFERENCES ccc_tenant (id) ON DELETE CASCADE,
+	user_name    TEXT NOT NULL,
+	name         TEXT NOT NULL DEFAULT '',
+	kind         TEXT NOT NULL,
+	hash         TEXT NOT NULL,
+	scopes       TEXT NOT NULL DEFAULT '{}',
+	expires_at   TIMESTAMPTZ NOT NULL,
+	last_used_at TIMESTAMPTZ,
+	added_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+	added_by     TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX api_token_tenant_idx ON api_token (tenant_id, user_name);`,
+		Down: `
+DROP TABLE IF EXISTS api_token;`,
+	},
+	{
+		Version: 10,
+		Name:    "user sessions",
+		Up: `
+-- Login sessions, the session cookie only carries the id. Lifetimes in minutes, 0 for default.
+ALTER TABLE ccc_tenant ADD COLUMN session_idle_minutes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ccc_tenant ADD COLUMN session_max_minutes INTEGER NOT NULL DEFAULT 0;
+CREATE TABLE user_session (
+	id           TEXT PRIMARY KEY,
+	tenant_id    TEXT NOT NULL REFERENCES ccc_tenant (id) ON DELETE CASCADE,
+	user_name    TEXT NOT NULL,
+	remote_addr  TEXT NOT NULL DEFAULT '',
+	user_agent   TEXT NOT NULL DEFAULT '',
+	added_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+	last_seen_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX user_session_user_idx ON user_session (tenant_id, user_name);`,
+		Down: `
+DROP TABLE IF EXISTS user_session;
+ALTER TABLE ccc_tenant DROP COLUMN IF EXISTS session_max_minutes;
+ALTER TABLE ccc_tenant DROP COLUMN IF EXISTS session_idle_minutes;`,
+	},
+	{
+		Version: 11,
+		Name:    "identity providers",
+		Up: `
+-- OpenID Connect providers of tenants, users of single sign-on are created on first login.
+CREATE TABLE identity_provider (
+	id                 TEXT PRIMARY KEY,
+	tenant_id          TEXT NOT NULL REFERENCES ccc_tenant (id) ON DELETE CASCADE,
+	name               TEXT NOT NULL,
+	issuer             TEXT NOT NULL,
+	client_id          TEXT NOT NULL,
+	client_secret      TEXT NOT NULL DEFAULT '',
+	scopes             TEXT NOT NULL DEFAULT '[]',
+	user_claim         TEXT NOT NULL DEFAULT 'email',
+	tenant_claim       TEXT NOT NULL DEFAULT '',
+	tenant_claim_value TEXT NOT NULL DEFAULT '',
+	groups_claim       TEXT NOT NULL DEFAULT 'groups',
+	group_roles        TEXT NOT NULL DEFAULT '{}',
+	default_role       TEXT NOT NULL DEFAULT '',
+	enabled            BOOLEAN NOT NULL DEFAULT TRUE,
+	added_at           TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at         TIMESTAMPTZ NOT NULL DEFAULT now(),
+	added_by           TEXT NOT NULL DEFAULT '',
+	updated_by         TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX identity_provider_tenant_idx ON identity_provider (tenant_id);
+ALTER TABLE user_tenant_details ADD COLUMN identity_provider TEXT NOT NULL DEFAULT '';`,
+		Down: `
+ALTER TABLE user_tenant_details DROP COLUMN IF EXISTS identity_provider;
+DROP TABLE IF EXISTS identity_provider;`,
+	},
+	{
+		Version: 12,
+		Name:    "multi-factor authentication",
+		Up: `
+-- TOTP second factor of users, tenants may require it for all password logins.
+CREATE TABLE user_mfa (
+	user_name      TEXT PRIMARY KEY REFERENCES user_tenant_details (username) ON DELETE CASCADE,
+	tenant_id      TEXT NOT NULL,
+	secret         TEXT NOT NULL,
+	enabled        BOOLEAN NOT NULL DEFAULT FALSE,
+	recovery_codes TEXT NOT NULL DEFAULT '[]',
+	last_step      BIGINT NOT NULL DEFAULT 0,
+	added_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+	enabled_at     TIMESTAMPTZ
+);
+ALTER TABLE ccc_tenant ADD COLUMN mfa_required BOOLEAN NOT NULL DEFAULT FALSE;`,
+		Down: `
+ALTER TABLE ccc_tenant DROP COLUMN IF EXISTS mfa_required;
+DROP TABLE IF EXISTS user_mfa;`,
+	},
+	{
+		Version: 13,
+		Name:    "config versions",
+		Up: `
+-- Row versions of config resources, bumped by every update and checked against If-Match.
+ALTER TABLE ccc_role ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE ccc_cluster ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE ccc_cppm_node ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE events ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE ccc_tenant ADD COLUMN version INTEGER NOT NULL DEFAULT 1;`,
+		Down: `
+ALTER TABLE ccc_tenant DROP COLUMN IF EXISTS version;
+ALTER TABLE events DROP COLUMN IF EXISTS version;
+ALTER TABLE ccc_cppm_node DROP COLUMN IF EXISTS version;
+ALTER TABLE ccc_cluster DROP COLUMN IF EXISTS version;
+ALTER TABLE ccc_role DROP COLUMN IF EXISTS version;`,
+	},
+	{
+		Version: 14,
+		Name:    "event attendees",
+		Up: `
+-- Tickets of attendees are signed with the key of their event, existing events get one here and
+-- new events from the store. Keys come from the cryptographic generator of pgcrypto.
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+ALTER TABLE events ADD COLUMN ticket_key TEXT NOT NULL DEFAULT '';
+UPDATE events SET ticket_key = encode(gen_random_bytes(32), 'hex');
+CREATE TABLE event_attendee (
+	id            SERIAL PRIMARY KEY,
+	event_id      INTEGER NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+	tenant_id     TEXT NOT NULL,
+	name          TEXT NOT NULL,
+	email         TEXT NOT NULL,
+	registered_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	registered_by TEXT NOT NULL DEFAULT '',
+	checked_in_at TIMESTAMPTZ,
+	checked_in_by TEXT NOT NULL DEFAULT '',
+	scans         INTEGER NOT NULL DEFAULT 0,
+	UNIQUE (event_id, email)
+);`,
+		Down: `
+DROP TABLE IF EXISTS event_attendee;
+ALTER TABLE events DROP COLUMN IF EXISTS ticket_key;`,
+	},
+	{
+		Version: 15,
+		Name:    "blob objects",
+		Up: `
+-- Objects of the postgres blob store, e.g. QR codes of events. They can be regenerated.
+CREATE TABLE blob_object (
+	key          TEXT PRIMARY KEY,
+	content_type TEXT NOT NULL DEFAULT '',
+	data         BYTEA NOT NULL,
+	updated_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);`,
+		Down: `
+DROP TABLE IF EXISTS blob_object;`,
+	},
+	{
+		Version: 16,
+		Name:    "calendar feeds",
+		Up: `
+-- Feed tokens are part of the URL calendar clients subscribe to, only the hash of the secret is kept.
+CREATE TABLE calendar_feed (
+	id           TEXT PRIMARY KEY,
+	tenant_id    TEXT NOT NULL,
+	user_name    TEXT NOT NULL,
+	hash         TEXT NOT NULL,
+	added_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+	last_used_at TIMESTAMPTZ,
+	UNIQUE (tenant_id, user_name)
+);`,
+		Down: `
+DROP TABLE IF EXISTS calendar_feed;`,
+	},
+	{
+		Version: 17,
+		Name:    "recurring events",
+		Up: `
+ALTER TABLE events ADD COLUMN time_zone TEXT NOT NULL DEFAULT 'UTC';
+ALTER TABLE events ADD COLUMN rrule TEXT NOT NULL DEFAULT '';
+ALTER TABLE events ADD COLUMN exdates TEXT NOT NULL DEFAULT '[]';
+-- Moved and cancelled occurrences, by the start the rule gives them.
+CREATE TABLE event_occurrence (
+	event_id      INTEGER NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+	tenant_id     TEXT NOT NULL,
+	recurrence_id TIMESTAMPTZ NOT NULL,
+	start_at      TIMESTAMPTZ NOT NULL,
+	cancelled     BOOLEAN NOT NULL DEFAULT false,
+	updated_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_by    TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (event_id, recurrence_id)
+);
+-- Attendees of recurring events register for one occurrence, emails once per occurrence.
+ALTER TABLE event_attendee ADD COLUMN occurrence TIMESTAMPTZ;
+ALTER TABLE event_attendee DROP CONSTRAINT IF EXISTS event_attendee_event_id_email_key;
+CREATE UNIQUE INDEX event_attendee_occurrence_email_key
+	ON event_attendee (event_id, COALESCE(occurrence, '-infinity'), email);`,
+		Down: `
+DELETE FROM event_attendee a USING event_attendee b
+	WHERE a.event_id = b.event_id AND a.email = b.email AND a.id > b.id;
+DROP INDEX IF EXISTS event_attendee_occurrence_email_key;
+ALTER TABLE event_attendee ADD CONSTRAINT event_attendee_event_id_email_key UNIQUE (event_id, email);
+ALTER TABLE event_attendee DROP COLUMN IF EXISTS occurrence;
+DROP TABLE IF EXISTS event_occurrence;
+ALTER TABLE events DROP COLUMN IF EXISTS exdates;
+ALTER TABLE events DROP COLUMN IF EXISTS rrule;
+ALTER TABLE events DROP COLUMN IF EXISTS time_zone;`,
+	},
+	{
+		Version: 18,
+		Name:    "event capacity",
+		Up: `
+ALTER TABLE events ADD COLUMN capacity INTEGER NOT NULL DEFAULT 0 CHECK (capacity >= 0);
+ALTER TABLE event_attendee ADD COLUMN status TEXT NOT NULL DEFAULT 'registered';
+ALTER TABLE event_attendee ADD COLUMN promoted_at TIMESTAMPTZ;
+-- Waitlists are promoted in order of registration.
+CREATE INDEX event_attendee_waitlist_idx ON event_attendee (event_id, status, id);`,
+		Down: `
+DROP INDEX IF EXISTS event_attendee_waitlist_idx;
+DELETE FROM event_attendee WHERE status = 'waitlisted';
+ALTER TABLE event_attendee DROP COLUMN IF EXISTS promoted_at;
+ALTER TABLE event_attendee DROP COLUMN IF EXISTS status;
+ALTER TABLE events DROP COLUMN IF EXISTS capacity;`,
+	},
+}
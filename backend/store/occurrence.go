@@ -0,0 +1,49 @@
+package store
+
+import (
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"strconv"
+	"time"
+
+	gorp "gopkg.in/gorp.v2"
+)
+
+//GetEventOccurrences - overrides of occurrences of event in order of their recurrence id
+func (store *PgStore) GetEventOccurrences(s *model.SessionContext, eventID string) ([]*config.EventOccurrence, error) {
+	logutil.Debugf(s, "Store Layer - Get Event Occurrences")
+	var occurrences []*config.EventOccurrence
+	err := store.Tenant(s).Select(&occurrences, "SELECT * FROM EVENT_OCCURRENCE WHERE EVENT_ID = $1 ORDER BY RECURRENCE_ID", eventID)
+	if err != nil {
+		return nil, err
+	}
+	return occurrences, nil
+}
+
+//UpsertEventOccurrence - insert or replace override of an occurrence of an event of the session tenant
+func (store *PgStore) UpsertEventOccurrence(s *model.SessionContext, occurrence *config.EventOccurrence) error {
+	logutil.Debugf(s, "Store Layer - Upsert Event Occurrence")
+	return execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		eventID := strconv.Itoa(occurrence.EventID)
+		if err := TenantTx(s, tx).Exists("event", eventID, "SELECT COUNT(*) FROM EVENTS WHERE ID = $1", eventID); err != nil {
+			return err
+		}
+		occurrence.UpdatedAt = time.Now()
+		occurrence.UpdatedBy = s.User.UserName
+		_, err := tx.Exec(`INSERT INTO EVENT_OCCURRENCE (EVENT_ID, TENANT_ID, RECURRENCE_ID, START_AT, CANCELLED, UPDATED_AT, UPDATED_BY)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (EVENT_ID, RECURRENCE_ID) DO UPDATE SET START_AT = EXCLUDED.START_AT, CANCELLED = EXCLUDED.CANCELLED,
+				UPDATED_AT = EXCLUDED.UPDATED_AT, UPDATED_BY = EXCLUDED.UPDATED_BY`,
+			occurrence.EventID, occurrence.TenantID, occurrence.RecurrenceID, occurrence.Start, occurrence.Cancelled,
+			occurrence.UpdatedAt, occurrence.UpdatedBy)
+		return err
+	})
+}
+
+//DeleteEventOccurrence - remove override, the occurrence is as the rule gives it again
+func (store *PgStore) DeleteEventOccurrence(s *model.SessionContext, eventID string, recurrenceID time.Time) error {
+	logutil.Debugf(s, "Store Layer - Delete Event Occurrence")
+	return store.Tenant(s).Exec("occurrence", recurrenceID.Format(time.RFC3339),
+		"DELETE FROM EVENT_OCCURRENCE WHERE EVENT_ID = $1 AND RECURRENCE_ID = $2", eventID, recurrenceID)
+}
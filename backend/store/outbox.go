@@ -0,0 +1,144 @@
+package store
+
+import (
+	"encoding/json"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"nyota/backend/utils"
+	"strconv"
+	"time"
+
+	gorp "gopkg.in/gorp.v2"
+)
+
+// newOutboxEvent - outbox row delivering event for entity to cluster. Payload is downgraded to the
+// CPPM version of cluster on dispatch.
+func newOutboxEvent(tenantID string, cluster *config.Cluster, entityID int, event model.Event) *config.OutboxEvent {
+	event.TenantID = tenantID
+	event.CPPMVersion = cluster.CppmVersion
+	payload, _ := json.Marshal(event)
+	now := time.Now()
+	return &config.OutboxEvent{
+		TenantID:      tenantID,
+		ClusterID:     cluster.ID,
+		ClusterUUID:   cluster.UUID,
+		EntityName:    event.Data.EntityName,
+		EntityID:      entityID,
+		Method:        event.Data.Method,
+		Payload:       string(payload),
+		Status:        config.SyncPending,
+		NextAttemptAt: now,
+		AddedAt:       now,
+		UpdatedAt:     now,
+	}
+}
+
+// syncedEntity - config entity synced to the clusters it is linked to.
+type syncedEntity interface {
+	EntityName() string
+	URL() string
+}
+
+// upsertSyncEvents - entity id of tenantID is sent to every mapped cluster of diff, as update where
+// CPPM already has it. Clusters unmapped from the entity get a delete when CPPM has it.
+func upsertSyncEvents(entity syncedEntity, tenantID string, id int, diff *clusterLinkDiff) []*config.OutboxEvent {
+	var events []*config.OutboxEvent
+	for _, cluster := range diff.Mapped {
+		method := utils.HttpPost
+		if diff.CppmIDs[cluster.ID] != 0 {
+			method = utils.HttpPut
+		}
+		event := utils.GetEventObj(cluster.UUID, entity.EntityName(), entity.URL(), id, diff.CppmIDs[cluster.ID], method, entity)
+		events = append(events, newOutboxEvent(tenantID, cluster, id, event))
+	}
+	for _, cluster := range diff.Unmapped {
+		if diff.CppmIDs[cluster.ID] == 0 {
+			continue
+		}
+		event := utils.GetEventObj(cluster.UUID, entity.EntityName(), entity.URL(), id, diff.CppmIDs[cluster.ID], utils.HttpDelete, nil)
+		events = append(events, newOutboxEvent(tenantID, cluster, id, event))
+	}
+	return events
+}
+
+// insertOutboxEvents - adds events to outbox in transaction tx of the config change.
+func insertOutboxEvents(s *model.SessionContext, tx *gorp.Transaction, events []*config.OutboxEvent) error {
+	for _, event := range events {
+		if err := tx.Insert(event); err != nil {
+			logutil.Errorf(s, "insert outbox event for cluster %s failed: %v", event.ClusterUUID, err)
+			return err
+		}
+	}
+	return nil
+}
+
+//GetDueOutboxEvents - pending events due for (re)dispatch, at most one per cluster: the oldest pending
+// one, so a cluster receives its changes in order.
+func (store *PgStore) GetDueOutboxEvents(now time.Time, limit int) ([]*config.OutboxEvent, error) {
+	var events []*config.OutboxEvent
+	err := store.DB().Select(&events, `SELECT * FROM CCC_SYNC_OUTBOX O WHERE O.STATUS = $1 AND O.NEXT_ATTEMPT_AT <= $2
+		AND NOT EXISTS (SELECT 1 FROM CCC_SYNC_OUTBOX P WHERE P.CLUSTER_ID = O.CLUSTER_ID AND P.STATUS = $1 AND P.ID < O.ID)
+		ORDER BY O.ID LIMIT $3`, config.SyncPending, now, limit)
+	return events, err
+}
+
+//UpdateOutboxEvent - saves dispatch state of event, false when another dispatcher changed it since
+// it was read with prevAttempts
+func (store *PgStore) UpdateOutboxEvent(event *config.OutboxEvent, prevAttempts int) (bool, error) {
+	result, err := store.DB().Exec(`UPDATE CCC_SYNC_OUTBOX SET STATUS = $1, ATTEMPTS = $2, LAST_ERROR = $3,
+		NEXT_ATTEMPT_AT = $4, UPDATED_AT = $5 WHERE ID = $6 AND ATTEMPTS = $7 AND STATUS = $8`,
+		event.Status, event.Attempts, event.LastError, event.NextAttemptAt, event.UpdatedAt, event.ID,
+		prevAttempts, config.SyncPending)
+	if err != nil {
+		return false, err
+	}
+	count, err := result.RowsAffected()
+	return count == 1, err
+}
+
+//AckOutboxEvent - marks event synced on callback of cluster. Without event id the oldest unsynced event
+// of the entity is acknowledged, as sent by clusters which do not echo the id.
+func (store *PgStore) AckOutboxEvent(s *model.SessionContext, clusterID, eventID int, entityName string, entityID int) error {
+	logutil.Debugf(s, "Store Layer - Ack Outbox Event")
+	result, err := store.DB().Exec(`UPDATE CCC_SYNC_OUTBOX SET STATUS = $1, LAST_ERROR = '', UPDATED_AT = $2
+		WHERE ID = (SELECT ID FROM CCC_SYNC_OUTBOX WHERE CLUSTER_ID = $3 AND TENANT_ID = $4 AND STATUS <> $1
+		AND (ID = $5 OR ($5 = 0 AND ENTITY_NAME = $6 AND ENTITY_ID = $7)) ORDER BY ID LIMIT 1)`,
+		config.SyncSynced, time.Now(), clusterID, s.User.TenantId, eventID, entityName, entityID)
+	if err != nil {
+		return err
+	}
+	if count, err := result.RowsAffected(); err != nil || count == 0 {
+		if err == nil {
+			err = &model.NotFoundError{Entity: "sync event", ID: strconv.Itoa(eventID)}
+		}
+		return err
+	}
+	return nil
+}
+
+//GetRoleSyncStatus - latest event of role for every cluster
+func (store *PgStore) GetRoleSyncStatus(s *model.SessionContext, roleID string) ([]*config.OutboxEvent, error) {
+	logutil.Debugf(s, "Store Layer - Get Role Sync Status")
+	tenantDB := store.Tenant(s)
+	if err := tenantDB.Exists("role", roleID, "SELECT COUNT(*) FROM CCC_ROLE WHERE ID = $1", roleID); err != nil {
+		return nil, err
+	}
+	var events []*config.OutboxEvent
+	err := tenantDB.Select(&events, `SELECT DISTINCT ON (CLUSTER_ID) * FROM CCC_SYNC_OUTBOX
+		WHERE ENTITY_NAME = $1 AND ENTITY_ID = $2 ORDER BY CLUSTER_ID, ID DESC`, (&config.Role{}).EntityName(), roleID)
+	return events, err
+}
+
+//GetClusterSyncStatus - latest event of every entity sent to cluster
+func (store *PgStore) GetClusterSyncStatus(s *model.SessionContext, clusterID string) ([]*config.OutboxEvent, error) {
+	logutil.Debugf(s, "Store Layer - Get Cluster Sync Status")
+	tenantDB := store.Tenant(s)
+	if err := tenantDB.Exists("cluster", clusterID, "SELECT COUNT(*) FROM CCC_CLUSTER WHERE ID = $1", clusterID); err != nil {
+		return nil, err
+	}
+	var events []*config.OutboxEvent
+	err := tenantDB.Select(&events, `SELECT DISTINCT ON (ENTITY_NAME, ENTITY_ID) * FROM CCC_SYNC_OUTBOX
+		WHERE CLUSTER_ID = $1 ORDER BY ENTITY_NAME, ENTITY_ID, ID DESC`, clusterID)
+	return events, err
+}
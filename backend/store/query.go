@@ -0,0 +1,208 @@
+package store
+
+import (
+	"fmt"
+	"nyota/backend/model"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var queryOps = map[string]string{
+	model.OpEq: "=",
+	model.OpNe: "<>",
+	model.OpLt: "<",
+	model.OpLe: "<=",
+	model.OpGt: ">",
+	model.OpGe: ">=",
+}
+
+// selectPage - page of rows of table matching where and spec into holder, and the number of all
+// matching rows. Where binds args from $1, columns of spec are quoted and its values bound after args.
+func selectPage(db SqlDB, holder interface{}, table, where string, args []interface{}, spec model.QuerySpec) (int, error) {
+	conds, args := specConditions(where, args, spec)
+	total, err := db.SelectInt(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", table, conds), args...)
+	if err != nil {
+		return 0, err
+	}
+	order := "id"
+	if spec.Sort != "" {
+		direction := "ASC"
+		if spec.Desc {
+			direction = "DESC"
+		}
+		order = fmt.Sprintf("%s %s, id", pq.QuoteIdentifier(spec.Sort), direction)
+	}
+	args = append(args, spec.PageSize, spec.Offset())
+	query := fmt.Sprintf("SELECT * FROM %s WHERE %s ORDER BY %s LIMIT $%d OFFSET $%d", table, conds, order, len(args)-1, len(args))
+	return int(total), db.Select(holder, query, args...)
+}
+
+// specConditions - where with the filters and search of spec added.
+func specConditions(where string, args []interface{}, spec model.QuerySpec) (string, []interface{}) {
+	conds := []string{"(" + where + ")"}
+	bind := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	for _, filter := range spec.Filters {
+		column := pq.QuoteIdentifier(filter.Column)
+		switch filter.Op {
+		case model.OpLike:
+			conds = append(conds, fmt.Sprintf(`%s ILIKE %s ESCAPE '\'`, column, bind(likePattern(filter.Values[0].(string)))))
+		case model.OpIn:
+			var placeholders []string
+			for _, value := range filter.Values {
+				placeholders = append(placeholders, bind(value))
+			}
+			conds = append(conds, fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholders, ", ")))
+		default:
+			conds = append(conds, fmt.Sprintf("%s %s %s", column, queryOps[filter.Op], bind(filter.Values[0])))
+		}
+	}
+	if spec.Search != "" {
+		placeholder := bind(likePattern(spec.Search))
+		var matches []string
+		for _, column := range spec.SearchColumns {
+			matches = append(matches, fmt.Sprintf(`%s ILIKE %s ESCAPE '\'`, pq.QuoteIdentifier(column), placeholder))
+		}
+		conds = append(conds, "("+strings.Join(matches, " OR ")+")")
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+// likePattern - pattern matching value anywhere, wildcards in value match only themselves.
+func likePattern(value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+	return "%" + value + "%"
+}
+
+// pageRows - page of rows, a slice of struct pointers in id order, matching spec and the number
+// of all matching rows, as selectPage does in postgres. Columns are the db tags of fields.
+func pageRows(rows interface{}, spec model.QuerySpec) (interface{}, int) {
+	all := reflect.ValueOf(rows)
+	matched := reflect.MakeSlice(all.Type(), 0, all.Len())
+	for i := 0; i < all.Len(); i++ {
+		if matchRow(all.Index(i), spec) {
+			matched = reflect.Append(matched, all.Index(i))
+		}
+	}
+	if spec.Sort != "" {
+		sort.SliceStable(matched.Interface(), func(i, j int) bool {
+			c := compareValues(rowValue(matched.Index(i), spec.Sort), rowValue(matched.Index(j), spec.Sort))
+			if spec.Desc {
+				return c > 0
+			}
+			return c < 0
+		})
+	}
+	total := matched.Len()
+	from, to := spec.Offset(), spec.Offset()+spec.PageSize
+	if from > total {
+		from = total
+	}
+	if to > total {
+		to = total
+	}
+	return matched.Slice(from, to).Interface(), total
+}
+
+func matchRow(row reflect.Value, spec model.QuerySpec) bool {
+	for _, filter := range spec.Filters {
+		value := rowValue(row, filter.Column)
+		match := false
+		switch filter.Op {
+		case model.OpLike:
+			match = containsFold(value.(string), filter.Values[0].(string))
+		case model.OpIn:
+			for _, v := range filter.Values {
+				match = match || compareValues(value, v) == 0
+			}
+		default:
+			c := compareValues(value, filter.Values[0])
+			switch filter.Op {
+			case model.OpEq:
+				match = c == 0
+			case model.OpNe:
+				match = c != 0
+			case model.OpLt:
+				match = c < 0
+			case model.OpLe:
+				match = c <= 0
+			case model.OpGt:
+				match = c > 0
+			case model.OpGe:
+				match = c >= 0
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	if spec.Search == "" {
+		return true
+	}
+	for _, column := range spec.SearchColumns {
+		if containsFold(rowValue(row, column).(string), spec.Search) {
+			return true
+		}
+	}
+	return false
+}
+
+// rowValue - field of row struct with db tag column as string, int64, bool or time.Time.
+func rowValue(row reflect.Value, column string) interface{} {
+	row = reflect.Indirect(row)
+	for i := 0; i < row.NumField(); i++ {
+		if row.Type().Field(i).Tag.Get("db") != column {
+			continue
+		}
+		field := row.Field(i)
+		switch field.Kind() {
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			return field.Int()
+		case reflect.String:
+			return field.String()
+		}
+		return field.Interface()
+	}
+	return nil
+}
+
+// compareValues - -1, 0 or 1 as a is less than, equal to or greater than b of the same type.
+func compareValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case string:
+		return strings.Compare(a, b.(string))
+	case int64:
+		switch {
+		case a < b.(int64):
+			return -1
+		case a > b.(int64):
+			return 1
+		}
+	case bool:
+		switch {
+		case a == b.(bool):
+			return 0
+		case !a:
+			return -1
+		}
+		return 1
+	case time.Time:
+		switch {
+		case a.Before(b.(time.Time)):
+			return -1
+		case a.After(b.(time.Time)):
+			return 1
+		}
+	}
+	return 0
+}
+
+func containsFold(value, substr string) bool {
+	return strings.Contains(strings.ToLower(value), strings.ToLower(substr))
+}
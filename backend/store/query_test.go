@@ -0,0 +1,63 @@
+package store
+
+import (
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"reflect"
+	"testing"
+)
+
+func TestSpecConditions(t *testing.T) {
+	spec := model.QuerySpec{
+		Filters: []model.QueryFilter{
+			{Column: "name", Op: model.OpLike, Values: []interface{}{"50%_off"}},
+			{Column: "cluster_id", Op: model.OpIn, Values: []interface{}{int64(1), int64(2)}},
+			{Column: `x"; drop table ccc_role; --`, Op: model.OpGe, Values: []interface{}{"a"}},
+		},
+		Search:        "guest",
+		SearchColumns: []string{"name", "description"},
+	}
+	conds, args := specConditions("tenant_id=$1", []interface{}{"1"}, spec)
+	expected := `(tenant_id=$1) AND "name" ILIKE $2 ESCAPE '\' AND "cluster_id" IN ($3, $4) AND ` +
+		`"x""; drop table ccc_role; --" >= $5 AND ("name" ILIKE $6 ESCAPE '\' OR "description" ILIKE $6 ESCAPE '\')`
+	if conds != expected {
+		t.Errorf("specConditions = %s, expected %s", conds, expected)
+	}
+	expectedArgs := []interface{}{"1", `%50\%\_off%`, int64(1), int64(2), "a", "%guest%"}
+	if !reflect.DeepEqual(args, expectedArgs) {
+		t.Errorf("Expected args %v, got %v", expectedArgs, args)
+	}
+}
+
+func TestPageRows(t *testing.T) {
+	roles := []*config.Role{
+		{ID: 1, Name: "Guest", Description: "b"},
+		{ID: 2, Name: "admin", Description: "a"},
+		{ID: 3, Name: "guest-wifi", Description: "a"},
+		{ID: 4, Name: "staff", Description: "c"},
+	}
+	tests := []struct {
+		spec     model.QuerySpec
+		expected []int
+		total    int
+	}{
+		{model.QuerySpec{Page: 2, PageSize: 3}, []int{4}, 4},
+		{model.QuerySpec{Page: 1, PageSize: 10, Sort: "description", Desc: true}, []int{4, 1, 2, 3}, 4},
+		{model.QuerySpec{Page: 1, PageSize: 10, Search: "GUEST", SearchColumns: []string{"name"}}, []int{1, 3}, 2},
+		{model.QuerySpec{Page: 1, PageSize: 1, Filters: []model.QueryFilter{
+			{Column: "description", Op: model.OpEq, Values: []interface{}{"a"}}}}, []int{2}, 2},
+		{model.QuerySpec{Page: 1, PageSize: 10, Filters: []model.QueryFilter{
+			{Column: "id", Op: model.OpIn, Values: []interface{}{int64(1), int64(4)}}}}, []int{1, 4}, 2},
+		{model.QuerySpec{Page: 5, PageSize: 10}, []int{}, 4},
+	}
+	for _, test := range tests {
+		page, total := pageRows(roles, test.spec)
+		ids := []int{}
+		for _, role := range page.([]*config.Role) {
+			ids = append(ids, role.ID)
+		}
+		if total != test.total || !reflect.DeepEqual(ids, test.expected) {
+			t.Errorf("pageRows(%+v) = %v of %d, expected %v of %d", test.spec, ids, total, test.expected, test.total)
+		}
+	}
+}
@@ -1,23 +1,22 @@
 package store
 
 import (
-	"fmt"
 	"nyota/backend/logutil"
 	"nyota/backend/model"
 	"nyota/backend/model/config"
-	"strings"
+	"strconv"
 	"time"
 
 	gorp "gopkg.in/gorp.v2"
 )
 
-//GetAllRoles - get all roles with Role details
-func (store *Store) GetAllRoles(s *model.SessionContext) ([]*config.Role, error) {
+//GetAllRoles - page of roles of tenant matching spec, with the number of all matching roles
+func (store *PgStore) GetAllRoles(s *model.SessionContext, spec model.QuerySpec) ([]*config.Role, int, error) {
 	logutil.Debugf(s, "Store Layer - Get All Roles")
 	var roles []*config.Role
-	err := store.DB().Select(&roles, "Select * from CCC_Role where tenant_id=$1", s.User.TenantId)
+	total, err := selectPage(store.DB(), &roles, "CCC_Role", "tenant_id=$1", []interface{}{s.User.TenantId}, spec)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// for _, role := range roles {
@@ -30,14 +29,14 @@ func (store *Store) GetAllRoles(s *model.SessionContext) ([]*config.Role, error)
 	// 	}
 	// 	role.Clusters = clusters
 	// }
-	return roles, nil
+	return roles, total, nil
 }
 
 //GetRoleByID - get role based on id
-func (store *Store) GetRoleByID(s *model.SessionContext, id string) (*config.Role, error) {
+func (store *PgStore) GetRoleByID(s *model.SessionContext, id string) (*config.Role, error) {
 	logutil.Debugf(s, "Store Layer - Get Role By Id")
 	var role *config.Role
-	err := store.DB().SelectOne(&role, "Select * from CCC_Role where id=$1", id)
+	err := store.Tenant(s).SelectOne(&role, "role", id, "Select * from CCC_Role where id=$1", id)
 	if err != nil {
 		return nil, err
 	}
@@ -55,18 +54,32 @@ func (store *Store) GetRoleByID(s *model.SessionContext, id string) (*config.Rol
 }
 
 //UpsertRole - insert or update role
-func (store *Store) UpsertRole(s *model.SessionContext, role *config.Role) error {
+func (store *PgStore) UpsertRole(s *model.SessionContext, role *config.Role) error {
 	logutil.Debugf(s, "Store Layer - Upsert All Roles")
 	err := execTx(s, store.DB(), func(tx *gorp.Transaction) (err error) {
 
 		// upsert segment
+		role.UpdatedBy = s.User.UserName
 		if role.ID == 0 {
 			role.AddedAt = time.Now()
 			role.UpdatedAt = role.AddedAt
+			role.AddedBy = role.UpdatedBy
+			role.Version = 0
 			err = tx.Insert(role)
 		} else {
+			id := strconv.Itoa(role.ID)
+			var existing *config.Role
+			if err = TenantTx(s, tx).SelectOne(&existing, "role", id, "select * from ccc_role where id=$1", id); err != nil {
+				return err
+			}
+			role.AddedAt, role.AddedBy = existing.AddedAt, existing.AddedBy
 			role.UpdatedAt = time.Now()
+			// Without expected version the update applies to whatever version is current.
+			if role.Version == 0 {
+				role.Version = existing.Version
+			}
 			_, err = tx.Update(role)
+			err = versionError("role", id, err)
 		}
 
 		if err != nil {
@@ -74,112 +87,62 @@ func (store *Store) UpsertRole(s *model.SessionContext, role *config.Role) error
 			return err
 		}
 
-		//get existing clusters for role
-		var existingRoleClusters []*config.RoleCluster
-		err = store.DB().Select(&existingRoleClusters, "select * from ccc_role_cluster where role_id=$1", role.ID)
-		if nil != err {
-			logutil.Errorf(s, "Error in fetching clusters from role cluster association table,err:%v", err)
+		// Sync events are committed with the change, the dispatcher delivers them to the clusters.
+		diff, err := roleClusterLinks.sync(s, tx, role.TenantID, role.ID, linkedClusterIDs(role.Clusters))
+		if err != nil {
+			return err
+		}
+		if err = insertOutboxEvents(s, tx, upsertSyncEvents(role, role.TenantID, role.ID, diff)); err != nil {
+			return err
 		}
 
-		var createRoleClusterIDs []int
-		var deleteRoleClusterIDs []int
-
-		/*
-			for _, cluster := range role.Clusters {
-					if cluster.ID == 0 {
-						cluster.AddedAt = time.Now()
-						cluster.UpdatedAt = cluster.AddedAt
-						err = tx.Insert(cluster)
-					} else {
-						cluster.UpdatedAt = time.Now()
-						_, err = tx.Update(cluster)
-					}
-
-					if err != nil {
-						logutil.Errorf(s, "upsert cluster:(%s) failed, error : %v", cluster.Name, err)
-						return err
-					}
-			}
-		*/
-		for _, existingRoleClusters := range existingRoleClusters {
-			isPresent := false
-			for _, cluster := range role.Clusters {
-				if existingRoleClusters.ClusterID == cluster.ID {
-					isPresent = true
-					break
-				}
-			}
+		logutil.Debugf(s, "Upsert Role Successful")
+		return nil
+	})
 
-			if !isPresent {
-				deleteRoleClusterIDs = append(deleteRoleClusterIDs, existingRoleClusters.ClusterID)
-			}
-		}
+	return err
+}
 
-		for _, cluster := range role.Clusters {
-			isPresent := false
-			for _, existingRoleClusters := range existingRoleClusters {
-				if existingRoleClusters.ClusterID == cluster.ID {
-					isPresent = true
-					break
-				}
-			}
-			if !isPresent {
-				createRoleClusterIDs = append(createRoleClusterIDs, cluster.ID)
-			}
+func (store *PgStore) DeleteRole(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Store Layer - Delete Role By Id")
+	return execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		tenantTx := TenantTx(s, tx)
+		var role *config.Role
+		if err := tenantTx.SelectOne(&role, "role", id, "SELECT * FROM CCC_ROLE WHERE ID = $1", id); err != nil {
+			return err
 		}
 
-		//create new association
-		var createRoleClusters []interface{}
-		for _, clusterID := range createRoleClusterIDs {
-			roleCluster := &config.RoleCluster{}
-			roleCluster.ClusterID = clusterID
-			roleCluster.RoleID = role.ID
-			roleCluster.TenantID = role.TenantID
-			createRoleClusters = append(createRoleClusters, roleCluster)
+		// Clusters which have the role get a delete event.
+		diff, err := roleClusterLinks.sync(s, tx, role.TenantID, role.ID, nil)
+		if err != nil {
+			return err
 		}
-
-		if len(createRoleClusters) > 0 {
-			err = tx.Insert(createRoleClusters...)
-			if err != nil {
-				logutil.Errorf(s, "insert role cluster mappings failed: %v", err)
-				return err
-			}
+		if err := insertOutboxEvents(s, tx, upsertSyncEvents(role, role.TenantID, role.ID, diff)); err != nil {
+			return err
 		}
-
-		// delete mappings
-		if len(deleteRoleClusterIDs) > 0 {
-			clusterIDs := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(deleteRoleClusterIDs)), ","), "[]")
-			_, err = tx.Exec("DELETE FROM ccc_role_cluster WHERE tenant_id = $1 and role_id = $2 and cluster_id in($3)", role.TenantID, role.ID, clusterIDs)
-			if err != nil {
-				logutil.Errorf(s, "delete role cluster mappings failed: %v", err)
-				return err
-			}
+		if err := tenantTx.Exec("role", id, "DELETE FROM CCC_ROLE WHERE ID = $1", id); err != nil {
+			logutil.Errorf(s, "Role deletion failed.")
+			return err
 		}
-
-		logutil.Debugf(s, "Upsert Role Successful")
 		return nil
 	})
-
-	return err
 }
 
-func (store *Store) DeleteRole(s *model.SessionContext, id string) error {
-	logutil.Debugf(s, "Store Layer - Delete Role By Id")
-	_, errDelRoleCluster := store.DB().Exec("DELETE FROM CCC_ROLE_CLUSTER WHERE ROLE_ID = $1 and TENANT_ID = $2", id, s.User.TenantId)
-	if errDelRoleCluster != nil {
-		logutil.Debugf(s, "Deletion failed in mapping table of Role Cluster.")
-		return errDelRoleCluster
-	}
-	_, errDelRole := store.DB().Exec("DELETE FROM CCC_ROLE WHERE ID = $1", id)
-	if errDelRole != nil {
-		logutil.Errorf(s, "Role deletion failed.")
-		return errDelRole
+// txClusters - clusters with ids, read in transaction tx.
+func txClusters(tx *gorp.Transaction, ids []int) ([]*config.Cluster, error) {
+	var clusters []*config.Cluster
+	for _, id := range ids {
+		var cluster *config.Cluster
+		if err := tx.SelectOne(&cluster, "SELECT * FROM CCC_CLUSTER WHERE ID = $1", id); err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, cluster)
 	}
-	return nil
+	return clusters, nil
 }
 
 /*
-func (store *Store) GetRoleCluster(roleID int, clusterID int, tenantID string) *config.RoleCluster {
+func (store *PgStore) GetRoleCluster(roleID int, clusterID int, tenantID string) *config.RoleCluster {
 	var roleCluster *config.RoleCluster
 	err := store.DB().Select(&roleCluster, "SELECT * from ccc_role_cluster WHERE role_id = $1 and cluster_id=$2 and tenant_id = $3", roleID, clusterID, tenantID)
 	if err != nil {
@@ -189,7 +152,7 @@ func (store *Store) GetRoleCluster(roleID int, clusterID int, tenantID string) *
 }
 */
 
-func (store *Store) GetRoleClusterCPPMID(roleID int, clusterID int, tenantID string) int {
+func (store *PgStore) GetRoleClusterCPPMID(roleID int, clusterID int, tenantID string) int {
 	rows, err := store.DB().Query(`SELECT cppm_id from ccc_role_cluster WHERE role_id = $1 and cluster_id=$2 and tenant_id = $3`, roleID, clusterID, tenantID)
 	if err != nil {
 		return 0
@@ -202,10 +165,15 @@ func (store *Store) GetRoleClusterCPPMID(roleID int, clusterID int, tenantID str
 	return cppmID
 }
 
-func (store *Store) UpdateRoleWithCPPMID(s *model.SessionContext, roleID int, uuid string, cppmID int) {
-	res, err := store.DB().Exec("UPDATE CCC_ROLE_CLUSTER SET CPPM_ID=$1 WHERE ROLE_ID = $2 AND CLUSTER_ID = (SELECT ID FROM CCC_CLUSTER WHERE UUID=$3)", cppmID, roleID, uuid)
+//UpdateRoleWithCPPMID - record CPPM id of role for cluster uuid, NotFoundError when role is not mapped to cluster
+func (store *PgStore) UpdateRoleWithCPPMID(s *model.SessionContext, roleID int, uuid string, cppmID int) error {
+	var cluster *config.Cluster
+	err := store.Tenant(s).SelectOne(&cluster, "cluster", uuid, "SELECT * FROM CCC_CLUSTER WHERE UUID = $1", uuid)
+	if nil == err {
+		err = store.Tenant(s).Exec("role", strconv.Itoa(roleID), "UPDATE CCC_ROLE_CLUSTER SET CPPM_ID=$1 WHERE ROLE_ID = $2 AND CLUSTER_ID = $3", cppmID, roleID, cluster.ID)
+	}
 	if nil != err {
-		logutil.Errorf(s, "CPPM ID updation failed in role cluster association table")
+		logutil.Errorf(s, "CPPM ID updation failed in role cluster association table: %v", err)
 	}
-	logutil.Debugf(s, "Result - %v", res)
+	return err
 }
@@ -0,0 +1,63 @@
+package store
+
+import (
+	"database/sql"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"time"
+)
+
+//GetUserSessions - sessions of tenant, only those of userName unless empty
+func (store *PgStore) GetUserSessions(s *model.SessionContext, userName string) ([]*model.UserSession, error) {
+	logutil.Debugf(s, "Store Layer - Get User Sessions")
+	var sessions []*model.UserSession
+	query, args := "SELECT * FROM USER_SESSION ORDER BY LAST_SEEN_AT DESC", []interface{}{}
+	if userName != "" {
+		query, args = "SELECT * FROM USER_SESSION WHERE USER_NAME = $1 ORDER BY LAST_SEEN_AT DESC", []interface{}{userName}
+	}
+	if err := store.Tenant(s).Select(&sessions, query, args...); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+//GetUserSessionByID - session for validating requests
+func (store *PgStore) GetUserSessionByID(id string) (*model.UserSession, error) {
+	var session *model.UserSession
+	err := store.DB().SelectOne(&session, "SELECT * FROM USER_SESSION WHERE ID = $1", id)
+	if err == sql.ErrNoRows {
+		return nil, &model.NotFoundError{Entity: "session", ID: id}
+	}
+	return session, err
+}
+
+//AddUserSession - insert session at login
+func (store *PgStore) AddUserSession(session *model.UserSession) error {
+	return store.DB().Insert(session)
+}
+
+//TouchUserSession - records use of session
+func (store *PgStore) TouchUserSession(id string, seenAt time.Time) error {
+	_, err := store.DB().Exec("UPDATE USER_SESSION SET LAST_SEEN_AT = $1 WHERE ID = $2", seenAt, id)
+	return err
+}
+
+//DeleteUserSession - revoke session of tenant
+func (store *PgStore) DeleteUserSession(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Store Layer - Delete User Session")
+	return store.Tenant(s).Exec("session", id, "DELETE FROM USER_SESSION WHERE ID = $1", id)
+}
+
+//DeleteUserSessions - revoke all sessions of user but exceptID
+func (store *PgStore) DeleteUserSessions(s *model.SessionContext, userName, exceptID string) error {
+	logutil.Debugf(s, "Store Layer - Delete User Sessions")
+	_, err := store.DB().Exec("DELETE FROM USER_SESSION WHERE TENANT_ID = $1 AND USER_NAME = $2 AND ID <> $3",
+		s.User.TenantId, userName, exceptID)
+	return err
+}
+
+//DeleteExpiredSessions - removes sessions unused since seenBefore or started before addedBefore
+func (store *PgStore) DeleteExpiredSessions(seenBefore, addedBefore time.Time) error {
+	_, err := store.DB().Exec("DELETE FROM USER_SESSION WHERE LAST_SEEN_AT < $1 OR ADDED_AT < $2", seenBefore, addedBefore)
+	return err
+}
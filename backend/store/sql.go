@@ -30,3 +30,16 @@ func execTx(s *model.SessionContext, db SqlDB, exec TxExecFunc) error {
 
 	return nil
 }
+
+// versionError - VersionMismatchError of entity id for optimistic lock errors of gorp version
+// columns, NotFoundError when the row is gone and err otherwise.
+func versionError(entity, id string, err error) error {
+	lockErr, ok := err.(gorp.OptimisticLockError)
+	if !ok {
+		return err
+	}
+	if !lockErr.RowExists {
+		return &model.NotFoundError{Entity: entity, ID: id}
+	}
+	return &model.VersionMismatchError{Entity: entity, ID: id, Version: int(lockErr.LocalVersion)}
+}
@@ -8,52 +8,61 @@ import (
 	"time"
 )
 
-func (store *Store) GetTenants(s *model.SessionContext) ([]*config.Tenant, error) {
+func (store *PgStore) GetTenants(s *model.SessionContext) ([]*config.Tenant, error) {
 
 	logutil.Debugf(s, "Store Layer - Get All Tenants")
 	var tenants []*config.Tenant
-	err := store.DB().Select(&tenants, "Select * from CCC_Tenant")
+	err := store.tenantRows(s).Select(&tenants, "Select * from CCC_Tenant")
 	if err != nil {
 		return nil, err
 	}
 	return tenants, nil
 }
 
-func (store *Store) GetTenantById(s *model.SessionContext, id string) (*config.Tenant, error) {
+func (store *PgStore) GetTenantById(s *model.SessionContext, id string) (*config.Tenant, error) {
 
 	logutil.Debugf(s, "Store Layer - Get Tenant By Id")
 	var tenant *config.Tenant
-	err := store.DB().SelectOne(&tenant, "Select * from CCC_Tenant where id=$1", id)
+	err := store.tenantRows(s).SelectOne(&tenant, "tenant", id, "Select * from CCC_Tenant where id=$1", id)
 	if err != nil {
 		return nil, err
 	}
 	return tenant, nil
 }
 
-func (store *Store) UpsertTenant(s *model.SessionContext, data *config.Tenant) (*config.Tenant, error) {
+func (store *PgStore) UpsertTenant(s *model.SessionContext, data *config.Tenant) (*config.Tenant, error) {
 
 	logutil.Debugf(s, "Store Layer - Upsert Tenant")
 	if data.ID == "" {
 		data.ID = fmt.Sprintf("%d", time.Now().UnixNano())
+		data.Version = 0
 		err := store.DB().Insert(data)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		_, err := store.DB().Update(data)
+		existing, err := store.GetTenantById(s, data.ID)
 		if err != nil {
 			return nil, err
 		}
+		if data.Version == 0 {
+			data.Version = existing.Version
+		}
+		_, err = store.DB().Update(data)
+		if err != nil {
+			return nil, versionError("tenant", data.ID, err)
+		}
 	}
 	return data, nil
 }
 
-func (store *Store) DeleteTenantById(s *model.SessionContext, id string) error {
+func (store *PgStore) DeleteTenantById(s *model.SessionContext, id string) error {
 
 	logutil.Debugf(s, "Store Layer - Delete Tenant By Id")
-	_, err := store.DB().Exec("Delete from CCC_Tenant where id=$1", id)
-	if err != nil {
-		return err
-	}
-	return nil
+	return store.tenantRows(s).Exec("tenant", id, "Delete from CCC_Tenant where id=$1", id)
+}
+
+// tenantRows - tenant table scoped to the tenant of session, only super admin sees all tenants.
+func (store *PgStore) tenantRows(s *model.SessionContext) *TenantDB {
+	return newTenantDB(s, store.db, "id")
 }
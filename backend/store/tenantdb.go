@@ -0,0 +1,155 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"nyota/backend/model"
+	"regexp"
+	"strings"
+
+	gorp "gopkg.in/gorp.v2"
+)
+
+var (
+	whereClause    = regexp.MustCompile(`(?i)\bwhere\b`)
+	trailingClause = regexp.MustCompile(`(?i)\b(group\s+by|order\s+by|limit|offset|for\s+update|returning)\b`)
+	placeholder    = regexp.MustCompile(`\$(\d+)`)
+	// Shapes a single "<column> = $n" can't restrict to the tenant: joins, common table expressions,
+	// set operations, aggregate filters and subqueries have clauses of their own.
+	unscopable = regexp.MustCompile(`(?i)\bjoin\b|^\s*with\b|\b(union|intersect|except)\b|\bfilter\s*\(|\(\s*select\b`)
+)
+
+// TenantDB - SqlDB restricted to the tenant of session. Selects, updates and deletes get
+// "<column> = <session tenant>" added to their where clause, so rows of other tenants are
+// neither visible nor modifiable whatever ids are passed in. Super admin sessions are not
+// restricted. Queries must be on a single table with a single where clause. Joins, common table
+// expressions, unions, aggregate filters and subqueries are refused, the condition could end up in
+// the wrong clause; such queries have to filter the tenant themselves on the SqlDB.
+type TenantDB struct {
+	db       gorp.SqlExecutor
+	column   string
+	tenantID string
+	bypass   bool
+}
+
+// Tenant - tenant scoped handle on the tenant_id column.
+func (store *PgStore) Tenant(s *model.SessionContext) *TenantDB {
+	return newTenantDB(s, store.db, "tenant_id")
+}
+
+// TenantTx - tenant scoped handle running in transaction tx.
+func TenantTx(s *model.SessionContext, tx *gorp.Transaction) *TenantDB {
+	return newTenantDB(s, tx, "tenant_id")
+}
+
+func newTenantDB(s *model.SessionContext, db gorp.SqlExecutor, column string) *TenantDB {
+	return &TenantDB{db: db, column: column, tenantID: s.User.TenantId, bypass: s.User.IsSuperAdmin}
+}
+
+// SelectOne - scoped select of a single row, NotFoundError when there is no visible row.
+func (t *TenantDB) SelectOne(holder interface{}, entity, id string, query string, args ...interface{}) error {
+	query, args, err := t.scope(query, args)
+	if err != nil {
+		return err
+	}
+	err = t.db.SelectOne(holder, query, args...)
+	if err == sql.ErrNoRows {
+		return &model.NotFoundError{Entity: entity, ID: id}
+	}
+	return err
+}
+
+// Select - scoped select of rows.
+func (t *TenantDB) Select(i interface{}, query string, args ...interface{}) error {
+	query, args, err := t.scope(query, args)
+	if err != nil {
+		return err
+	}
+	// Same as SqlDB, unmapped columns of 'select *' are not an error.
+	_, err = t.db.Select(i, query, args...)
+	if _, ok := err.(*gorp.NoFieldInTypeError); ok {
+		return nil
+	}
+	return err
+}
+
+// Exists - NotFoundError unless the scoped query matches at least one row.
+func (t *TenantDB) Exists(entity, id string, query string, args ...interface{}) error {
+	query, args, err := t.scope(query, args)
+	if err != nil {
+		return err
+	}
+	count, err := t.db.SelectInt(query, args...)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return &model.NotFoundError{Entity: entity, ID: id}
+	}
+	return nil
+}
+
+// Exec - scoped delete or update, NotFoundError when no visible row was affected.
+func (t *TenantDB) Exec(entity, id string, query string, args ...interface{}) error {
+	query, args, err := t.scope(query, args)
+	if err != nil {
+		return err
+	}
+	res, err := t.db.Exec(query, args...)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return &model.NotFoundError{Entity: entity, ID: id}
+	}
+	return nil
+}
+
+// scope - adds tenant condition to query, tenant id is bound as the next positional argument.
+func (t *TenantDB) scope(query string, args []interface{}) (string, []interface{}, error) {
+	if t.bypass {
+		return query, args, nil
+	}
+	query, err := scopeQuery(query, t.column, nextPlaceholder(query))
+	if err != nil {
+		return "", nil, err
+	}
+	return query, append(args, t.tenantID), nil
+}
+
+// nextPlaceholder - number of the first positional argument not used by query.
+func nextPlaceholder(query string) int {
+	max := 0
+	for _, m := range placeholder.FindAllStringSubmatch(query, -1) {
+		var n int
+		fmt.Sscan(m[1], &n)
+		if n > max {
+			max = n
+		}
+	}
+	return max + 1
+}
+
+// scopeQuery - "... WHERE (<cond>) AND <column> = $n ..." keeping group by, order by etc. after it.
+// Existing condition is parenthesised so that OR in it can not widen the scope. Error for queries
+// of any other shape than a single table with one where, see TenantDB.
+func scopeQuery(query, column string, n int) (string, error) {
+	query = strings.TrimRight(strings.TrimSpace(query), ";")
+	if unscopable.MatchString(query) {
+		return "", fmt.Errorf("query can't be scoped to tenant: %s", query)
+	}
+	if wheres := whereClause.FindAllStringIndex(query, -1); len(wheres) > 1 ||
+		len(wheres) == 1 && strings.Count(query[:wheres[0][0]], "(") > strings.Count(query[:wheres[0][0]], ")") {
+		return "", fmt.Errorf("query can't be scoped to tenant, it has a subquery: %s", query)
+	}
+	tail := ""
+	if loc := trailingClause.FindStringIndex(query); loc != nil {
+		query, tail = strings.TrimSpace(query[:loc[0]]), " "+query[loc[0]:]
+	}
+	cond := fmt.Sprintf("%s = $%d", column, n)
+	if loc := whereClause.FindStringIndex(query); loc != nil {
+		return fmt.Sprintf("%s WHERE (%s) AND %s%s", strings.TrimSpace(query[:loc[0]]),
+			strings.TrimSpace(query[loc[1]:]), cond, tail), nil
+	}
+	return fmt.Sprintf("%s WHERE %s%s", query, cond, tail), nil
+}
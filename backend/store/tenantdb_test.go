@@ -0,0 +1,212 @@
+package store
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"nyota/backend/model"
+	"nyota/backend/model/config"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestScopeQuery(t *testing.T) {
+	tests := []struct {
+		query, expected string
+	}{
+		{"Select * from CCC_Cluster where id=$1",
+			"Select * from CCC_Cluster WHERE (id=$1) AND tenant_id = $2"},
+		{"DELETE FROM CCC_CPPM_NODE WHERE ID = $1 OR 1=1;",
+			"DELETE FROM CCC_CPPM_NODE WHERE (ID = $1 OR 1=1) AND tenant_id = $2"},
+		{"Select * from CCC_Role",
+			"Select * from CCC_Role WHERE tenant_id = $1"},
+		{"select * from events where name=$2 and id=$1 order by id limit 10",
+			"select * from events WHERE (name=$2 and id=$1) AND tenant_id = $3 order by id limit 10"},
+		{"update ccc_role set name=$1 where id=$2 returning id",
+			"update ccc_role set name=$1 WHERE (id=$2) AND tenant_id = $3 returning id"},
+	}
+	tests = append(tests, struct{ query, expected string }{eventAttendanceQuery,
+		eventAttendanceQuery[:strings.LastIndex(eventAttendanceQuery, "WHERE")] + "WHERE (EVENT_ID = $1) AND tenant_id = $4"})
+	for _, test := range tests {
+		if got, err := scopeQuery(test.query, "tenant_id", nextPlaceholder(test.query)); err != nil || got != test.expected {
+			t.Errorf("scopeQuery(%q) = %q %v, expected %q", test.query, got, err, test.expected)
+		}
+	}
+
+	// Aggregates with CASE keep the single where of the table.
+	caseQuery := "SELECT SUM(CASE WHEN STATUS = $2 THEN 1 ELSE 0 END) FROM EVENT_ATTENDEE WHERE EVENT_ID = $1"
+	if got, err := scopeQuery(caseQuery, "tenant_id", 3); err != nil || !strings.HasSuffix(got, "WHERE (EVENT_ID = $1) AND tenant_id = $3") {
+		t.Errorf("scopeQuery(%q) = %q %v, expected outer where scoped", caseQuery, got, err)
+	}
+}
+
+func TestScopeQueryRefusesShapes(t *testing.T) {
+	// The tenant condition would restrict only one table, one query or the wrong clause.
+	tests := []struct {
+		shape, query string
+	}{
+		{"subquery in where", "UPDATE CCC_ROLE_CLUSTER SET CPPM_ID=$1 WHERE ROLE_ID = $2 AND CLUSTER_ID = (SELECT ID FROM CCC_CLUSTER WHERE UUID=$3)"},
+		{"in subquery", "SELECT * FROM CCC_ROLE WHERE ID IN (SELECT ROLE_ID FROM CCC_ROLE_CLUSTER WHERE CLUSTER_ID = $1)"},
+		{"exists subquery", "DELETE FROM CCC_ROLE WHERE ID = $1 AND EXISTS (SELECT 1 FROM CCC_ROLE_CLUSTER)"},
+		{"derived table", "SELECT * FROM (SELECT * FROM CCC_ROLE WHERE ID = $1) AS ROLE"},
+		{"derived table without where", "SELECT COUNT(*) FROM ( select ID FROM CCC_CLUSTER) AS C ORDER BY 1"},
+		{"join", "SELECT R.* FROM CCC_ROLE R JOIN CCC_ROLE_CLUSTER RC ON RC.ROLE_ID = R.ID WHERE RC.CLUSTER_ID = $1"},
+		{"left join", "select * from ccc_role left outer join ccc_role_cluster on role_id = id"},
+		{"cte", "WITH R AS (SELECT * FROM CCC_ROLE) SELECT * FROM R WHERE ID = $1"},
+		{"recursive cte", "  with recursive r as (select 1) select * from r"},
+		{"union", "SELECT ID FROM CCC_ROLE WHERE NAME = $1 UNION SELECT ID FROM CCC_CLUSTER WHERE NAME = $1"},
+		{"union all", "SELECT ID FROM CCC_ROLE UNION ALL SELECT ID FROM CCC_CLUSTER"},
+		{"intersect", "SELECT ID FROM CCC_ROLE INTERSECT SELECT ROLE_ID FROM CCC_ROLE_CLUSTER"},
+		{"except", "SELECT ID FROM CCC_ROLE EXCEPT SELECT ROLE_ID FROM CCC_ROLE_CLUSTER"},
+		{"aggregate filter", "SELECT COUNT(*) FILTER (WHERE STATUS = $2) FROM EVENT_ATTENDEE WHERE EVENT_ID = $1"},
+		{"aggregate filter without where", "SELECT COUNT(*) filter(where status = $1) FROM EVENT_ATTENDEE"},
+		{"two wheres", "SELECT * FROM CCC_ROLE WHERE ID = $1 WHERE NAME = $2"},
+	}
+	for _, test := range tests {
+		if got, err := scopeQuery(test.query, "tenant_id", nextPlaceholder(test.query)); err == nil {
+			t.Errorf("%s: scopeQuery(%q) = %q, expected error", test.shape, test.query, got)
+		}
+	}
+
+	s := &model.SessionContext{User: &model.UserContext{TenantId: "1"}}
+	if err := newTenantDB(s, nil, "tenant_id").Exec("role", "1", tests[0].query, 1, 2, "u"); err == nil || model.IsNotFound(err) {
+		t.Errorf("Expected refused query to fail before reaching the database, got %v", err)
+	}
+}
+
+// TestStoreQueriesAreScopable - every literal query of the store run through TenantDB has a shape
+// scopeQuery accepts, so none of them fails only against postgres.
+func TestStoreQueriesAreScopable(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool { return !strings.HasSuffix(info.Name(), "_test.go") }, 0)
+	if err != nil {
+		t.Fatalf("Parsing store failed: %v", err)
+	}
+	constants := map[string]string{}
+	var calls []*ast.CallExpr
+	for _, file := range pkgs["store"].Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.ValueSpec:
+				for i, name := range node.Names {
+					if i < len(node.Values) {
+						if lit, ok := node.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+							constants[name.Name], _ = strconv.Unquote(lit.Value)
+						}
+					}
+				}
+			case *ast.CallExpr:
+				calls = append(calls, node)
+			}
+			return true
+		})
+	}
+
+	// Position of the query in the arguments of TenantDB methods.
+	queryArg := map[string]int{"SelectOne": 3, "Select": 1, "Exists": 2, "Exec": 2}
+	checked := 0
+	for _, call := range calls {
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !isTenantDB(selector.X) {
+			continue
+		}
+		i, ok := queryArg[selector.Sel.Name]
+		if !ok || i >= len(call.Args) {
+			continue
+		}
+		var query string
+		switch arg := call.Args[i].(type) {
+		case *ast.BasicLit:
+			query, _ = strconv.Unquote(arg.Value)
+		case *ast.Ident:
+			query = constants[arg.Name]
+		}
+		if query == "" {
+			continue // built at runtime
+		}
+		checked++
+		if _, err := scopeQuery(query, "tenant_id", nextPlaceholder(query)); err != nil {
+			t.Errorf("%s: %v", fset.Position(call.Pos()), err)
+		}
+	}
+	if checked < 30 {
+		t.Errorf("Expected the queries of the store checked, found %d", checked)
+	}
+}
+
+// isTenantDB - expression is Tenant(s), TenantTx(s, tx) or a variable holding one.
+func isTenantDB(expr ast.Expr) bool {
+	switch expr := expr.(type) {
+	case *ast.CallExpr:
+		switch fun := expr.Fun.(type) {
+		case *ast.SelectorExpr:
+			return fun.Sel.Name == "Tenant"
+		case *ast.Ident:
+			return fun.Name == "TenantTx"
+		}
+	case *ast.Ident:
+		return expr.Name == "tenantTx"
+	}
+	return false
+}
+
+func TestTenantScopeBypass(t *testing.T) {
+	s := &model.SessionContext{User: &model.UserContext{TenantId: "1"}}
+	db := newTenantDB(s, nil, "tenant_id")
+	if query, args, _ := db.scope("select * from ccc_role where id=$1", []interface{}{"5"}); len(args) != 2 || args[1] != "1" ||
+		query != "select * from ccc_role WHERE (id=$1) AND tenant_id = $2" {
+		t.Errorf("Expected tenant scoped query, got %q %v", query, args)
+	}
+
+	s.User.IsSuperAdmin = true
+	db = newTenantDB(s, nil, "tenant_id")
+	if query, args, _ := db.scope("select * from ccc_role where id=$1", []interface{}{"5"}); len(args) != 1 ||
+		query != "select * from ccc_role where id=$1" {
+		t.Errorf("Expected super admin query unchanged, got %q %v", query, args)
+	}
+}
+
+func TestMemStoreTenantIsolation(t *testing.T) {
+	store := NewMemStore()
+	owner := &model.SessionContext{User: &model.UserContext{TenantId: "1"}}
+	other := &model.SessionContext{User: &model.UserContext{TenantId: "2"}}
+	admin := &model.SessionContext{User: &model.UserContext{TenantId: "2", IsSuperAdmin: true}}
+
+	cluster, _ := store.UpsertCluster(owner, &config.Cluster{Name: "east", TenantID: "1"})
+	id := "1"
+	if cluster.ID != 1 {
+		t.Fatalf("Expected first cluster id 1, got %d", cluster.ID)
+	}
+
+	if _, err := store.GetClusterById(other, id); !model.IsNotFound(err) {
+		t.Errorf("Expected not found for other tenant, got %v", err)
+	}
+	if _, err := store.UpsertCluster(other, &config.Cluster{ID: cluster.ID, Name: "stolen", TenantID: "2"}); !model.IsNotFound(err) {
+		t.Errorf("Expected update by other tenant to be not found, got %v", err)
+	}
+	if err := store.DeleteClusterById(other, id); !model.IsNotFound(err) {
+		t.Errorf("Expected delete by other tenant to be not found, got %v", err)
+	}
+	if err := store.UpsertRole(other, &config.Role{Name: "guest", TenantID: "2", Clusters: []*config.Cluster{cluster}}); !model.IsNotFound(err) {
+		t.Errorf("Expected mapping of other tenant cluster to fail, got %v", err)
+	}
+	if data, err := store.GetClusterById(admin, id); err != nil || data.Name != "east" {
+		t.Errorf("Expected super admin to read cluster, got %v %v", data, err)
+	}
+	if err := store.DeleteClusterById(owner, id); err != nil {
+		t.Errorf("Expected owner to delete cluster, got %v", err)
+	}
+
+	store.UpsertUser(owner, &model.UserTenantDetails{UserName: "ann@nyota.com", TenantID: "1"})
+	if err := store.DeleteUser(other, "ann@nyota.com"); !model.IsNotFound(err) {
+		t.Errorf("Expected delete of user by other tenant to be not found, got %v", err)
+	}
+	if err := store.DeleteUser(owner, "ann@nyota.com"); err != nil {
+		t.Errorf("Expected owner to delete user, got %v", err)
+	}
+	if err := store.DeleteUser(owner, "ann@nyota.com"); !model.IsNotFound(err) {
+		t.Errorf("Expected delete of missing user to be not found, got %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"database/sql"
+	"nyota/backend/logutil"
+	"nyota/backend/model"
+	"time"
+)
+
+//GetAPITokens - API tokens of tenant, hashes are not returned
+func (store *PgStore) GetAPITokens(s *model.SessionContext) ([]*model.APIToken, error) {
+	logutil.Debugf(s, "Store Layer - Get API Tokens")
+	var tokens []*model.APIToken
+	err := store.Tenant(s).Select(&tokens, "SELECT * FROM API_TOKEN ORDER BY ADDED_AT")
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range tokens {
+		token.Hash = ""
+	}
+	return tokens, nil
+}
+
+//GetAPITokenByID - token with hash for authenticating bearer requests
+func (store *PgStore) GetAPITokenByID(id string) (*model.APIToken, error) {
+	var token *model.APIToken
+	err := store.DB().SelectOne(&token, "SELECT * FROM API_TOKEN WHERE ID = $1", id)
+	if err == sql.ErrNoRows {
+		return nil, &model.NotFoundError{Entity: "api token", ID: id}
+	}
+	return token, err
+}
+
+//AddAPIToken - insert token for the session tenant
+func (store *PgStore) AddAPIToken(s *model.SessionContext, token *model.APIToken) error {
+	logutil.Debugf(s, "Store Layer - Add API Token")
+	token.AddedAt = time.Now()
+	token.AddedBy = s.User.UserName
+	return store.DB().Insert(token)
+}
+
+//DeleteAPIToken - revoke token of tenant
+func (store *PgStore) DeleteAPIToken(s *model.SessionContext, id string) error {
+	logutil.Debugf(s, "Store Layer - Delete API Token")
+	return store.Tenant(s).Exec("api token", id, "DELETE FROM API_TOKEN WHERE ID = $1", id)
+}
+
+//TouchAPIToken - records use of token
+func (store *PgStore) TouchAPIToken(id string, usedAt time.Time) error {
+	_, err := store.DB().Exec("UPDATE API_TOKEN SET LAST_USED_AT = $1 WHERE ID = $2", usedAt, id)
+	return err
+}
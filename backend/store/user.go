@@ -3,13 +3,14 @@ package store
 import (
 	"nyota/backend/logutil"
 	"nyota/backend/model"
+	"nyota/backend/model/config"
 
 	gorp "gopkg.in/gorp.v2"
 )
 
 //GetAllUsers - get all users with user details
-func (store *Store) GetAllUsers(s *model.SessionContext) ([]*model.UserTenantDetails, error) {
-	logutil.Debugf(s, "Store Layer - Get All Roles")
+func (store *PgStore) GetAllUsers(s *model.SessionContext) ([]*model.UserTenantDetails, error) {
+	logutil.Debugf(s, "Store Layer - Get All Users")
 	var users []*model.UserTenantDetails
 	err := store.DB().Select(&users, "Select * from USER_Tenant_Details where tenant_id=$1", s.User.TenantId)
 	if err != nil {
@@ -30,7 +31,7 @@ func (store *Store) GetAllUsers(s *model.SessionContext) ([]*model.UserTenantDet
 }
 
 //GetUserByName - get user based on username
-func (store *Store) GetUserByName(s *model.SessionContext, username string) (*model.UserTenantDetails, error) {
+func (store *PgStore) GetUserByName(s *model.SessionContext, username string) (*model.UserTenantDetails, error) {
 	logutil.Debugf(s, "Store Layer - Get User By UserName")
 	var user *model.UserTenantDetails
 	err := store.DB().SelectOne(&user, "Select * from user_tenant_details where username=$1", username)
@@ -51,25 +52,72 @@ func (store *Store) GetUserByName(s *model.SessionContext, username string) (*mo
 }
 
 //UpsertUser - insert or update user
-func (store *Store) UpsertUser(s *model.SessionContext, user *model.UserTenantDetails) error {
+func (store *PgStore) UpsertUser(s *model.SessionContext, user *model.UserTenantDetails) error {
 	logutil.Debugf(s, "Store Layer - Upsert All Users")
 	err := execTx(s, store.DB(), func(tx *gorp.Transaction) (err error) {
+		count, err := tx.SelectInt("Select count(*) from user_tenant_details where username=$1", user.UserName)
+		if err != nil {
+			return err
+		}
+
 		// upsert user
-		err = tx.Insert(user)
+		if count == 0 {
+			err = tx.Insert(user)
+		} else {
+			_, err = tx.Update(user)
+		}
 
 		if err != nil {
 			logutil.Errorf(s, "upsert user:(%s) failed: %v", user.UserName, err)
 			return err
 		}
 
-		logutil.Debugf(s, "Upsert Role Successful")
+		logutil.Debugf(s, "Upsert User Successful")
 		return nil
 	})
 
 	return err
 }
 
-// func (store *Store) DeleteRole(s *model.SessionContext, id string) error {
+//UpdateUserPassword - replace password hash and salt of user
+func (store *PgStore) UpdateUserPassword(s *model.SessionContext, username, hash, salt string) error {
+	logutil.Debugf(s, "Store Layer - Update User Password")
+	_, err := store.DB().Exec("UPDATE user_tenant_details SET password = $1, salt = $2 WHERE username = $3", hash, salt, username)
+	return err
+}
+
+//BootstrapAdmin - creates tenant and first admin user unless the user already exists
+func (store *PgStore) BootstrapAdmin(s *model.SessionContext, tenant *config.Tenant, user *model.UserTenantDetails) (bool, error) {
+	logutil.Debugf(s, "Store Layer - Bootstrap Admin")
+	created := false
+	err := execTx(s, store.DB(), func(tx *gorp.Transaction) error {
+		_, err := tx.Exec("INSERT INTO ccc_tenant (id, name, description) VALUES ($1, $2, $3) ON CONFLICT (id) DO NOTHING",
+			tenant.ID, tenant.Name, tenant.Description)
+		if err != nil {
+			return err
+		}
+		count, err := tx.SelectInt("Select count(*) from user_tenant_details where username=$1", user.UserName)
+		if err != nil || count > 0 {
+			return err
+		}
+		created = true
+		return tx.Insert(user)
+	})
+	return created && err == nil, err
+}
+
+//DeleteUser - delete user of the session tenant
+func (store *PgStore) DeleteUser(s *model.SessionContext, username string) error {
+	logutil.Debugf(s, "Store Layer - Delete User By UserName")
+	err := store.Tenant(s).Exec("user", username, "DELETE FROM user_tenant_details WHERE username = $1", username)
+	if err != nil {
+		logutil.Errorf(s, "User deletion failed.")
+		return err
+	}
+	return nil
+}
+
+// func (store *PgStore) DeleteRole(s *model.SessionContext, id string) error {
 // 	logutil.Debugf(s, "Store Layer - Delete Role By Id")
 // 	_, errDelRoleCluster := store.DB().Exec("DELETE FROM CCC_ROLE_CLUSTER WHERE ROLE_ID = $1 and TENANT_ID = $2", id, s.User.TenantId)
 // 	if errDelRoleCluster != nil {
@@ -85,7 +133,7 @@ func (store *Store) UpsertUser(s *model.SessionContext, user *model.UserTenantDe
 // }
 
 /*
-func (store *Store) GetRoleCluster(roleID int, clusterID int, tenantID string) *config.RoleCluster {
+func (store *PgStore) GetRoleCluster(roleID int, clusterID int, tenantID string) *config.RoleCluster {
 	var roleCluster *config.RoleCluster
 	err := store.DB().Select(&roleCluster, "SELECT * from ccc_role_cluster WHERE role_id = $1 and cluster_id=$2 and tenant_id = $3", roleID, clusterID, tenantID)
 	if err != nil {
@@ -95,7 +143,7 @@ func (store *Store) GetRoleCluster(roleID int, clusterID int, tenantID string) *
 }
 */
 
-// func (store *Store) GetRoleClusterCPPMID(roleID int, clusterID int, tenantID string) int {
+// func (store *PgStore) GetRoleClusterCPPMID(roleID int, clusterID int, tenantID string) int {
 // 	rows, err := store.DB().Query(`SELECT cppm_id from ccc_role_cluster WHERE role_id = $1 and cluster_id=$2 and tenant_id = $3`, roleID, clusterID, tenantID)
 // 	if err != nil {
 // 		return 0
@@ -108,7 +156,7 @@ func (store *Store) GetRoleCluster(roleID int, clusterID int, tenantID string) *
 // 	return cppmID
 // }
 
-// func (store *Store) UpdateRoleWithCPPMID(s *model.SessionContext, roleID int, uuid string, cppmID int) {
+// func (store *PgStore) UpdateRoleWithCPPMID(s *model.SessionContext, roleID int, uuid string, cppmID int) {
 // 	res, err := store.DB().Exec("UPDATE CCC_ROLE_CLUSTER SET CPPM_ID=$1 WHERE ROLE_ID = $2 AND CLUSTER_ID = (SELECT ID FROM CCC_CLUSTER WHERE UUID=$3)", cppmID, roleID, uuid)
 // 	if nil != err {
 // 		logutil.Errorf(s, "CPPM ID updation failed in role cluster association table")
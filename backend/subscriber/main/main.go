@@ -2,7 +2,6 @@ package main
 
 import (
 	"nyota/backend/model"
-	str "nyota/backend/store"
 	"nyota/backend/watch"
 	"encoding/json"
 	"goprizm/log"
@@ -15,7 +14,7 @@ func main() {
 	go func() {
 		log.Debugf("waiting for msg")
 		for msg := range msgC {
-			log.Debugf("channel:" + msg.Channel)
+			log.Debugf("channel:%s", msg.Channel)
 			var event model.Event
 			unmarshallErr := json.Unmarshal([]byte(msg.Payload), &event)
 			if unmarshallErr != nil {
@@ -26,8 +25,6 @@ func main() {
 			}
 		}
 	}()
-	subStore := &str.Store{Watcher: watch.New()}
-	// defer subStore.watcher.redisSub.Close()
-	// defer subStore.watcher.redis.Close()
-	subStore.Watcher.SubscribeAndReceive([]string{"event"}, msgC)
+	watcher := watch.New()
+	watcher.SubscribeAndReceive([]string{"event"}, msgC)
 }
@@ -16,9 +16,9 @@ func GetClusterConfigFormatter(s *model.SessionContext, cluster *config.Cluster)
 func getClusterConfigAudit(s *model.SessionContext, cluster *config.Cluster) model.UIFormExtraFields {
 	var auditArr []interface{}
 	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_created_at"), Value: cluster.AddedAtEpoc, Show: true})
-	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_created_by"), Value: s.User.UserName, Show: true})
+	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_created_by"), Value: cluster.AddedBy, Show: true})
 	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_updated_at"), Value: cluster.UpdatedAtEpoc, Show: true})
-	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_updated_by"), Value: s.User.UserName, Show: true})
+	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_updated_by"), Value: cluster.UpdatedBy, Show: true})
 
 	audit := model.UIFormExtraFields{Header: s.TFunc("key_add_edit_changes_saved"), Show: true}
 	audit.Fields = auditArr
@@ -38,3 +38,12 @@ func getClusterConfigUIControl(s *model.SessionContext, cluster *config.Cluster)
 		Order: 3, Required: true, Value: cluster.CppmVersion, MinLength: 0, MaxLength: 100})
 	return configFormatterList
 }
+
+func GetClusterGridViewColumns(s *model.SessionContext) []model.GridColumn {
+	return []model.GridColumn{
+		model.GridColumn{Label: s.TFunc("name"), Field: "name", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("description"), Field: "description", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("cppm_version"), Field: "cppm_version", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("key_updated_at"), Field: "updated_at", CanSort: true, CanFilter: true, Width: 0,
+			Type: model.ColumnTime}}
+}
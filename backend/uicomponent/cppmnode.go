@@ -100,11 +100,28 @@ func getCPPMNodeConfigUIControl(s *model.SessionContext, cppmNode *config.CppmNo
 func getCppmNodeConfigAudit(s *model.SessionContext, cppmNode *config.CppmNode) model.UIFormExtraFields {
 	var auditArr []interface{}
 	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_created_at"), Value: cppmNode.AddedAtEpoc, Show: true})
-	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_created_by"), Value: s.User.UserName, Show: true})
+	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_created_by"), Value: cppmNode.AddedBy, Show: true})
 	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_updated_at"), Value: cppmNode.UpdatedAtEpoc, Show: true})
-	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_updated_by"), Value: s.User.UserName, Show: true})
+	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_updated_by"), Value: cppmNode.UpdatedBy, Show: true})
 
 	audit := model.UIFormExtraFields{Header: s.TFunc("key_add_edit_changes_saved"), Show: true}
 	audit.Fields = auditArr
 	return audit
 }
+
+func GetCppmNodeGridViewColumns(s *model.SessionContext) []model.GridColumn {
+	return []model.GridColumn{
+		model.GridColumn{Label: s.TFunc("cluster_id"), Field: "cluster_id", CanSort: true, CanFilter: true, Width: 0,
+			Type: model.ColumnNumber},
+		model.GridColumn{Label: s.TFunc("cppm_version"), Field: "cppm_version", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("server_uuid"), Field: "server_uuid", CanSort: false, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("server_dns_name"), Field: "server_dns_name", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("fqdn"), Field: "fqdn", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("server_ip"), Field: "server_ip", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("management_ip"), Field: "management_ip", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("is_master"), Field: "is_master", CanSort: true, CanFilter: true, Width: 0,
+			Type: model.ColumnBool},
+		model.GridColumn{Label: s.TFunc("replication_status"), Field: "replication_status", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("key_updated_at"), Field: "updated_at", CanSort: true, CanFilter: true, Width: 0,
+			Type: model.ColumnTime}}
+}
@@ -0,0 +1,16 @@
+package uicomponent
+
+import (
+	"nyota/backend/model"
+)
+
+func GetEventGridViewColumns(s *model.SessionContext) []model.GridColumn {
+	return []model.GridColumn{
+		model.GridColumn{Label: s.TFunc("key_name"), Field: "event_name", Column: "name", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("description"), Field: "event_description", Column: "description", CanSort: true,
+			CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("key_event_date"), Field: "event_date", CanSort: true, CanFilter: true, Width: 0,
+			Type: model.ColumnTime},
+		model.GridColumn{Label: s.TFunc("key_updated_at"), Field: "updated_at", CanSort: true, CanFilter: true, Width: 0,
+			Type: model.ColumnTime}}
+}
@@ -17,9 +17,9 @@ func GetRoleConfigFormatter(s *model.SessionContext, role *config.Role) *model.S
 func getRoleConfigAudit(s *model.SessionContext, role *config.Role) model.UIFormExtraFields {
 	var auditArr []interface{}
 	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_created_at"), Value: role.AddedAtEpoc, Show: true})
-	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_created_by"), Value: s.User.UserName, Show: true})
+	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_created_by"), Value: role.AddedBy, Show: true})
 	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_updated_at"), Value: role.UpdatedAtEpoc, Show: true})
-	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_updated_by"), Value: s.User.UserName, Show: true})
+	auditArr = append(auditArr, model.UIFormExtraFieldsDetails{Name: s.TFunc("key_updated_by"), Value: role.UpdatedBy, Show: true})
 
 	audit := model.UIFormExtraFields{Header: s.TFunc("key_add_edit_changes_saved"), Show: true}
 	audit.Fields = auditArr
@@ -37,10 +37,12 @@ func getRoleConfigUIControl(s *model.SessionContext, role *config.Role) []model.
 	return configFormatterList
 }
 
-func GetRoleGridViewColumns(s *model.SessionContext) []config.RoleGridColumn {
-	return []config.RoleGridColumn{
-		config.RoleGridColumn{Label: s.TFunc("key_name"), Field: "name", CanSort: false, CanFilter: true, Width: 0},
-		config.RoleGridColumn{Label: s.TFunc("description"), Field: "description", CanSort: false, CanFilter: true, Width: 0}}
+func GetRoleGridViewColumns(s *model.SessionContext) []model.GridColumn {
+	return []model.GridColumn{
+		model.GridColumn{Label: s.TFunc("key_name"), Field: "name", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("description"), Field: "description", CanSort: true, CanFilter: true, Width: 0},
+		model.GridColumn{Label: s.TFunc("key_updated_at"), Field: "updated_at", CanSort: true, CanFilter: true, Width: 0,
+			Type: model.ColumnTime}}
 }
 
 func getRoleAction(s *model.SessionContext) model.UIFormExtraFields {
@@ -8,8 +8,9 @@ const (
 	HttpDelete = "DELETE"
 
 	// Supported Roles
-	AdminUserRole   = "ADMIN"
-	AnalystUserRole = "ANALYST"
+	SuperAdminUserRole = "SUPER-ADMIN"
+	AdminUserRole      = "ADMIN"
+	AnalystUserRole    = "ANALYST"
 
 	// UI Menu Permission Key -> API grouping
 	AssetMenuPermissionKey                = "DEVICES"
@@ -17,6 +18,12 @@ const (
 	UnclassifiedMenuPermissionKey         = "UNCLASSIFIED-DEVICES"
 	PolicyManagerMenuPermissionKey        = "DISCOVERY-SETTINGS"
 	GenericMenuPermissionKey              = "COMMON-ASSET"
+	ClusterMenuPermissionKey              = "CLUSTERS"
+	CPPMNodeMenuPermissionKey             = "CPPM-NODES"
+	UserMenuPermissionKey                 = "USERS"
+	EventMenuPermissionKey                = "EVENTS"
+	RoleMenuPermissionKey                 = "ROLES"
+	TenantMenuPermissionKey               = "TENANTS"
 
 	// API Method Permissions Supported
 	ModifyPermission = "MODIFY"
@@ -36,6 +43,12 @@ func updateUserAdminPermission() map[string]string {
 	adminPermission[CustomClassificationMenuPermissionKey] = ModifyPermission
 	adminPermission[UnclassifiedMenuPermissionKey] = ModifyPermission
 	adminPermission[PolicyManagerMenuPermissionKey] = ModifyPermission
+	adminPermission[ClusterMenuPermissionKey] = ModifyPermission
+	adminPermission[CPPMNodeMenuPermissionKey] = ModifyPermission
+	adminPermission[UserMenuPermissionKey] = ModifyPermission
+	adminPermission[EventMenuPermissionKey] = ModifyPermission
+	adminPermission[RoleMenuPermissionKey] = ModifyPermission
+	adminPermission[TenantMenuPermissionKey] = ModifyPermission
 	return adminPermission
 }
 
@@ -45,5 +58,85 @@ func updateUserAnalystPermission() map[string]string {
 	analystPermission[CustomClassificationMenuPermissionKey] = BlockPermission
 	analystPermission[UnclassifiedMenuPermissionKey] = BlockPermission
 	analystPermission[PolicyManagerMenuPermissionKey] = BlockPermission
+	analystPermission[ClusterMenuPermissionKey] = ReadPermission
+	analystPermission[CPPMNodeMenuPermissionKey] = ReadPermission
+	analystPermission[UserMenuPermissionKey] = BlockPermission
+	analystPermission[EventMenuPermissionKey] = ReadPermission
+	analystPermission[RoleMenuPermissionKey] = ReadPermission
+	analystPermission[TenantMenuPermissionKey] = BlockPermission
 	return analystPermission
 }
+
+// PermissionGroups - groups routes are registered with, custom admin roles grant permissions on these.
+var PermissionGroups = []string{AssetMenuPermissionKey, CustomClassificationMenuPermissionKey,
+	UnclassifiedMenuPermissionKey, PolicyManagerMenuPermissionKey, ClusterMenuPermissionKey,
+	CPPMNodeMenuPermissionKey, UserMenuPermissionKey, EventMenuPermissionKey, RoleMenuPermissionKey,
+	TenantMenuPermissionKey}
+
+// permissionRank - order of permissions, a permission includes all lower ranked ones.
+var permissionRank = map[string]int{BlockPermission: 0, ReadPermission: 1, ModifyPermission: 2}
+
+// ValidPermissions - every group of permissions is one of PermissionGroups with a known permission.
+func ValidPermissions(permissions map[string]string) bool {
+	for group, permission := range permissions {
+		if _, ok := permissionRank[permission]; !ok || !IsPermissionGroup(group) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPermissionGroup - group is one of PermissionGroups.
+func IsPermissionGroup(group string) bool {
+	for _, known := range PermissionGroups {
+		if group == known {
+			return true
+		}
+	}
+	return false
+}
+
+// PermissionsWithin - granted gives no more access than held on any group, groups missing in
+// held are blocked. Keeps users from granting access they don't have themselves.
+func PermissionsWithin(granted, held map[string]string) bool {
+	for group, permission := range granted {
+		heldPermission, ok := held[group]
+		if permissionRank[permission] > 0 && (!ok || permissionRank[permission] > permissionRank[heldPermission]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LimitPermissions - permissions of granted reduced to those of held, groups missing in held are blocked.
+func LimitPermissions(granted, held map[string]string) map[string]string {
+	limited := make(map[string]string)
+	for group, permission := range granted {
+		heldPermission, ok := held[group]
+		if !ok {
+			heldPermission = BlockPermission
+		}
+		if permissionRank[heldPermission] < permissionRank[permission] {
+			permission = heldPermission
+		}
+		limited[group] = permission
+	}
+	return limited
+}
+
+// IsBuiltInRole - role is one of the supported roles defined in code, custom roles can't use their names.
+func IsBuiltInRole(role string) bool {
+	_, ok := RolePermissions(role)
+	return ok
+}
+
+// RolePermissions - Returns the permissions granted to a supported user role.
+func RolePermissions(role string) (map[string]string, bool) {
+	switch role {
+	case SuperAdminUserRole, AdminUserRole:
+		return AdminUserRolePermission, true
+	case AnalystUserRole:
+		return AnalystUserRolePermission, true
+	}
+	return nil, false
+}
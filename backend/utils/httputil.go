@@ -3,6 +3,7 @@ package utils
 import (
 	"nyota/backend/logutil"
 	"nyota/backend/model"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,9 +11,11 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	v "github.com/go-ozzo/ozzo-validation"
 	"github.com/gorilla/mux"
+	"github.com/lib/pq"
 )
 
 const (
@@ -24,13 +27,26 @@ const (
 
 	HTTPAcceptLanguageKey = "Accept-Language"
 
+	HTTPRequestIDKey = "X-Request-ID"
+
+	// HTTPCSRFTokenKey - CSRF token of the session, required on requests that change data.
+	HTTPCSRFTokenKey = "X-CSRF-Token"
+
+	// Entity tag of config resource versions and the conditional requests based on it.
+	HTTPETagKey        = "ETag"
+	HTTPIfMatchKey     = "If-Match"
+	HTTPIfNoneMatchKey = "If-None-Match"
+
 	notFoundError     = "Not Found Error"
 	ValidatationError = "Validation Error"
 	parsingError      = "Parsing Error"
 	SessionError      = "Session Error"
 	AccessError       = "Access Error"
+	conflictError     = "Conflict Error"
 	unkownError       = "Something Went Wrong"
 
+	pgUniqueViolation = "23505"
+
 	paramActiveFilter = "active_filter"
 	ccParamFilter     = "Unclassified Device"
 )
@@ -62,24 +78,55 @@ func addAuditData(s *model.SessionContext, m model.Context) {
 	s.AuditData = m.Audit()
 }
 
+// SetAuditOld - entity m before the change of request, written to the audit log.
+func SetAuditOld(s *model.SessionContext, m model.Context) {
+	s.AuditOld = m.Audit()
+}
+
+// SetAuditNew - entity m with id as stored by request, written to the audit log.
+func SetAuditNew(s *model.SessionContext, id string, m model.Context) {
+	s.AuditID = id
+	s.AuditData = m.Audit()
+}
+
 func addValidationErrors(s *model.SessionContext, err error) {
 	if nil != err {
-		var data string
 		switch err.(type) {
 		case v.Errors:
 			byteArr, _ := err.(v.Errors).MarshalJSON()
 			m := make(map[string]interface{})
 			json.Unmarshal(byteArr, &m)
 			parseMap(s, m)
-			d, _ := json.Marshal(m)
-			data = string(d)
+			fields := make(map[string]string)
+			flattenFields(fields, "", m)
+			s.Err = &model.AppError{Type: ValidatationError, Message: translate(s, "key_validation_failed"),
+				Fields: fields, Code: http.StatusUnprocessableEntity}
 		default:
 			// We should not enter here...
-			data = err.Error()
+			s.Err = &model.AppError{Type: ValidatationError, Message: translate(s, err.Error()), Code: http.StatusUnprocessableEntity}
 		}
-		s.Err = &model.AppError{Type: ValidatationError, Message: data, Code: http.StatusUnprocessableEntity}
 	}
 }
+
+// flattenFields - adds translated errors of nested structs as "parent.field" keys.
+func flattenFields(fields map[string]string, prefix string, m map[string]interface{}) {
+	for key, val := range m {
+		switch concreteVal := val.(type) {
+		case map[string]interface{}:
+			flattenFields(fields, prefix+key+".", concreteVal)
+		default:
+			fields[prefix+key] = fmt.Sprint(concreteVal)
+		}
+	}
+}
+
+// translate - message of key in language of session, key itself when session has no translation.
+func translate(s *model.SessionContext, key string) string {
+	if s.TFunc == nil {
+		return key
+	}
+	return s.TFunc(key)
+}
 func parseMap(s *model.SessionContext, aMap map[string]interface{}) {
 	for key, val := range aMap {
 		switch concreteVal := val.(type) {
@@ -112,22 +159,86 @@ func parseArray(s *model.SessionContext, anArray []interface{}) {
 
 // SetPreconditionFailedError - Sets pre-condition Failed Error to session and handled generically.
 func SetPreconditionFailedError(s *model.SessionContext, msg string) {
-	s.Err = &model.AppError{Type: ValidatationError, Message: s.TFunc(msg), Code: http.StatusBadRequest}
+	s.Err = &model.AppError{Type: ValidatationError, Message: translate(s, msg), Code: http.StatusBadRequest}
 }
 
 // SetBadRequestError - Sets error to session and handled generically.
 func SetBadRequestError(s *model.SessionContext) {
-	s.Err = &model.AppError{Type: ValidatationError, Message: s.TFunc("name_unique_constraint_missing"), Code: http.StatusBadRequest}
+	s.Err = &model.AppError{Type: ValidatationError, Message: translate(s, "name_unique_constraint_missing"), Code: http.StatusBadRequest}
 }
 
 // SetNotFoundError - Sets error to session and handled generically.
 func SetNotFoundError(s *model.SessionContext) {
-	s.Err = &model.AppError{Type: notFoundError, Message: "Not found.", Code: http.StatusNotFound}
+	s.Err = &model.AppError{Type: notFoundError, Message: translate(s, "key_not_found"), Code: http.StatusNotFound}
+}
+
+// SetConflictError - Sets error to session when the data clashes with an existing entity.
+func SetConflictError(s *model.SessionContext) {
+	s.Err = &model.AppError{Type: conflictError, Message: translate(s, "key_already_exists"), Code: http.StatusConflict}
+}
+
+// SetVersionMismatchError - Sets error to session when a resource was changed since the version the
+// request is based on.
+func SetVersionMismatchError(s *model.SessionContext) {
+	s.Err = &model.AppError{Type: conflictError, Message: translate(s, "key_version_mismatch"), Code: http.StatusPreconditionFailed}
+}
+
+// SetDuplicateCheckInError - Sets error to session when a ticket is scanned again, with time and operator
+// of its first check-in.
+func SetDuplicateCheckInError(s *model.SessionContext, err *model.DuplicateCheckInError) {
+	s.Err = &model.AppError{Type: conflictError, Message: translate(s, "key_ticket_checked_in"),
+		Fields: map[string]string{"checked_in_at": err.At.Format(time.RFC3339), "checked_in_by": err.By},
+		Code:   http.StatusConflict}
+}
+
+// SetUnauthorizedError - Sets error to session when request is not authenticated.
+func SetUnauthorizedError(s *model.SessionContext) {
+	s.Err = &model.AppError{Type: SessionError, Message: translate(s, "key_unauthorized"), Code: http.StatusUnauthorized}
+}
+
+// SetTooManyRequestsError - Sets error to session when requests are refused for a while.
+func SetTooManyRequestsError(s *model.SessionContext, msg string) {
+	s.Err = &model.AppError{Type: SessionError, Message: translate(s, msg), Code: http.StatusTooManyRequests}
+}
+
+// SetConversionError - Sets validation error for the field, or clusters when the whole entity, which
+// can not be synced to the CPPM version of a cluster.
+func SetConversionError(s *model.SessionContext, err *model.ConversionError) {
+	field, key := err.Field, "key_cppm_field_unsupported"
+	if field == "" {
+		field, key = "clusters", "key_cppm_version_unsupported"
+	}
+	s.Err = &model.AppError{Type: ValidatationError, Message: translate(s, "key_validation_failed"),
+		Fields: map[string]string{field: fmt.Sprintf("%s (CPPM %s)", translate(s, key), err.Release())},
+		Code:   http.StatusUnprocessableEntity}
+}
+
+// SetForbiddenError - Sets error to session when caller may not act on the requested data.
+func SetForbiddenError(s *model.SessionContext) {
+	s.Err = &model.AppError{Type: AccessError, Message: translate(s, "key_forbidden"), Code: http.StatusForbidden}
+}
+
+// SetStoreError - Sets error returned by store to session. Missing entities are reported as not
+// found, unique violations as conflict and outdated versions as precondition failed.
+func SetStoreError(s *model.SessionContext, err error) {
+	if model.IsNotFound(err) || err == sql.ErrNoRows {
+		SetNotFoundError(s)
+		return
+	}
+	if model.IsVersionMismatch(err) {
+		SetVersionMismatchError(s)
+		return
+	}
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pgUniqueViolation {
+		SetConflictError(s)
+		return
+	}
+	SetSomethingWrong(s)
 }
 
 // SetSomethingWrong - Sets error to session and handled generically if unintended error occurs.
 func SetSomethingWrong(s *model.SessionContext) {
-	s.Err = &model.AppError{Type: unkownError, Message: "Something went wrong...", Code: http.StatusInternalServerError}
+	s.Err = &model.AppError{Type: unkownError, Message: translate(s, "key_something_wrong"), Code: http.StatusInternalServerError}
 }
 
 // ReadHTTPResponse - read http response and return bytes
@@ -182,9 +293,14 @@ func WriteHTTPResponse(contents []byte, w http.ResponseWriter) {
 	w.Write(contents)
 }
 
-// SetParsingError - Error is set when unmarshalling fails.
+// SetParsingError - Error is set when unmarshalling fails. Decoder details are logged, clients get the
+// offending field when known.
 func SetParsingError(s *model.SessionContext, err error) {
-	s.Err = &model.AppError{Type: parsingError, Message: err.Error(), Code: http.StatusInternalServerError}
+	logutil.Debugf(s, "Invalid request body: %v", err)
+	s.Err = &model.AppError{Type: parsingError, Message: translate(s, "key_invalid_json"), Code: http.StatusBadRequest}
+	if typeErr, ok := err.(*json.UnmarshalTypeError); ok && typeErr.Field != "" {
+		s.Err.Fields = map[string]string{typeErr.Field: translate(s, "key_invalid_field_type")}
+	}
 }
 
 func IsValidIPV4(ip string) bool {
@@ -220,3 +336,8 @@ func GetEventObj(uuid string, name string, url string, cccID int, cppmID int,
 	eventObj := model.Event{UUID: uuid, Data: eventData}
 	return eventObj
 }
+
+// PrepareMapWithTenantInfo - request parameters of downstream services with the tenant of session.
+func PrepareMapWithTenantInfo(s *model.SessionContext) map[string]string {
+	return map[string]string{"tenant_id": s.User.TenantId}
+}
@@ -3,12 +3,17 @@ package utils
 import (
 	"nyota/backend/i18n"
 	"nyota/backend/model"
-	"nyota/backend/model/config"
 	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
+
+	v "github.com/go-ozzo/ozzo-validation"
 )
 
 func TestPrepareMapWithTenantInfo(t *testing.T) {
@@ -41,11 +46,11 @@ func TestInvalidInputError(t *testing.T) {
 	session := model.SessionContext{User: u, Lang: "en-US", Err: nil}
 	session.TFunc = i18n.Translate(&session)
 
-	//var ts config.Segment
+	//var ts segment
 	DecodeAndValidate(&session, w, req, nil)
 
-	if session.Err.Code != 500 {
-		t.Errorf("Expected error code: 500, but actual error code is :%d", session.Err.Code)
+	if session.Err.Code != 400 {
+		t.Errorf("Expected error code: 400, but actual error code is :%d", session.Err.Code)
 	}
 }
 
@@ -61,14 +66,17 @@ func TestInvalidInput(t *testing.T) {
 	session := model.SessionContext{User: u, Lang: "en-US", Err: nil}
 	session.TFunc = i18n.Translate(&session)
 
-	var ts config.Segment
+	var ts segment
 	DecodeAndValidate(&session, w, req, &ts)
 
 	if session.Err.Code != 422 {
 		t.Errorf("Expected error code: 422, but actual error code is :%d", session.Err.Code)
 	}
-	if !strings.Contains(session.Err.Message, "Collector Id must be specified") {
-		t.Errorf("Expected message : 'Collector Id must be specified', but actual error message is :%s", session.Err.Message)
+	if !strings.Contains(fmt.Sprint(session.Err.Fields), "Collector Id must be specified") {
+		t.Errorf("Expected field error : 'Collector Id must be specified', but actual field errors are :%v", session.Err.Fields)
+	}
+	if session.Err.Type != ValidatationError || session.Err.Message != "Some fields are invalid" {
+		t.Errorf("Expected validation error envelope, but actual error is :%+v", session.Err)
 	}
 }
 
@@ -86,7 +94,7 @@ func TestInValidInput2(t *testing.T) {
 	session := model.SessionContext{User: u, Lang: "en-US", Err: nil}
 	session.TFunc = i18n.Translate(&session)
 
-	var ts config.Segment
+	var ts segment
 	DecodeAndValidate(&session, w, req, &ts)
 
 	if session.Err == nil {
@@ -95,6 +103,9 @@ func TestInValidInput2(t *testing.T) {
 	if session.Err.Code != 422 {
 		t.Errorf("Expected error code: 422, but actual error code is :%d", session.Err.Code)
 	}
+	if session.Err.Fields["aug_methods.0.name"] == "" || session.Err.Fields["aug_methods.0.type"] == "" {
+		t.Errorf("Expected field errors of aug method, but actual field errors are :%v", session.Err.Fields)
+	}
 }
 
 func TestValidInput(t *testing.T) {
@@ -112,7 +123,7 @@ func TestValidInput(t *testing.T) {
 	session := model.SessionContext{User: u, Lang: "en-US", Err: nil}
 	session.TFunc = i18n.Translate(&session)
 
-	var ts config.Segment
+	var ts segment
 	DecodeAndValidate(&session, w, req, &ts)
 	if session.Err != nil {
 		t.Errorf("There must not be any errors, but actual error code is :%d", session.Err.Code)
@@ -134,7 +145,7 @@ func TestAuditData(t *testing.T) {
 	session := model.SessionContext{User: u, Lang: "en-US", Err: nil}
 	session.TFunc = i18n.Translate(&session)
 
-	var ts config.Segment
+	var ts segment
 	DecodeAndValidate(&session, w, req, &ts)
 
 	if session.Err != nil {
@@ -149,6 +160,13 @@ func TestAuditData(t *testing.T) {
 	if !strings.Contains(session.AuditData, "10.2.51.0/24") {
 		t.Errorf("Invalid Audit data. 10.2.51.0/24 subnet should be present. Found = %s", session.AuditData)
 	}
+
+	SetAuditOld(&session, &ts)
+	ts.Name = "bbbbb"
+	SetAuditNew(&session, "5", &ts)
+	if !strings.Contains(session.AuditOld, "aaaaa") || !strings.Contains(session.AuditData, "bbbbb") || session.AuditID != "5" {
+		t.Errorf("Invalid Audit data. Old = %s, New = %s, Id = %s", session.AuditOld, session.AuditData, session.AuditID)
+	}
 }
 
 func TestAddValidationError(t *testing.T) {
@@ -233,3 +251,53 @@ func TestParsingError(t *testing.T) {
 	n = []interface{}{1, 2}
 	parseArray(s, n)
 }
+
+// segment - entity with nested entities to decode, config types can't be used here as they import
+// utils.
+type segment struct {
+	ID          int         `json:"id"`
+	TenantID    string      `json:"tenant_id"`
+	CollectorID string      `json:"collector_id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Subnets     []string    `json:"subnets"`
+	AugMethods  []augMethod `json:"aug_methods"`
+	AddedAt     time.Time   `json:"added_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	AddedBy     string      `json:"added_by"`
+	UpdatedBy   string      `json:"updated_by"`
+}
+
+type augMethod struct {
+	ID          int                    `json:"id"`
+	TenantID    string                 `json:"tenant_id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Type        string                 `json:"type"`
+	Config      map[string]interface{} `json:"config"`
+	AddedAt     time.Time              `json:"added_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+func (seg *segment) Audit() string {
+	data, _ := json.Marshal(seg)
+	return string(data)
+}
+
+func (seg *segment) Validate() error {
+	return v.ValidateStruct(seg,
+		v.Field(&seg.CollectorID, v.Required.Error("collector_id_required")),
+		v.Field(&seg.Name, v.Required.Error("key_name_required")),
+		v.Field(&seg.AugMethods))
+}
+
+func (seg *segment) SetData(id string, tenantID string, userName string) {
+	seg.ID, _ = strconv.Atoi(id)
+	seg.TenantID = tenantID
+}
+
+func (method augMethod) Validate() error {
+	return v.ValidateStruct(&method,
+		v.Field(&method.Name, v.Required.Error("key_name_required")),
+		v.Field(&method.Type, v.Required.Error("key_type_required")))
+}
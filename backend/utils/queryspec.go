@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"net/http"
+	"net/url"
+	"nyota/backend/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Page size of list queries without one, and the largest accepted.
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 1000
+
+	HTTPTotalCountKey = "X-Total-Count"
+)
+
+// ParseQuerySpec - list query of params page, page_size, sort ("field" ascending, "-field"
+// descending), filter (repeatable "field:op:value", op one of eq, ne, lt, le, gt, ge, like, in)
+// and q (free text). Fields are the grid columns of the listed entity, filters and search only
+// on CanFilter columns and sort only on CanSort ones. False with the error set on session when
+// a param is invalid.
+func ParseQuerySpec(s *model.SessionContext, params url.Values, columns []model.GridColumn) (model.QuerySpec, bool) {
+	spec := model.QuerySpec{Page: 1, PageSize: DefaultPageSize}
+	var ok bool
+	if spec.Page, ok = queryInt(params, "page", spec.Page); !ok {
+		return spec, setQueryError(s, "page", "key_query_page_invalid")
+	}
+	if spec.PageSize, ok = queryInt(params, "page_size", spec.PageSize); !ok {
+		return spec, setQueryError(s, "page_size", "key_query_page_invalid")
+	}
+	if spec.PageSize > MaxPageSize {
+		spec.PageSize = MaxPageSize
+	}
+
+	byField := make(map[string]model.GridColumn)
+	for _, column := range columns {
+		if column.Column == "" {
+			column.Column = column.Field
+		}
+		byField[column.Field] = column
+	}
+
+	if sort := params.Get("sort"); sort != "" {
+		spec.Desc = strings.HasPrefix(sort, "-")
+		column, found := byField[strings.TrimPrefix(sort, "-")]
+		if !found || !column.CanSort {
+			return spec, setQueryError(s, "sort", "key_query_sort_invalid")
+		}
+		spec.Sort = column.Column
+	}
+
+	for _, expr := range params["filter"] {
+		parts := strings.SplitN(expr, ":", 3)
+		if len(parts) != 3 {
+			return spec, setQueryError(s, "filter", "key_query_filter_invalid")
+		}
+		column, found := byField[parts[0]]
+		if !found || !column.CanFilter || !validOp(parts[1], column.Type) {
+			return spec, setQueryError(s, "filter", "key_query_filter_invalid")
+		}
+		values := []string{parts[2]}
+		if parts[1] == model.OpIn {
+			values = strings.Split(parts[2], ",")
+		}
+		filter := model.QueryFilter{Column: column.Column, Op: parts[1]}
+		for _, value := range values {
+			converted, ok := columnValue(column.Type, value)
+			if !ok {
+				return spec, setQueryError(s, "filter", "key_query_value_invalid")
+			}
+			filter.Values = append(filter.Values, converted)
+		}
+		spec.Filters = append(spec.Filters, filter)
+	}
+
+	if spec.Search = strings.TrimSpace(params.Get("q")); spec.Search != "" {
+		for _, column := range columns {
+			if column.CanFilter && column.Type == model.ColumnText {
+				spec.SearchColumns = append(spec.SearchColumns, byField[column.Field].Column)
+			}
+		}
+		if len(spec.SearchColumns) == 0 {
+			return spec, setQueryError(s, "q", "key_query_search_invalid")
+		}
+	}
+	return spec, true
+}
+
+// SetTotalCount - number of rows matching the list query, sent with pages of plain arrays.
+func SetTotalCount(w http.ResponseWriter, total int) {
+	w.Header().Set(HTTPTotalCountKey, strconv.Itoa(total))
+}
+
+func queryInt(params url.Values, name string, defaultValue int) (int, bool) {
+	if params.Get(name) == "" {
+		return defaultValue, true
+	}
+	n, err := strconv.Atoi(params.Get(name))
+	return n, err == nil && n > 0
+}
+
+// validOp - op can be applied to columns of columnType.
+func validOp(op, columnType string) bool {
+	switch op {
+	case model.OpEq, model.OpNe, model.OpIn:
+		return true
+	case model.OpLt, model.OpLe, model.OpGt, model.OpGe:
+		return columnType != model.ColumnBool
+	case model.OpLike:
+		return columnType == model.ColumnText
+	}
+	return false
+}
+
+// columnValue - filter value converted to the type of column.
+func columnValue(columnType, value string) (interface{}, bool) {
+	switch columnType {
+	case model.ColumnNumber:
+		n, err := strconv.ParseInt(value, 10, 64)
+		return n, err == nil
+	case model.ColumnBool:
+		b, err := strconv.ParseBool(value)
+		return b, err == nil
+	case model.ColumnTime:
+		t, err := time.Parse(time.RFC3339, value)
+		return t, err == nil
+	}
+	return value, true
+}
+
+// setQueryError - Sets error for the invalid list query param, always false.
+func setQueryError(s *model.SessionContext, param, key string) bool {
+	s.Err = &model.AppError{Type: ValidatationError, Message: translate(s, "key_query_invalid"),
+		Fields: map[string]string{param: translate(s, key)}, Code: http.StatusBadRequest}
+	return false
+}
@@ -4,11 +4,14 @@ import (
 	"nyota/backend/model"
 )
 
-func Convert(object model.VersionEntity, destVersion int) model.VersionEntity {
-	lastUpdatedVersion := object.GetVersion()
-	for destVersion < lastUpdatedVersion {
-		object := object.Convert()
-		lastUpdatedVersion = object.GetVersion()
+// Convert - walks object down to the newest version not above destVersion.
+func Convert(object model.VersionEntity, destVersion int) (model.VersionEntity, error) {
+	for destVersion < object.GetVersion() {
+		prev, err := object.Convert()
+		if err != nil {
+			return nil, err
+		}
+		object = prev
 	}
-	return object
+	return object, nil
 }
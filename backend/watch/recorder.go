@@ -0,0 +1,46 @@
+package watch
+
+import (
+	"sync"
+)
+
+// Message is a notification captured by Recorder.
+type Message struct {
+	Channel string
+	Data    interface{}
+}
+
+// Recorder is a Notifier which keeps all notifications in memory instead of publishing them
+// to redis. Used where redis is not available, e.g. tests.
+type Recorder struct {
+	mu       sync.Mutex
+	messages []Message
+	err      error
+}
+
+// Notify records the notification, or fails when an error is set with Fail.
+func (recorder *Recorder) Notify(channel string, data interface{}) error {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if recorder.err != nil {
+		return recorder.err
+	}
+	recorder.messages = append(recorder.messages, Message{Channel: channel, Data: data})
+	return nil
+}
+
+// Fail makes following notifications fail with err, nil restores publishing.
+func (recorder *Recorder) Fail(err error) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.err = err
+}
+
+// Messages returns a copy of all recorded notifications in publish order.
+func (recorder *Recorder) Messages() []Message {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	messages := make([]Message, len(recorder.messages))
+	copy(messages, recorder.messages)
+	return messages
+}
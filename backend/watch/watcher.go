@@ -7,6 +7,11 @@ import (
 	redis "github.com/go-redis/redis"
 )
 
+// Notifier publishes data on a channel.
+type Notifier interface {
+	Notify(channel string, data interface{}) error
+}
+
 type Watcher struct {
 	redis    redis.UniversalClient
 	redisSub *redis.PubSub
@@ -20,7 +25,7 @@ func New() *Watcher {
 	}
 }
 
-func (watcher *Watcher) Notify(channel string, data interface{}) {
+func (watcher *Watcher) Notify(channel string, data interface{}) error {
 	notify := func() error {
 		return watcher.redis.Publish(channel, data).Err()
 	}
@@ -28,6 +33,7 @@ func (watcher *Watcher) Notify(channel string, data interface{}) {
 	if nil != err {
 		log.Errorf("failed to publish: %v", err)
 	}
+	return err
 }
 
 func (watcher *Watcher) SubscribeAndReceive(channel []string, msgC chan *redis.Message) {